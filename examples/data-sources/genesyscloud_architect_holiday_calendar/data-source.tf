@@ -0,0 +1,28 @@
+data "genesyscloud_architect_holiday_calendar" "scotland" {
+  country_code      = "GB"
+  region_code       = "GB-SCT"
+  years             = [2025, 2026]
+  name_prefix       = "Scotland - "
+  excluded_holidays = ["St Andrew's Day"]
+
+  additional_holiday {
+    name      = "Christmas Eve"
+    date      = "2025-12-24"
+    recurring = true
+  }
+}
+
+resource "genesyscloud_architect_schedules" "scotland_holidays" {
+  for_each = { for s in data.genesyscloud_architect_holiday_calendar.scotland.schedules : s.date => s }
+
+  name  = each.value.name
+  start = each.value.start
+  end   = each.value.end
+}
+
+resource "genesyscloud_architect_schedulegroups" "scotland" {
+  name                 = "Scotland Schedule Group"
+  time_zone            = "Europe/London"
+  open_schedules_id    = [genesyscloud_architect_schedules.sample_schedule.id]
+  holiday_schedules_id = [for s in genesyscloud_architect_schedules.scotland_holidays : s.id]
+}
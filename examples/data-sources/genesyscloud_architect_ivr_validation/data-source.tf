@@ -0,0 +1,32 @@
+data "genesyscloud_architect_ivr_validation" "routing" {
+  ivr {
+    name = genesyscloud_architect_ivr.sample_ivr.name
+    dnis = genesyscloud_architect_ivr.sample_ivr.dnis
+  }
+
+  did_pool {
+    start_phone_number = genesyscloud_telephony_providers_edges_did_pool.example_did_pool.start_phone_number
+    end_phone_number   = genesyscloud_telephony_providers_edges_did_pool.example_did_pool.end_phone_number
+  }
+
+  schedule_group {
+    name      = genesyscloud_architect_schedulegroups.sample_schedule_groups.name
+    time_zone = genesyscloud_architect_schedulegroups.sample_schedule_groups.time_zone
+
+    open_schedule {
+      name  = genesyscloud_architect_schedules.open.name
+      start = genesyscloud_architect_schedules.open.start
+      end   = genesyscloud_architect_schedules.open.end
+      rrule = genesyscloud_architect_schedules.open.rrule
+    }
+
+    closed_schedule {
+      name  = genesyscloud_architect_schedules.closed.name
+      start = genesyscloud_architect_schedules.closed.start
+      end   = genesyscloud_architect_schedules.closed.end
+      rrule = genesyscloud_architect_schedules.closed.rrule
+    }
+  }
+
+  ivr_ids = [genesyscloud_architect_ivr.sample_ivr.id]
+}
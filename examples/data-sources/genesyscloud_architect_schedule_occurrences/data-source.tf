@@ -0,0 +1,12 @@
+data "genesyscloud_architect_schedule_occurrences" "christmas_week" {
+  open_schedule_ids    = genesyscloud_architect_schedulegroups.sample_schedule_groups.open_schedules_id
+  closed_schedule_ids  = genesyscloud_architect_schedulegroups.sample_schedule_groups.closed_schedules_id
+  holiday_schedule_ids = genesyscloud_architect_schedulegroups.sample_schedule_groups.holiday_schedules_id
+  time_zone            = "America/New_York"
+  start_date           = "2025-12-22"
+  end_date             = "2025-12-28"
+}
+
+output "christmas_day_state" {
+  value = [for i in data.genesyscloud_architect_schedule_occurrences.christmas_week.intervals : i.state if i.start == "2025-12-25T00:00:00-05:00"][0]
+}
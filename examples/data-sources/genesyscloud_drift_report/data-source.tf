@@ -0,0 +1,5 @@
+data "genesyscloud_drift_report" "weekly_audit" {
+  directory                = "./genesyscloud"
+  include_filter_resources = ["genesyscloud_routing_queue", "genesyscloud_routing_skill"]
+  format                   = "markdown"
+}
@@ -0,0 +1,14 @@
+data "genesyscloud_flow_validation" "inbound_call" {
+  filepath = "${path.module}/inboundcall_flow_example_substitutions.yaml"
+  substitutions = {
+    flow_name            = "An example flow"
+    description          = "This is an example flow"
+    default_language     = "en-us"
+    greeting             = "Archy says hi!!!"
+    menu_disconnect_name = "Disconnect"
+  }
+  queue_names       = [genesyscloud_routing_queue.example_queue.name]
+  prompt_names      = [genesyscloud_architect_user_prompt.welcome.name]
+  datatable_names   = [genesyscloud_architect_datatable.opening_hours.name]
+  data_action_names = [genesyscloud_integration_action.get_customer.name]
+}
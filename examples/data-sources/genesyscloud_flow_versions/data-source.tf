@@ -0,0 +1,7 @@
+data "genesyscloud_flow_versions" "main_ivr" {
+  flow_id = genesyscloud_flow.inbound_call_flow.id
+}
+
+output "previous_flow_version" {
+  value = [for v in data.genesyscloud_flow_versions.main_ivr.versions : v.version if !v.published && !v.debug][0]
+}
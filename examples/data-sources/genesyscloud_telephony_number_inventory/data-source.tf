@@ -0,0 +1,12 @@
+data "genesyscloud_telephony_number_inventory" "inventory" {
+  include_unassigned = true
+  max_unassigned     = 5000
+}
+
+output "unassigned_numbers" {
+  value = [for n in data.genesyscloud_telephony_number_inventory.inventory.numbers : n.number if !n.assigned]
+}
+
+output "shared_numbers" {
+  value = { for n in data.genesyscloud_telephony_number_inventory.inventory.numbers : n.number => n.owners[*].name if length(n.owners) > 1 }
+}
@@ -0,0 +1,8 @@
+data "genesyscloud_tf_export_dependency_graph" "main_ivr" {
+  include_filter_resources = ["genesyscloud_flow::inboundcall_Main IVR"]
+}
+
+resource "local_file" "main_ivr_graph" {
+  content  = data.genesyscloud_tf_export_dependency_graph.main_ivr.dot
+  filename = "${path.module}/main_ivr.dot"
+}
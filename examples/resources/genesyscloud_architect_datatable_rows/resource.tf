@@ -0,0 +1,5 @@
+resource "genesyscloud_architect_datatable_rows" "routing_table_rows" {
+  datatable_id      = genesyscloud_architect_datatable.routing_table.id
+  filepath          = "routing_table.csv"
+  file_content_hash = filesha256("routing_table.csv")
+}
\ No newline at end of file
@@ -28,4 +28,47 @@ resource "genesyscloud_flow" "flow" {
     greeting             = "Hello World"
     menu_disconnect_name = "Disconnect"
   }
-}
\ No newline at end of file
+}
+
+resource "genesyscloud_flow" "templated_flow" {
+  filepath          = "the flow configuration file path"
+  file_content_hash = filesha256("the flow configuration file path")
+  // Example flow configuration using template_values:
+  /*
+  inboundCall:
+    name: {{ quote .flow_name }}
+    defaultLanguage: en-us
+    startUpRef: ./menus/menu[mainMenu]
+    initialGreeting:
+      exp: ToAudio({{ promptRef .greeting_prompt }})
+    menus:
+      - menu:
+          name: Main Menu
+          audio:
+            tts: Please choose a department.
+          refId: mainMenu
+          choices:
+  {{- range $i, $department := .departments }}
+            - menuTransferToAcd:
+                name: {{ quote $department.name }}
+                dtmf: digit_{{ $department.dtmf }}
+                targetQueue: {{ queueRef $department.queue }}
+  {{- end }}
+  */
+  template_values = jsonencode({
+    flow_name       = "An example templated flow"
+    greeting_prompt = genesyscloud_architect_user_prompt.greeting.name
+    departments = [
+      {
+        name  = "Sales"
+        dtmf  = 1
+        queue = { name = genesyscloud_routing_queue.sales.name, id = genesyscloud_routing_queue.sales.id }
+      },
+      {
+        name  = "Support"
+        dtmf  = 2
+        queue = { name = genesyscloud_routing_queue.support.name, id = genesyscloud_routing_queue.support.id }
+      },
+    ]
+  })
+}
@@ -0,0 +1,8 @@
+resource "genesyscloud_outbound_contact_list_contacts" "contacts" {
+  contact_list_id   = genesyscloud_outbound_contact_list.contact_list.id
+  filepath          = "contacts.csv"
+  file_content_hash = filesha256("contacts.csv")
+  key_column        = "Email"
+  priority          = false
+  do_not_queue      = true
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "genesyscloud_telephony_providers_edges_phone_batch" "hq_phones" {
+  site_id                = genesyscloud_telephony_providers_edges_site.site.id
+  phone_base_settings_id = genesyscloud_telephony_providers_edges_phonebasesettings.phone-base-settings.id
+  filepath               = "${path.module}/phones.csv"
+  file_content_hash      = filesha256("${path.module}/phones.csv")
+  name_prefix            = "HQ - "
+  max_concurrency        = 10
+}
+
+output "failed_phones" {
+  value = { for p in genesyscloud_telephony_providers_edges_phone_batch.hq_phones.phones : p.name => p.error if p.error != "" }
+}
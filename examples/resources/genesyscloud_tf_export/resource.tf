@@ -34,3 +34,30 @@ resource "genesyscloud_tf_export" "export" {
   split_files_by_resource      = true
   enable_dependency_resolution = true
 }
+
+resource "genesyscloud_tf_export" "import-blocks" {
+  directory                = "./genesyscloud/import-blocks"
+  export_as_hcl            = true
+  include_import_blocks    = true
+  include_filter_resources = ["genesyscloud_routing_queue", "genesyscloud_routing_skill"]
+}
+
+resource "genesyscloud_tf_export" "modules" {
+  directory     = "./genesyscloud/modules"
+  export_as_hcl = true
+  module_layout = "resource_family"
+  module_groups = {
+    "genesyscloud_flow"          = "architect"
+    "genesyscloud_telephony_*"   = "telephony"
+    "genesyscloud_routing_*"     = "routing"
+    "genesyscloud_auth_division" = "shared"
+  }
+}
+
+resource "genesyscloud_tf_export" "git" {
+  directory                = "./org-config/genesyscloud"
+  export_as_hcl            = true
+  git_commit               = true
+  git_commit_subject       = "Nightly export"
+  include_filter_resources = ["genesyscloud_routing_queue"]
+}
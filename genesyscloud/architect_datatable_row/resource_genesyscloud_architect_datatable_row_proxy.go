@@ -89,7 +89,7 @@ func (p *architectDatatableRowProxy) deleteArchitectDatatableRow(ctx context.Con
 	return p.deleteArchitectDatatableRowAttr(ctx, p, tableId, rowId)
 }
 
-func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+func getAllArchitectDatatableFn(ctx context.Context, p *architectDatatableRowProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
 	var totalRecords []platformclientv2.Datatable
 
 	const pageSize = 100
@@ -104,7 +104,7 @@ func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy
 
 	for _, table := range *tables.Entities {
 		totalRecords = append(totalRecords, table)
-		rc.SetCache(p.dataTableCache, *table.Id, *ConvertDatatable(table))
+		rc.SetCache(ctx, p.dataTableCache, *table.Id, *ConvertDatatable(table))
 	}
 
 	for pageNum := 2; pageNum <= *tables.PageCount; pageNum++ {
@@ -119,7 +119,7 @@ func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy
 
 		for _, table := range *tables.Entities {
 			totalRecords = append(totalRecords, table)
-			rc.SetCache(p.dataTableCache, *table.Id, *ConvertDatatable(table))
+			rc.SetCache(ctx, p.dataTableCache, *table.Id, *ConvertDatatable(table))
 		}
 	}
 	return &totalRecords, apiResponse, nil
@@ -135,9 +135,9 @@ func ConvertDatatable(master platformclientv2.Datatable) *Datatable {
 	return &datatable
 }
 
-func getArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
+func getArchitectDatatableFn(ctx context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
 
-	eg := rc.GetCacheItem(p.dataTableCache, datatableId)
+	eg := rc.GetCacheItem(ctx, p.dataTableCache, datatableId)
 	if eg != nil {
 		return eg, nil, nil
 	}
@@ -176,7 +176,7 @@ func getArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy, d
 	return successPayload, response, err
 }
 
-func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+func getAllArchitectDatatableRowsFn(ctx context.Context, p *architectDatatableRowProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
 	var resources []map[string]interface{}
 	const pageSize = 100
 
@@ -192,7 +192,7 @@ func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowP
 	for _, row := range *rows.Entities {
 		resources = append(resources, row)
 		if keyVal, ok := row["key"]; ok {
-			rc.SetCache(p.dataTableRowCache, tableId+"_"+keyVal.(string), row)
+			rc.SetCache(ctx, p.dataTableRowCache, tableId+"_"+keyVal.(string), row)
 		}
 	}
 
@@ -210,15 +210,15 @@ func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowP
 		for _, row := range *rows.Entities {
 			resources = append(resources, row)
 			if keyVal, ok := row["key"]; ok {
-				rc.SetCache(p.dataTableRowCache, tableId+"_"+keyVal.(string), row)
+				rc.SetCache(ctx, p.dataTableRowCache, tableId+"_"+keyVal.(string), row)
 			}
 		}
 	}
 	return &resources, apiResponse, nil
 }
 
-func getArchitectDataTableRowFn(_ context.Context, p *architectDatatableRowProxy, tableId string, key string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
-	eg := rc.GetCacheItem(p.dataTableRowCache, tableId+"_"+key)
+func getArchitectDataTableRowFn(ctx context.Context, p *architectDatatableRowProxy, tableId string, key string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+	eg := rc.GetCacheItem(ctx, p.dataTableRowCache, tableId+"_"+key)
 	if eg != nil {
 		return eg, nil, nil
 	}
@@ -233,11 +233,11 @@ func updateArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowPr
 	return p.architectApi.PutFlowsDatatableRow(tableId, key, *row)
 }
 
-func deleteArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error) {
+func deleteArchitectDatatableRowFn(ctx context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error) {
 	resp, err := p.architectApi.DeleteFlowsDatatableRow(tableId, rowId)
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.dataTableRowCache, tableId+"_"+rowId)
+	rc.DeleteCacheItem(ctx, p.dataTableRowCache, tableId+"_"+rowId)
 	return nil, nil
 }
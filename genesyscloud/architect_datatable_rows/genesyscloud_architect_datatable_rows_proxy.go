@@ -0,0 +1,160 @@
+package architect_datatable_rows
+
+import (
+	"context"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The genesyscloud_architect_datatable_rows_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+type getDatatableByIdFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*platformclientv2.Datatable, *platformclientv2.APIResponse, error)
+type getAllDatatablesFunc func(ctx context.Context, p *architectDatatableRowsProxy) ([]platformclientv2.Datatable, *platformclientv2.APIResponse, error)
+type getAllDatatableRowsFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) ([]map[string]interface{}, *platformclientv2.APIResponse, error)
+type createDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string, row map[string]interface{}) (*platformclientv2.APIResponse, error)
+type updateDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string, key string, row map[string]interface{}) (*platformclientv2.APIResponse, error)
+type deleteDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string, key string) (*platformclientv2.APIResponse, error)
+
+// architectDatatableRowsProxy contains all of the methods that call genesys cloud APIs.
+type architectDatatableRowsProxy struct {
+	clientConfig            *platformclientv2.Configuration
+	architectApi            *platformclientv2.ArchitectApi
+	getDatatableByIdAttr    getDatatableByIdFunc
+	getAllDatatablesAttr    getAllDatatablesFunc
+	getAllDatatableRowsAttr getAllDatatableRowsFunc
+	createDatatableRowAttr  createDatatableRowFunc
+	updateDatatableRowAttr  updateDatatableRowFunc
+	deleteDatatableRowAttr  deleteDatatableRowFunc
+}
+
+var internalProxy *architectDatatableRowsProxy
+
+// newArchitectDatatableRowsProxy initializes the datatable rows proxy with all of the data needed to communicate with Genesys Cloud
+func newArchitectDatatableRowsProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowsProxy {
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	return &architectDatatableRowsProxy{
+		clientConfig:            clientConfig,
+		architectApi:            api,
+		getDatatableByIdAttr:    getDatatableByIdFn,
+		getAllDatatablesAttr:    getAllDatatablesFn,
+		getAllDatatableRowsAttr: getAllDatatableRowsFn,
+		createDatatableRowAttr:  createDatatableRowFn,
+		updateDatatableRowAttr:  updateDatatableRowFn,
+		deleteDatatableRowAttr:  deleteDatatableRowFn,
+	}
+}
+
+// getArchitectDatatableRowsProxy acts as a singleton for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getArchitectDatatableRowsProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowsProxy {
+	if internalProxy == nil {
+		internalProxy = newArchitectDatatableRowsProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getDatatableById returns a datatable with its schema
+func (p *architectDatatableRowsProxy) getDatatableById(ctx context.Context, datatableId string) (*platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	return p.getDatatableByIdAttr(ctx, p, datatableId)
+}
+
+// getAllDatatables returns every datatable in the org
+func (p *architectDatatableRowsProxy) getAllDatatables(ctx context.Context) ([]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	return p.getAllDatatablesAttr(ctx, p)
+}
+
+// getAllDatatableRows returns every row of a datatable
+func (p *architectDatatableRowsProxy) getAllDatatableRows(ctx context.Context, datatableId string) ([]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	return p.getAllDatatableRowsAttr(ctx, p, datatableId)
+}
+
+// createDatatableRow adds a row to a datatable
+func (p *architectDatatableRowsProxy) createDatatableRow(ctx context.Context, datatableId string, row map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	return p.createDatatableRowAttr(ctx, p, datatableId, row)
+}
+
+// updateDatatableRow replaces the row of a datatable with the given key
+func (p *architectDatatableRowsProxy) updateDatatableRow(ctx context.Context, datatableId string, key string, row map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	return p.updateDatatableRowAttr(ctx, p, datatableId, key, row)
+}
+
+// deleteDatatableRow removes the row of a datatable with the given key
+func (p *architectDatatableRowsProxy) deleteDatatableRow(ctx context.Context, datatableId string, key string) (*platformclientv2.APIResponse, error) {
+	return p.deleteDatatableRowAttr(ctx, p, datatableId, key)
+}
+
+func getDatatableByIdFn(_ context.Context, p *architectDatatableRowsProxy, datatableId string) (*platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetFlowsDatatable(datatableId, "schema")
+}
+
+func getAllDatatablesFn(_ context.Context, p *architectDatatableRowsProxy) ([]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allDatatables []platformclientv2.Datatable
+
+	tables, resp, err := p.architectApi.GetFlowsDatatables("", 1, pageSize, "", "", nil, "")
+	if err != nil {
+		return nil, resp, err
+	}
+	if tables.Entities == nil || len(*tables.Entities) == 0 {
+		return allDatatables, resp, nil
+	}
+	allDatatables = append(allDatatables, *tables.Entities...)
+
+	for pageNum := 2; pageNum <= *tables.PageCount; pageNum++ {
+		tables, resp, err := p.architectApi.GetFlowsDatatables("", pageNum, pageSize, "", "", nil, "")
+		if err != nil {
+			return nil, resp, err
+		}
+		if tables.Entities == nil || len(*tables.Entities) == 0 {
+			break
+		}
+		allDatatables = append(allDatatables, *tables.Entities...)
+	}
+
+	return allDatatables, resp, nil
+}
+
+func getAllDatatableRowsFn(_ context.Context, p *architectDatatableRowsProxy, datatableId string) ([]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allRows []map[string]interface{}
+
+	rows, resp, err := p.architectApi.GetFlowsDatatableRows(datatableId, 1, pageSize, false, "")
+	if err != nil {
+		return nil, resp, err
+	}
+	if rows.Entities == nil || len(*rows.Entities) == 0 {
+		return allRows, resp, nil
+	}
+	allRows = append(allRows, *rows.Entities...)
+
+	for pageNum := 2; pageNum <= *rows.PageCount; pageNum++ {
+		rows, resp, err := p.architectApi.GetFlowsDatatableRows(datatableId, pageNum, pageSize, false, "")
+		if err != nil {
+			return nil, resp, err
+		}
+		if rows.Entities == nil || len(*rows.Entities) == 0 {
+			break
+		}
+		allRows = append(allRows, *rows.Entities...)
+	}
+
+	return allRows, resp, nil
+}
+
+func createDatatableRowFn(_ context.Context, p *architectDatatableRowsProxy, datatableId string, row map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.architectApi.PostFlowsDatatableRows(datatableId, row)
+	return resp, err
+}
+
+func updateDatatableRowFn(_ context.Context, p *architectDatatableRowsProxy, datatableId string, key string, row map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.architectApi.PutFlowsDatatableRow(datatableId, key, row)
+	return resp, err
+}
+
+func deleteDatatableRowFn(_ context.Context, p *architectDatatableRowsProxy, datatableId string, key string) (*platformclientv2.APIResponse, error) {
+	return p.architectApi.DeleteFlowsDatatableRow(datatableId, key)
+}
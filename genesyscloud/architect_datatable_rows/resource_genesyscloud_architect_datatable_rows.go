@@ -0,0 +1,243 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_architect_datatable_rows.go contains all of the methods that perform the core logic for a resource.
+The resource is identified by the ID of its datatable.
+*/
+
+// getAllArchitectDatatableRows retrieves every datatable and is used for the exporter
+func getAllArchitectDatatableRows(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	proxy := getArchitectDatatableRowsProxy(clientConfig)
+
+	datatables, resp, err := proxy.getAllDatatables(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get architect datatables error: %s", err), resp)
+	}
+
+	for _, datatable := range datatables {
+		resources[*datatable.Id] = &resourceExporter.ResourceMeta{Name: *datatable.Name}
+	}
+
+	return resources, nil
+}
+
+func createArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	datatableId := d.Get("datatable_id").(string)
+
+	log.Printf("Uploading rows of datatable %s", datatableId)
+	if diagErr := applyRowsFile(ctx, d, meta, datatableId); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(datatableId)
+	log.Printf("Uploaded rows of datatable %s", datatableId)
+	return readDatatableRows(ctx, d, meta, false)
+}
+
+func readArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readDatatableRows(ctx, d, meta, true)
+}
+
+// readDatatableRows reads the rows of the datatable. When checkDrift is set, the file_content_hash is cleared if the
+// rows no longer match the file so that the next plan uploads the file again.
+func readDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}, checkDrift bool) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+	datatableId := d.Id()
+
+	log.Printf("Reading rows of datatable %s", datatableId)
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		datatable, resp, err := proxy.getDatatableById(ctx, datatableId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read datatable %s | error: %s", datatableId, err), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read datatable %s | error: %s", datatableId, err), resp))
+		}
+
+		liveRows, resp, err := proxy.getAllDatatableRows(ctx, datatableId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read rows of datatable %s | error: %s", datatableId, err), resp))
+		}
+
+		_ = d.Set("datatable_id", datatableId)
+		_ = d.Set("row_count", len(liveRows))
+
+		if checkDrift {
+			files.ClearFileHashOnDrift(d, func(filePath string) (string, error) {
+				columns := getDatatableColumns(datatable)
+				rows, err := readRowsFile(filePath, columns)
+				if err != nil {
+					return "", err
+				}
+				diff, err := diffDatatableRows(rows, liveRows, columns)
+				if err != nil || diff.isEmpty() {
+					return "", err
+				}
+				return fmt.Sprintf("%d rows to create, %d to update, %d to delete", len(diff.creates), len(diff.updates), len(diff.deletes)), nil
+			})
+		}
+
+		log.Printf("Read %d rows of datatable %s", len(liveRows), datatableId)
+		return nil
+	})
+}
+
+func updateArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	datatableId := d.Id()
+
+	log.Printf("Updating rows of datatable %s", datatableId)
+	if diagErr := applyRowsFile(ctx, d, meta, datatableId); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated rows of datatable %s", datatableId)
+	return readDatatableRows(ctx, d, meta, false)
+}
+
+func deleteArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+	datatableId := d.Id()
+
+	liveRows, resp, err := proxy.getAllDatatableRows(ctx, datatableId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			log.Printf("Datatable %s already deleted", datatableId)
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read rows of datatable %s | error: %s", datatableId, err), resp)
+	}
+
+	log.Printf("Deleting %d rows from datatable %s", len(liveRows), datatableId)
+	batches := chunks.ChunkItems(liveRows, func(row map[string]interface{}) datatableRowChange {
+		key, _ := row[keyColumn].(string)
+		return datatableRowChange{operation: rowDelete, key: key}
+	}, datatableRowBatchSize)
+	if diagErr := applyRowChanges(ctx, proxy, datatableId, batches); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Deleted rows from datatable %s", datatableId)
+	return nil
+}
+
+// applyRowsFile validates the rows file against the datatable schema, diffs it against the live rows and sends the
+// changes in batches
+func applyRowsFile(ctx context.Context, d *schema.ResourceData, meta interface{}, datatableId string) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+	filePath := d.Get("filepath").(string)
+
+	datatable, resp, err := proxy.getDatatableById(ctx, datatableId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read datatable %s | error: %s", datatableId, err), resp)
+	}
+	columns := getDatatableColumns(datatable)
+
+	rows, err := readRowsFile(filePath, columns)
+	if err != nil {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Invalid rows file %s for datatable %s", filePath, datatableId), err)
+	}
+
+	liveRows, resp, err := proxy.getAllDatatableRows(ctx, datatableId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read rows of datatable %s | error: %s", datatableId, err), resp)
+	}
+
+	diff, err := diffDatatableRows(rows, liveRows, columns)
+	if err != nil {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Invalid rows file %s for datatable %s", filePath, datatableId), err)
+	}
+	log.Printf("Datatable %s: %d rows to create, %d to update, %d to delete", datatableId, len(diff.creates), len(diff.updates), len(diff.deletes))
+
+	// Deletes go first so that rows removed from the file never count towards the row limit of the datatable
+	batches := chunks.ChunkItems(diff.deletes, func(key string) datatableRowChange {
+		return datatableRowChange{operation: rowDelete, key: key}
+	}, datatableRowBatchSize)
+	batches = append(batches, chunks.ChunkItems(diff.updates, func(row map[string]interface{}) datatableRowChange {
+		return datatableRowChange{operation: rowUpdate, key: row[keyColumn].(string), row: row}
+	}, datatableRowBatchSize)...)
+	batches = append(batches, chunks.ChunkItems(diff.creates, func(row map[string]interface{}) datatableRowChange {
+		return datatableRowChange{operation: rowCreate, key: row[keyColumn].(string), row: row}
+	}, datatableRowBatchSize)...)
+
+	return applyRowChanges(ctx, proxy, datatableId, batches)
+}
+
+// applyRowChanges sends each batch of row changes concurrently, one batch at a time
+func applyRowChanges(ctx context.Context, proxy *architectDatatableRowsProxy, datatableId string, batches [][]datatableRowChange) diag.Diagnostics {
+	return chunks.ProcessChunks(batches, func(batch []datatableRowChange) diag.Diagnostics {
+		var (
+			wg      sync.WaitGroup
+			mutex   sync.Mutex
+			diagErr diag.Diagnostics
+		)
+		for _, change := range batch {
+			wg.Add(1)
+			go func(change datatableRowChange) {
+				defer wg.Done()
+				var (
+					resp *platformclientv2.APIResponse
+					err  error
+				)
+				switch change.operation {
+				case rowCreate:
+					resp, err = proxy.createDatatableRow(ctx, datatableId, change.row)
+				case rowUpdate:
+					resp, err = proxy.updateDatatableRow(ctx, datatableId, change.key, change.row)
+				case rowDelete:
+					resp, err = proxy.deleteDatatableRow(ctx, datatableId, change.key)
+					if util.IsStatus404(resp) {
+						err = nil
+					}
+				}
+				if err != nil {
+					mutex.Lock()
+					defer mutex.Unlock()
+					diagErr = append(diagErr, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to sync row %s of datatable %s | error: %s", change.key, datatableId, err), resp)...)
+				}
+			}(change)
+		}
+		wg.Wait()
+		return diagErr
+	})
+}
+
+// DatatableRowsResolver writes the rows of a datatable to a CSV file in the export directory and points the exported
+// filepath at it
+var DatatableRowsResolver = files.CsvExportResolver("datatable", getDatatableRowsCsv)
+
+// getDatatableRowsCsv returns the rows of a datatable as CSV
+func getDatatableRowsCsv(ctx context.Context, meta interface{}, datatableId string) ([]byte, error) {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+
+	datatable, _, err := proxy.getDatatableById(ctx, datatableId)
+	if err != nil {
+		return nil, err
+	}
+	rows, _, err := proxy.getAllDatatableRows(ctx, datatableId)
+	if err != nil {
+		return nil, err
+	}
+	return writeRowsCsv(getDatatableColumns(datatable), rows)
+}
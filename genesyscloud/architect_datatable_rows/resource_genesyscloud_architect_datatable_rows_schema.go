@@ -0,0 +1,82 @@
+package architect_datatable_rows
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+resource_genesyscloud_architect_datatable_rows_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the architect_datatable_rows resource.
+3.  The resource exporter configuration for the architect_datatable_rows exporter.
+*/
+const resourceName = "genesyscloud_architect_datatable_rows"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceArchitectDatatableRows())
+	regInstance.RegisterExporter(resourceName, ArchitectDatatableRowsExporter())
+}
+
+// ResourceArchitectDatatableRows registers the genesyscloud_architect_datatable_rows resource with Terraform
+func ResourceArchitectDatatableRows() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Architect Datatable Rows. Manages all of the rows of a datatable from a CSV or JSON file.
+Each row is validated against the properties of the datatable, and only the rows that were added, changed or removed are sent to the API.`,
+
+		CreateContext: provider.CreateWithPooledClient(createArchitectDatatableRows),
+		ReadContext:   provider.ReadWithPooledClient(readArchitectDatatableRows),
+		UpdateContext: provider.UpdateWithPooledClient(updateArchitectDatatableRows),
+		DeleteContext: provider.DeleteWithPooledClient(deleteArchitectDatatableRows),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"datatable_id": {
+				Description: "Datatable ID that contains the rows. If this is changed, the rows are created in the new datatable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filepath": {
+				Description: `Path or URL of the file containing the rows. Files ending in .json are read as a JSON array of row objects, all other files are read as CSV with a header row of property names.
+Every row must have a "key" value, and properties that are missing or empty take their default value. Every row of the datatable that is not in the file is deleted.`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the rows file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"row_count": {
+				Description: "The number of rows in the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ArchitectDatatableRowsExporter returns the resourceExporter object used to hold the genesyscloud_architect_datatable_rows exporter's config
+func ArchitectDatatableRowsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc:   provider.GetAllWithPooledClient(getAllArchitectDatatableRows),
+		ExcludedAttributes: []string{"row_count"},
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"datatable_id": {RefType: "genesyscloud_architect_datatable"},
+		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: DatatableRowsResolver,
+			SubDirectory:              "datatables",
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestDatatable(id string) *platformclientv2.Datatable {
+	properties := map[string]interface{}{
+		"key":      map[string]interface{}{"type": "string", "displayOrder": float64(0)},
+		"Queue":    map[string]interface{}{"type": "string", "displayOrder": float64(1)},
+		"Priority": map[string]interface{}{"type": "integer", "displayOrder": float64(2), "default": float64(5)},
+		"Open":     map[string]interface{}{"type": "boolean", "displayOrder": float64(3)},
+	}
+	return &platformclientv2.Datatable{
+		Id:     &id,
+		Schema: &platformclientv2.Jsonschemadocument{Properties: &properties},
+	}
+}
+
+func TestUnitDatatableRowsParseFiles(t *testing.T) {
+	columns := getDatatableColumns(buildTestDatatable(uuid.NewString()))
+	assert.Equal(t, "key", columns[0].name)
+	assert.Equal(t, "Open", columns[3].name)
+
+	rows, err := parseCsvRows(strings.NewReader("key,Queue,Priority,Open\nsales,Sales,2,true\nsupport,Support,,\n"), columns)
+	assert.Nil(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"key": "sales", "Queue": "Sales", "Priority": float64(2), "Open": true},
+		{"key": "support", "Queue": "Support"},
+	}, rows)
+
+	_, err = parseCsvRows(strings.NewReader("key,Unknown\nsales,x\n"), columns)
+	assert.ErrorContains(t, err, "not a property")
+
+	_, err = parseCsvRows(strings.NewReader("key,Priority\nsales,2.5\n"), columns)
+	assert.ErrorContains(t, err, "not an integer")
+
+	_, err = parseCsvRows(strings.NewReader("key,Open\nsales,maybe\n"), columns)
+	assert.ErrorContains(t, err, "not a boolean")
+
+	rows, err = parseJsonRows(strings.NewReader(`[{"key": "sales", "Priority": 2, "Open": null}]`), columns)
+	assert.Nil(t, err)
+	assert.Equal(t, []map[string]interface{}{{"key": "sales", "Priority": float64(2)}}, rows)
+
+	_, err = parseJsonRows(strings.NewReader(`[{"key": "sales", "Queue": 7}]`), columns)
+	assert.ErrorContains(t, err, "not a string")
+}
+
+func TestUnitDatatableRowsDiff(t *testing.T) {
+	columns := getDatatableColumns(buildTestDatatable(uuid.NewString()))
+	rows := []map[string]interface{}{
+		{"key": "sales", "Queue": "Sales"},
+		{"key": "support", "Queue": "Support", "Priority": float64(1)},
+		{"key": "billing", "Queue": "Billing"},
+	}
+	liveRows := []map[string]interface{}{
+		{"key": "sales", "Queue": "Sales", "Priority": float64(5), "Open": false},
+		{"key": "support", "Queue": "Support", "Priority": float64(5), "Open": false},
+		{"key": "legacy", "Queue": "Legacy", "Priority": float64(5), "Open": false},
+	}
+
+	diff, err := diffDatatableRows(rows, liveRows, columns)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"legacy"}, diff.deletes)
+	assert.Equal(t, []map[string]interface{}{{"key": "support", "Queue": "Support", "Priority": float64(1), "Open": false}}, diff.updates)
+	assert.Equal(t, []map[string]interface{}{{"key": "billing", "Queue": "Billing", "Priority": float64(5), "Open": false}}, diff.creates)
+
+	_, err = diffDatatableRows([]map[string]interface{}{{"key": "sales"}, {"key": "sales"}}, nil, columns)
+	assert.ErrorContains(t, err, "more than once")
+
+	_, err = diffDatatableRows([]map[string]interface{}{{"key": ""}}, nil, columns)
+	assert.ErrorContains(t, err, "no value")
+
+	content, err := writeRowsCsv(columns, liveRows)
+	assert.Nil(t, err)
+	assert.Equal(t, "key,Queue,Priority,Open\nsales,Sales,5,false\nsupport,Support,5,false\nlegacy,Legacy,5,false\n", string(content))
+
+	exported, err := parseCsvRows(strings.NewReader(string(content)), columns)
+	assert.Nil(t, err)
+	diff, err = diffDatatableRows(exported, liveRows, columns)
+	assert.Nil(t, err)
+	assert.True(t, diff.isEmpty())
+}
+
+func TestUnitResourceArchitectDatatableRowsCreate(t *testing.T) {
+	datatableId := uuid.NewString()
+	liveRows := []map[string]interface{}{
+		{"key": "sales", "Queue": "Sales", "Priority": float64(5), "Open": false},
+		{"key": "legacy", "Queue": "Legacy", "Priority": float64(5), "Open": false},
+	}
+
+	filePath := filepath.Join(t.TempDir(), "rows.csv")
+	if err := os.WriteFile(filePath, []byte("key,Queue\nsales,Sales\nbilling,Billing\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mutex       sync.Mutex
+		createdKeys []string
+		deletedKeys []string
+	)
+	rowsProxy := &architectDatatableRowsProxy{}
+	rowsProxy.getDatatableByIdAttr = func(ctx context.Context, p *architectDatatableRowsProxy, id string) (*platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, datatableId, id)
+		return buildTestDatatable(id), nil, nil
+	}
+	rowsProxy.getAllDatatableRowsAttr = func(ctx context.Context, p *architectDatatableRowsProxy, id string) ([]map[string]interface{}, *platformclientv2.APIResponse, error) {
+		return liveRows, nil, nil
+	}
+	rowsProxy.createDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowsProxy, id string, row map[string]interface{}) (*platformclientv2.APIResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		assert.Equal(t, float64(5), row["Priority"])
+		createdKeys = append(createdKeys, row["key"].(string))
+		return nil, nil
+	}
+	rowsProxy.updateDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowsProxy, id string, key string, row map[string]interface{}) (*platformclientv2.APIResponse, error) {
+		t.Errorf("unexpected update of row %s", key)
+		return nil, nil
+	}
+	rowsProxy.deleteDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowsProxy, id string, key string) (*platformclientv2.APIResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		deletedKeys = append(deletedKeys, key)
+		return nil, nil
+	}
+	internalProxy = rowsProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceSchema := ResourceArchitectDatatableRows().Schema
+	resourceDataMap := map[string]interface{}{
+		"datatable_id":      datatableId,
+		"filepath":          filePath,
+		"file_content_hash": "hash",
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, resourceDataMap)
+
+	diag := createArchitectDatatableRows(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, datatableId, d.Id())
+	assert.Equal(t, "hash", d.Get("file_content_hash").(string))
+	assert.Equal(t, []string{"billing"}, createdKeys)
+	assert.Equal(t, []string{"legacy"}, deletedKeys)
+
+	// Reading while the datatable still differs from the file clears the hash so the file is uploaded again
+	diag = readArchitectDatatableRows(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, "", d.Get("file_content_hash").(string))
+	assert.Equal(t, len(liveRows), d.Get("row_count").(int))
+}
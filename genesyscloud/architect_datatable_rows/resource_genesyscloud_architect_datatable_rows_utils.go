@@ -0,0 +1,337 @@
+package architect_datatable_rows
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_architect_datatable_rows_utils.go file contains the helpers that read a rows file, validate it
+against the schema of the datatable, diff it against the live rows and write the rows of a datatable back out as CSV.
+*/
+
+const (
+	keyColumn = "key"
+
+	// Rows are created, updated and deleted one request at a time, so each batch of requests is sent concurrently
+	datatableRowBatchSize = 10
+)
+
+// datatableColumn is a property of the datatable schema
+type datatableColumn struct {
+	name         string
+	varType      string
+	defaultValue interface{}
+	displayOrder int
+}
+
+type rowOperation int
+
+const (
+	rowCreate rowOperation = iota
+	rowUpdate
+	rowDelete
+)
+
+type datatableRowChange struct {
+	operation rowOperation
+	key       string
+	row       map[string]interface{}
+}
+
+// datatableRowDiff holds the changes needed to make a datatable match a rows file
+type datatableRowDiff struct {
+	creates []map[string]interface{}
+	updates []map[string]interface{}
+	deletes []string
+}
+
+func (r *datatableRowDiff) isEmpty() bool {
+	return len(r.creates) == 0 && len(r.updates) == 0 && len(r.deletes) == 0
+}
+
+// getDatatableColumns returns the properties of the datatable schema, key first and the rest in display order
+func getDatatableColumns(datatable *platformclientv2.Datatable) []datatableColumn {
+	var columns []datatableColumn
+	if datatable == nil || datatable.Schema == nil || datatable.Schema.Properties == nil {
+		return columns
+	}
+
+	for name, property := range *datatable.Schema.Properties {
+		propertyMap, ok := property.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		column := datatableColumn{name: name, defaultValue: propertyMap["default"]}
+		column.varType, _ = propertyMap["type"].(string)
+		if displayOrder, ok := propertyMap["displayOrder"].(float64); ok {
+			column.displayOrder = int(displayOrder)
+		}
+		columns = append(columns, column)
+	}
+
+	sort.SliceStable(columns, func(i, j int) bool {
+		if (columns[i].name == keyColumn) != (columns[j].name == keyColumn) {
+			return columns[i].name == keyColumn
+		}
+		if columns[i].displayOrder != columns[j].displayOrder {
+			return columns[i].displayOrder < columns[j].displayOrder
+		}
+		return columns[i].name < columns[j].name
+	})
+	return columns
+}
+
+func columnsByName(columns []datatableColumn) map[string]datatableColumn {
+	byName := make(map[string]datatableColumn, len(columns))
+	for _, column := range columns {
+		byName[column.name] = column
+	}
+	return byName
+}
+
+// readRowsFile reads the rows of a CSV or JSON rows file from a local path or URL and validates them against the columns
+func readRowsFile(path string, columns []datatableColumn) ([]map[string]interface{}, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		return parseJsonRows(reader, columns)
+	}
+	return parseCsvRows(reader, columns)
+}
+
+// parseCsvRows reads a CSV file where the first row holds the property names. Empty cells take the property default.
+func parseCsvRows(reader io.Reader, columns []datatableColumn) ([]map[string]interface{}, error) {
+	byName := columnsByName(columns)
+
+	csvReader, header, err := files.NewCsvReader(reader)
+	if err != nil || header == nil {
+		return nil, err
+	}
+	for _, name := range header {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("column '%s' is not a property of the datatable", name)
+		}
+	}
+
+	var rows []map[string]interface{}
+	for rowNum := 1; ; rowNum++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV row %d: %v", rowNum, err)
+		}
+		row := make(map[string]interface{}, len(header))
+		for i, name := range header {
+			if record[i] == "" && name != keyColumn {
+				continue
+			}
+			value, err := convertCsvValue(byName[name], record[i])
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %v", rowNum, err)
+			}
+			row[name] = value
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func convertCsvValue(column datatableColumn, value string) (interface{}, error) {
+	switch column.varType {
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' of column '%s' is not a boolean", value, column.name)
+		}
+		return b, nil
+	case "integer", "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' of column '%s' is not a number", value, column.name)
+		}
+		return checkNumber(column, f)
+	default:
+		return value, nil
+	}
+}
+
+// parseJsonRows reads a file containing a JSON array of row objects
+func parseJsonRows(reader io.Reader, columns []datatableColumn) ([]map[string]interface{}, error) {
+	byName := columnsByName(columns)
+
+	var objects []map[string]interface{}
+	if err := json.NewDecoder(reader).Decode(&objects); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read JSON rows: %v", err)
+	}
+
+	rows := make([]map[string]interface{}, 0, len(objects))
+	for i, object := range objects {
+		row := make(map[string]interface{}, len(object))
+		for name, value := range object {
+			column, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("row %d: column '%s' is not a property of the datatable", i+1, name)
+			}
+			if value == nil {
+				continue
+			}
+			checked, err := checkJsonValue(column, value)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %v", i+1, err)
+			}
+			row[name] = checked
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func checkJsonValue(column datatableColumn, value interface{}) (interface{}, error) {
+	switch column.varType {
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return nil, fmt.Errorf("value %v of column '%s' is not a boolean", value, column.name)
+		}
+	case "integer", "number":
+		f, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("value %v of column '%s' is not a number", value, column.name)
+		}
+		return checkNumber(column, f)
+	default:
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("value %v of column '%s' is not a string", value, column.name)
+		}
+	}
+	return value, nil
+}
+
+func checkNumber(column datatableColumn, f float64) (interface{}, error) {
+	if column.varType == "integer" && f != math.Trunc(f) {
+		return nil, fmt.Errorf("value %v of column '%s' is not an integer", f, column.name)
+	}
+	return f, nil
+}
+
+// applyDefaults sets the default value of every property missing from a row, in the same way as the
+// genesyscloud_architect_datatable_row resource
+func applyDefaults(row map[string]interface{}, columns []datatableColumn) map[string]interface{} {
+	result := make(map[string]interface{}, len(columns))
+	for _, column := range columns {
+		if value, ok := row[column.name]; ok && value != nil {
+			result[column.name] = value
+			continue
+		}
+		if column.name == keyColumn {
+			continue
+		}
+		switch {
+		case column.defaultValue != nil:
+			result[column.name] = column.defaultValue
+		case column.varType == "boolean":
+			result[column.name] = false
+		case column.varType == "string":
+			result[column.name] = ""
+		case column.varType == "integer" || column.varType == "number":
+			result[column.name] = float64(0)
+		}
+	}
+	return result
+}
+
+// diffDatatableRows compares the rows of a file with the live rows of a datatable by key
+func diffDatatableRows(rows []map[string]interface{}, liveRows []map[string]interface{}, columns []datatableColumn) (*datatableRowDiff, error) {
+	diff := &datatableRowDiff{}
+
+	rowKeys := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		key, _ := row[keyColumn].(string)
+		if key == "" {
+			return nil, fmt.Errorf("row %d has no value for '%s'", i+1, keyColumn)
+		}
+		if rowKeys[key] {
+			return nil, fmt.Errorf("key '%s' appears more than once", key)
+		}
+		rowKeys[key] = true
+	}
+
+	liveByKey := make(map[string]map[string]interface{}, len(liveRows))
+	for _, liveRow := range liveRows {
+		key, _ := liveRow[keyColumn].(string)
+		if !rowKeys[key] {
+			diff.deletes = append(diff.deletes, key)
+			continue
+		}
+		liveByKey[key] = applyDefaults(liveRow, columns)
+	}
+
+	for _, row := range rows {
+		fullRow := applyDefaults(row, columns)
+		liveRow, ok := liveByKey[row[keyColumn].(string)]
+		if !ok {
+			diff.creates = append(diff.creates, fullRow)
+			continue
+		}
+		if !reflect.DeepEqual(fullRow, liveRow) {
+			diff.updates = append(diff.updates, fullRow)
+		}
+	}
+
+	return diff, nil
+}
+
+// writeRowsCsv writes datatable rows as CSV with one column per property of the datatable
+func writeRowsCsv(columns []datatableColumn, rows []map[string]interface{}) ([]byte, error) {
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.name
+	}
+
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	if err := csvWriter.Write(header); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, name := range header {
+			record[i] = formatCsvValue(row[name])
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	csvWriter.Flush()
+	return buf.Bytes(), csvWriter.Error()
+}
+
+func formatCsvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,103 @@
+package architect_flow
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFlowValidationRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	filePath := d.Get("filepath").(string)
+	substitutions, _ := d.Get("substitutions").(map[string]interface{})
+
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return util.BuildDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to open flow configuration file %s", filePath), err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return util.BuildDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to read flow configuration file %s", filePath), err)
+	}
+
+	fileTemplate, err := files.GetFileTemplate(d)
+	if err != nil {
+		return util.BuildDiagnosticError(validationDataSourceName, "Invalid template_values or template_delimiters", err)
+	}
+
+	substituted := applyFlowSubstitutions(string(content), substitutions)
+	if fileTemplate != nil {
+		if substituted, err = fileTemplate.Render(filePath, substituted); err != nil {
+			return util.BuildDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to render flow configuration file %s", filePath), err)
+		}
+	}
+	result := validateFlowYaml([]byte(substituted), buildFlowReferences(d))
+	checkUnusedSubstitutions(string(content), substitutions, result)
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(substituted))))
+	_ = d.Set("valid", !result.hasErrors())
+	_ = d.Set("flow_type", result.flowType)
+	_ = d.Set("flow_name", result.flowName)
+	_ = d.Set("issues", flattenFlowIssues(result.issues))
+
+	return buildFlowIssueDiagnostics(filePath, result.issues, d.Get("fail_on_error").(bool))
+}
+
+// buildFlowReferences returns the names the flow may reference. Types without any names are not checked.
+func buildFlowReferences(d *schema.ResourceData) *flowReferences {
+	names := func(key string) []string {
+		set, ok := d.GetOk(key)
+		if !ok {
+			return nil
+		}
+		return *lists.SetToStringList(set.(*schema.Set))
+	}
+	return &flowReferences{
+		queues:      names("queue_names"),
+		prompts:     names("prompt_names"),
+		datatables:  names("datatable_names"),
+		dataActions: names("data_action_names"),
+	}
+}
+
+func flattenFlowIssues(issues []flowIssue) []interface{} {
+	flattened := make([]interface{}, len(issues))
+	for i, issue := range issues {
+		flattened[i] = map[string]interface{}{
+			"severity": issue.Severity,
+			"line":     issue.Line,
+			"column":   issue.Column,
+			"message":  issue.Message,
+		}
+	}
+	return flattened
+}
+
+// buildFlowIssueDiagnostics reports each issue against the filepath attribute. Errors are downgraded to warnings
+// when failOnError is false.
+func buildFlowIssueDiagnostics(filePath string, issues []flowIssue, failOnError bool) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	for _, issue := range issues {
+		severity := diag.Warning
+		if issue.Severity == flowIssueError && failOnError {
+			severity = diag.Error
+		}
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       fmt.Sprintf("Flow configuration %s", issue.Severity),
+			Detail:        issue.String(filePath),
+			AttributePath: cty.GetAttrPath("filepath"),
+		})
+	}
+	return diagnostics
+}
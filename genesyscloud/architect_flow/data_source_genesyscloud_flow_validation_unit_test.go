@@ -0,0 +1,124 @@
+package architect_flow
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testValidationFlow = `inboundCall:
+  name: {{flow_name}}
+  defaultLanguage: en-us
+  startUpRef: ./menus/menu[mainMenu]
+  initialGreeting:
+    exp: ToAudio(Prompt.Welcome)
+  menus:
+    - menu:
+        name: Main Menu
+        refId: mainMenu
+        audio:
+          exp: ToAudio(Prompt.MainMenu)
+        choices:
+          - menuTransferToAcd:
+              name: Sales
+              dtmf: digit_1
+              targetQueue:
+                lit:
+                  name: Sales Queue
+          - menuTask:
+              name: Lookup
+              dtmf: digit_2
+              task:
+                actions:
+                  - dataTableLookup:
+                      name: Lookup
+                      dataTable:
+                        Opening Hours:
+                          foundOutputs: {}
+                  - callData:
+                      name: Call Data
+                      dataAction:
+                        Get Customer:
+                          inputs: {}
+                  - transferToAcd:
+                      name: Transfer
+                      targetQueue:
+                        lit:
+                          name: {{support_queue}}
+`
+
+func TestUnitValidateFlowYaml(t *testing.T) {
+	content := applyFlowSubstitutions(testValidationFlow, map[string]interface{}{"flow_name": "Main IVR", "support_queue": "Support Queue"})
+	known := &flowReferences{
+		queues:      []string{"sales queue", "Support Queue"},
+		prompts:     []string{"Welcome", "MainMenu"},
+		datatables:  []string{"Opening Hours"},
+		dataActions: []string{"Get Customer"},
+	}
+
+	result := validateFlowYaml([]byte(content), known)
+	assert.Empty(t, result.issues)
+	assert.Equal(t, "inboundcall", result.flowType)
+	assert.Equal(t, "Main IVR", result.flowName)
+
+	// Unknown references and placeholders without a substitution are reported on the line they appear on
+	content = applyFlowSubstitutions(testValidationFlow, map[string]interface{}{"flow_name": "Main IVR"})
+	result = validateFlowYaml([]byte(content), &flowReferences{
+		queues:      []string{"Support Queue"},
+		prompts:     []string{"Welcome"},
+		datatables:  []string{},
+		dataActions: nil,
+	})
+	assert.True(t, result.hasErrors())
+	assert.Equal(t, []flowIssue{
+		{Severity: flowIssueError, Line: 12, Column: 16, Message: "prompt 'MainMenu' is not defined in the configuration"},
+		{Severity: flowIssueError, Line: 19, Column: 25, Message: "queue 'Sales Queue' is not defined in the configuration"},
+		{Severity: flowIssueError, Line: 28, Column: 25, Message: "datatable 'Opening Hours' is not defined in the configuration"},
+		{Severity: flowIssueError, Line: 39, Column: 33, Message: "no substitution was provided for '{{support_queue}}'"},
+	}, result.issues)
+
+	result = validateFlowYaml([]byte("inboundText:\n  name: Test\n"), nil)
+	assert.Equal(t, []flowIssue{{Severity: flowIssueError, Line: 1, Column: 1, Message: "unknown flow type 'inboundText'. Valid flow types are: bot, commonmodule, digitalbot, inboundcall, inboundchat, inboundemail, inboundshortmessage, outboundcall, inqueuecall, inqueueemail, inqueueshortmessage, speech, securecall, surveyinvite, voice, voicemail, voicesurvey, workflow, workitem"}}, result.issues)
+
+	result = validateFlowYaml([]byte("workflow:\n  description: Test\n"), nil)
+	assert.Equal(t, []flowIssue{{Severity: flowIssueError, Line: 1, Column: 1, Message: "the workflow configuration has no name"}}, result.issues)
+
+	result = validateFlowYaml([]byte("workflow:\n  name: Test\n  variables: [\n"), nil)
+	assert.True(t, result.hasErrors())
+	assert.Equal(t, 3, result.issues[0].Line)
+}
+
+func TestUnitDataSourceFlowValidationRead(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "flow.yaml")
+	if err := os.WriteFile(filePath, []byte(testValidationFlow), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dataSourceSchema := DataSourceFlowValidation().Schema
+	d := schema.TestResourceDataRaw(t, dataSourceSchema, map[string]interface{}{
+		"filepath":      filePath,
+		"substitutions": map[string]interface{}{"flow_name": "Main IVR", "support_queue": "Support Queue", "unused": "x"},
+		"queue_names":   []interface{}{"Support Queue"},
+	})
+
+	diags := dataSourceFlowValidationRead(context.Background(), d, nil)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, filePath+":19:25: queue 'Sales Queue' is not defined in the configuration", diags[0].Detail)
+	assert.Equal(t, filePath+": substitution 'unused' is not used in the flow configuration", diags[1].Detail)
+
+	assert.Equal(t, false, d.Get("valid").(bool))
+	assert.Equal(t, "inboundcall", d.Get("flow_type").(string))
+	assert.Equal(t, "Main IVR", d.Get("flow_name").(string))
+	assert.Equal(t, 2, d.Get("issues.#").(int))
+	assert.Equal(t, "warning", d.Get("issues.1.severity").(string))
+
+	// Errors are reported as warnings when fail_on_error is false
+	_ = d.Set("fail_on_error", false)
+	diags = dataSourceFlowValidationRead(context.Background(), d, nil)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 2)
+}
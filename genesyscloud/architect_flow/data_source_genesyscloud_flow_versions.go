@@ -0,0 +1,94 @@
+package architect_flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+func dataSourceFlowVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	p := getArchitectFlowProxy(sdkConfig)
+	flowId := d.Get("flow_id").(string)
+
+	flow, resp, err := p.GetFlow(ctx, flowId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read flow %s: %s", flowId, err), resp)
+	}
+	versions, resp, err := p.GetFlowVersions(ctx, flowId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to get versions of flow %s: %s", flowId, err), resp)
+	}
+
+	publishedVersion := ""
+	if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+		publishedVersion = *flow.PublishedVersion.Id
+	}
+
+	d.SetId(flowId)
+	_ = d.Set("published_version", publishedVersion)
+	_ = d.Set("versions", flattenFlowVersions(*versions, publishedVersion))
+	return nil
+}
+
+// flattenFlowVersions converts flow versions to the versions attribute, newest first
+func flattenFlowVersions(versions []platformclientv2.Flowversion, publishedVersion string) []interface{} {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareFlowVersions(stringValue(versions[i].Id), stringValue(versions[j].Id)) > 0
+	})
+
+	flattened := make([]interface{}, 0, len(versions))
+	for _, version := range versions {
+		versionMap := map[string]interface{}{
+			"version":               stringValue(version.Id),
+			"commit_version":        stringValue(version.CommitVersion),
+			"configuration_version": stringValue(version.ConfigurationVersion),
+			"debug":                 version.Debug != nil && *version.Debug,
+			"secure":                version.Secure != nil && *version.Secure,
+			"published":             version.Id != nil && *version.Id == publishedVersion,
+		}
+		if version.CreatedBy != nil && version.CreatedBy.Id != nil {
+			versionMap["created_by_id"] = *version.CreatedBy.Id
+		}
+		if version.DateCreated != nil {
+			versionMap["date_created"] = time.UnixMilli(int64(*version.DateCreated)).UTC().Format(time.RFC3339)
+		}
+		flattened = append(flattened, versionMap)
+	}
+	return flattened
+}
+
+// compareFlowVersions compares version numbers such as 9.0 and 10.0 numerically. It returns a negative number when a
+// is older than b, a positive number when a is newer and 0 when they are the same version.
+func compareFlowVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+	return 0
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,147 @@
+package architect_flow
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"gopkg.in/yaml.v3"
+)
+
+/*
+Exported flow configurations reference other objects in the org by name. So that an export can be applied to
+another org, those names are replaced with {{placeholders}} and the flow's substitutions point each placeholder at
+the exported resource, e.g. queue_Sales = "${genesyscloud_routing_queue.Sales.name}", or the exported data source.
+*/
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Keys of flow configuration objects that hold a literal reference, mapped to the type of the referenced resource
+var flowLiteralReferenceTypes = map[string]string{
+	"queue":         "genesyscloud_routing_queue",
+	"targetQueue":   "genesyscloud_routing_queue",
+	"scheduleGroup": "genesyscloud_architect_schedulegroups",
+	"division":      "genesyscloud_auth_division",
+}
+
+// Keys of flow configuration objects whose own keys are the names of the referenced resources
+var flowKeyedReferenceTypes = map[string]string{
+	"dataTable":  "genesyscloud_architect_datatable",
+	"dataAction": "genesyscloud_integration_action",
+}
+
+const flowPromptResourceType = "genesyscloud_architect_user_prompt"
+
+// flowReferenceLookup finds the resources of the export by name and by ID
+type flowReferenceLookup struct {
+	byName func(resourceType string, name string) (resourceExporter.ExportedResource, bool)
+	byId   func(id string) (resourceExporter.ExportedResource, bool)
+}
+
+type flowReferenceRewriter struct {
+	lookup        flowReferenceLookup
+	substitutions map[string]interface{}
+}
+
+// rewriteFlowReferences replaces the names and IDs of exported resources in a flow configuration with placeholders
+// and returns the substitutions that resolve them
+func rewriteFlowReferences(content []byte, lookup flowReferenceLookup) ([]byte, map[string]interface{}, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse flow configuration: %v", err)
+	}
+
+	r := &flowReferenceRewriter{lookup: lookup, substitutions: make(map[string]interface{})}
+	r.rewriteNode(&document)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return nil, nil, fmt.Errorf("failed to write flow configuration: %v", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), r.substitutions, nil
+}
+
+func (r *flowReferenceRewriter) rewriteNode(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			r.rewriteNode(child)
+		}
+	case yaml.ScalarNode:
+		r.rewriteScalar(node)
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			if resourceType, ok := flowLiteralReferenceTypes[keyNode.Value]; ok {
+				if nameNode := literalNameNode(valueNode); nameNode != nil {
+					r.replaceName(nameNode, resourceType)
+					continue
+				}
+			}
+			if resourceType, ok := flowKeyedReferenceTypes[keyNode.Value]; ok && valueNode.Kind == yaml.MappingNode {
+				for j := 0; j < len(valueNode.Content); j += 2 {
+					r.replaceName(valueNode.Content[j], resourceType)
+				}
+			}
+			r.rewriteNode(valueNode)
+		}
+	}
+}
+
+// literalNameNode returns the node holding the name of a literal reference written as
+// `key: name`, `key: { lit: name }` or `key: { lit: { name: name } }`
+func literalNameNode(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.ScalarNode {
+		return node
+	}
+	litNode := mappingValue(node, "lit")
+	if litNode != nil && litNode.Kind == yaml.MappingNode {
+		litNode = mappingValue(litNode, "name")
+	}
+	if litNode != nil && litNode.Kind == yaml.ScalarNode {
+		return litNode
+	}
+	return nil
+}
+
+func (r *flowReferenceRewriter) rewriteScalar(node *yaml.Node) {
+	if uuidRegex.MatchString(node.Value) {
+		if resource, ok := r.lookup.byId(node.Value); ok {
+			node.Value = r.placeholder(resource, "id")
+			node.Style = yaml.DoubleQuotedStyle
+		}
+		return
+	}
+	node.Value = promptReferenceRegex.ReplaceAllStringFunc(node.Value, func(reference string) string {
+		name := strings.TrimPrefix(reference, "Prompt.")
+		resource, ok := r.lookup.byName(flowPromptResourceType, name)
+		if !ok {
+			return reference
+		}
+		return "Prompt." + r.placeholder(resource, "name")
+	})
+}
+
+func (r *flowReferenceRewriter) replaceName(node *yaml.Node, resourceType string) {
+	if node.Kind != yaml.ScalarNode {
+		return
+	}
+	if resource, ok := r.lookup.byName(resourceType, node.Value); ok {
+		node.Value = r.placeholder(resource, "name")
+		node.Style = yaml.DoubleQuotedStyle
+	}
+}
+
+// placeholder records the substitution for an attribute of an exported resource and returns its {{placeholder}}
+func (r *flowReferenceRewriter) placeholder(resource resourceExporter.ExportedResource, attribute string) string {
+	key := fmt.Sprintf("%s_%s_%s", strings.TrimPrefix(resource.Type, "genesyscloud_"), resource.Label, attribute)
+	r.substitutions[key] = fmt.Sprintf("${%s.%s}", resource.Address(), attribute)
+	return "{{" + key + "}}"
+}
@@ -0,0 +1,265 @@
+package architect_flow
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+/*
+The flow YAML validator checks an Archy flow configuration locally, without calling the Genesys Cloud API. It reports
+problems with the line and column they were found on so that they can be fixed before the flow is uploaded to a
+deploy job.
+*/
+
+const (
+	flowIssueError   = "error"
+	flowIssueWarning = "warning"
+)
+
+var (
+	yamlErrorLineRegex     = regexp.MustCompile(`line (\d+)`)
+	unresolvedSubstitution = regexp.MustCompile(`{{\s*([^{}\s]+)\s*}}`)
+	promptReferenceRegex   = regexp.MustCompile(`\bPrompt\.([A-Za-z0-9_]+)`)
+)
+
+// flowIssue is a problem found in a flow configuration file
+type flowIssue struct {
+	Severity string
+	Line     int
+	Column   int
+	Message  string
+}
+
+// flowReferences holds the names of the objects a flow may reference. A nil list disables the check for that type.
+type flowReferences struct {
+	queues      []string
+	prompts     []string
+	datatables  []string
+	dataActions []string
+}
+
+type flowValidationResult struct {
+	flowType string
+	flowName string
+	issues   []flowIssue
+}
+
+func (r *flowValidationResult) hasErrors() bool {
+	for _, issue := range r.issues {
+		if issue.Severity == flowIssueError {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *flowValidationResult) addIssue(severity string, node *yaml.Node, format string, args ...any) {
+	issue := flowIssue{Severity: severity, Message: fmt.Sprintf(format, args...)}
+	if node != nil {
+		issue.Line = node.Line
+		issue.Column = node.Column
+	}
+	r.issues = append(r.issues, issue)
+}
+
+// applyFlowSubstitutions replaces {{key}} placeholders in the same way as the flow upload
+func applyFlowSubstitutions(content string, substitutions map[string]interface{}) string {
+	for k, v := range substitutions {
+		content = strings.Replace(content, fmt.Sprintf("{{%s}}", k), fmt.Sprintf("%v", v), -1)
+	}
+	return content
+}
+
+// checkUnusedSubstitutions warns about substitutions with no {{key}} placeholder in the original content, which
+// usually means the key is misspelt
+func checkUnusedSubstitutions(content string, substitutions map[string]interface{}, result *flowValidationResult) {
+	keys := make([]string, 0, len(substitutions))
+	for k := range substitutions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !strings.Contains(content, fmt.Sprintf("{{%s}}", k)) {
+			result.addIssue(flowIssueWarning, nil, "substitution '%s' is not used in the flow configuration", k)
+		}
+	}
+}
+
+// validateFlowYaml parses a flow configuration and checks its flow type, substitutions and references
+func validateFlowYaml(content []byte, known *flowReferences) *flowValidationResult {
+	result := &flowValidationResult{}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		issue := flowIssue{Severity: flowIssueError, Message: fmt.Sprintf("invalid YAML: %s", strings.TrimPrefix(err.Error(), "yaml: "))}
+		if match := yamlErrorLineRegex.FindStringSubmatch(err.Error()); match != nil {
+			issue.Line, _ = strconv.Atoi(match[1])
+		}
+		result.issues = append(result.issues, issue)
+		return result
+	}
+	if len(document.Content) == 0 {
+		result.addIssue(flowIssueError, nil, "the flow configuration is empty")
+		return result
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode || len(root.Content) == 0 {
+		result.addIssue(flowIssueError, root, "the flow configuration must be a map with the flow type as its only key")
+		return result
+	}
+	if len(root.Content) > 2 {
+		result.addIssue(flowIssueError, root.Content[2], "unexpected top level key '%s'. The flow configuration must have the flow type as its only key", root.Content[2].Value)
+	}
+
+	typeNode, flowNode := root.Content[0], root.Content[1]
+	result.flowType = strings.ToLower(typeNode.Value)
+	if !isValidFlowType(result.flowType) {
+		result.addIssue(flowIssueError, typeNode, "unknown flow type '%s'. Valid flow types are: %s", typeNode.Value, strings.Join(validFlowTypes, ", "))
+	}
+
+	if flowNode.Kind != yaml.MappingNode {
+		result.addIssue(flowIssueError, flowNode, "the %s configuration must be a map", typeNode.Value)
+	} else if nameNode := mappingValue(flowNode, "name"); nameNode == nil || nameNode.Value == "" {
+		result.addIssue(flowIssueError, typeNode, "the %s configuration has no name", typeNode.Value)
+	} else {
+		result.flowName = nameNode.Value
+	}
+
+	validateFlowNode(flowNode, known, result)
+	sort.SliceStable(result.issues, func(i, j int) bool {
+		if result.issues[i].Line != result.issues[j].Line {
+			return result.issues[i].Line < result.issues[j].Line
+		}
+		return result.issues[i].Column < result.issues[j].Column
+	})
+	return result
+}
+
+func isValidFlowType(flowType string) bool {
+	for _, validType := range validFlowTypes {
+		if validType == flowType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFlowNode walks the flow configuration checking for placeholders without a substitution and references
+// to objects that aren't known
+func validateFlowNode(node *yaml.Node, known *flowReferences, result *flowValidationResult) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		for _, match := range unresolvedSubstitution.FindAllStringSubmatch(node.Value, -1) {
+			result.addIssue(flowIssueError, node, "no substitution was provided for '{{%s}}'", match[1])
+		}
+		if known != nil && known.prompts != nil {
+			for _, match := range promptReferenceRegex.FindAllStringSubmatch(node.Value, -1) {
+				checkReference(result, node, "prompt", match[1], known.prompts)
+			}
+		}
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			validateFlowNode(child, known, result)
+		}
+	case yaml.MappingNode:
+		if name, ok := unquotedPlaceholder(node); ok {
+			result.addIssue(flowIssueError, node, "no substitution was provided for '{{%s}}'", name)
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			if known != nil {
+				checkMappingReferences(keyNode, valueNode, known, result)
+			}
+			validateFlowNode(keyNode, nil, result)
+			validateFlowNode(valueNode, known, result)
+		}
+	}
+}
+
+// unquotedPlaceholder reports whether a node is an unquoted {{key}} placeholder, which YAML reads as a flow mapping
+// holding another flow mapping
+func unquotedPlaceholder(node *yaml.Node) (string, bool) {
+	if node.Style&yaml.FlowStyle == 0 || len(node.Content) != 2 || node.Content[1].Tag != "!!null" {
+		return "", false
+	}
+	inner := node.Content[0]
+	if inner.Kind != yaml.MappingNode || inner.Style&yaml.FlowStyle == 0 || len(inner.Content) != 2 ||
+		inner.Content[0].Kind != yaml.ScalarNode || inner.Content[1].Tag != "!!null" {
+		return "", false
+	}
+	return inner.Content[0].Value, true
+}
+
+// checkMappingReferences checks the Archy constructs that reference queues, datatables and data actions by name:
+//
+//	targetQueue: { lit: { name: Queue Name } }
+//	dataTable: { Table Name: { ... } }
+//	dataAction: { Action Name: { ... } }
+func checkMappingReferences(keyNode *yaml.Node, valueNode *yaml.Node, known *flowReferences, result *flowValidationResult) {
+	switch keyNode.Value {
+	case "queue", "targetQueue":
+		if known.queues == nil {
+			return
+		}
+		if litNode := mappingValue(valueNode, "lit"); litNode != nil {
+			if litNode.Kind == yaml.MappingNode {
+				litNode = mappingValue(litNode, "name")
+			}
+			if litNode != nil && litNode.Kind == yaml.ScalarNode {
+				checkReference(result, litNode, "queue", litNode.Value, known.queues)
+			}
+		}
+	case "dataTable":
+		if known.datatables != nil && valueNode.Kind == yaml.MappingNode {
+			for i := 0; i < len(valueNode.Content); i += 2 {
+				checkReference(result, valueNode.Content[i], "datatable", valueNode.Content[i].Value, known.datatables)
+			}
+		}
+	case "dataAction":
+		if known.dataActions != nil && valueNode.Kind == yaml.MappingNode {
+			for i := 0; i < len(valueNode.Content); i += 2 {
+				checkReference(result, valueNode.Content[i], "data action", valueNode.Content[i].Value, known.dataActions)
+			}
+		}
+	}
+}
+
+func checkReference(result *flowValidationResult, node *yaml.Node, objectType string, name string, knownNames []string) {
+	if name == "" || unresolvedSubstitution.MatchString(name) {
+		return
+	}
+	for _, knownName := range knownNames {
+		if strings.EqualFold(knownName, name) {
+			return
+		}
+	}
+	result.addIssue(flowIssueError, node, "%s '%s' is not defined in the configuration", objectType, name)
+}
+
+// mappingValue returns the value of a key in a mapping node
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// String formats an issue as <file>:<line>:<column>: <message>
+func (i flowIssue) String(filePath string) string {
+	if i.Line == 0 {
+		return fmt.Sprintf("%s: %s", filePath, i.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", filePath, i.Line, i.Column, i.Message)
+}
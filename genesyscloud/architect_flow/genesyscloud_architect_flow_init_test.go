@@ -32,6 +32,8 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceArchitectFlow()
+	providerDataSources[validationDataSourceName] = DataSourceFlowValidation()
+	providerDataSources[versionsDataSourceName] = DataSourceFlowVersions()
 }
 
 // initTestResources initializes all test resources and data sources.
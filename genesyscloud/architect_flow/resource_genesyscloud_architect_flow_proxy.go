@@ -2,8 +2,11 @@ package architect_flow
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
@@ -18,6 +21,20 @@ type createArchitectFlowJobsFunc func(context.Context, *architectFlowProxy) (*pl
 type getArchitectFlowJobsFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Architectjobstateresponse, *platformclientv2.APIResponse, error)
 type getAllArchitectFlowsFunc func(context.Context, *architectFlowProxy, string, []string) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error)
 type getFlowIdByNameAndTypeFunc func(ctx context.Context, a *architectFlowProxy, name string, varType string) (id string, resp *platformclientv2.APIResponse, retryable bool, err error)
+type createFlowExportJobFunc func(ctx context.Context, a *architectFlowProxy, flowId string) (*flowExportJob, *platformclientv2.APIResponse, error)
+type getFlowExportJobFunc func(ctx context.Context, a *architectFlowProxy, jobId string) (*flowExportJob, *platformclientv2.APIResponse, error)
+type getFlowVersionsFunc func(ctx context.Context, a *architectFlowProxy, flowId string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error)
+type publishFlowVersionFunc func(ctx context.Context, a *architectFlowProxy, flowId string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error)
+
+// flowExportJob is the state of an Architect export job. The Go SDK has no model for the flow export API.
+type flowExportJob struct {
+	Id          string `json:"id"`
+	Status      string `json:"status"`
+	DownloadUrl string `json:"downloadUrl"`
+	Messages    []struct {
+		Text string `json:"text"`
+	} `json:"messages"`
+}
 
 type architectFlowProxy struct {
 	clientConfig *platformclientv2.Configuration
@@ -30,6 +47,10 @@ type architectFlowProxy struct {
 	createArchitectFlowJobsAttr createArchitectFlowJobsFunc
 	getArchitectFlowJobsAttr    getArchitectFlowJobsFunc
 	getFlowIdByNameAndTypeAttr  getFlowIdByNameAndTypeFunc
+	createFlowExportJobAttr     createFlowExportJobFunc
+	getFlowExportJobAttr        getFlowExportJobFunc
+	getFlowVersionsAttr         getFlowVersionsFunc
+	publishFlowVersionAttr      publishFlowVersionFunc
 
 	flowCache rc.CacheInterface[platformclientv2.Flow]
 }
@@ -48,6 +69,10 @@ func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *archit
 		createArchitectFlowJobsAttr: createArchitectFlowJobsFn,
 		getArchitectFlowJobsAttr:    getArchitectFlowJobsFn,
 		getFlowIdByNameAndTypeAttr:  getFlowIdByNameAndTypeFn,
+		createFlowExportJobAttr:     createFlowExportJobFn,
+		getFlowExportJobAttr:        getFlowExportJobFn,
+		getFlowVersionsAttr:         getFlowVersionsFn,
+		publishFlowVersionAttr:      publishFlowVersionFn,
 		flowCache:                   flowCache,
 	}
 }
@@ -83,6 +108,25 @@ func (a *architectFlowProxy) GetAllFlows(ctx context.Context, name string, varTy
 	return a.getAllArchitectFlowsAttr(ctx, a, name, varType)
 }
 
+// CreateFlowExportJob starts a job exporting the latest configuration of a flow as YAML
+func (a *architectFlowProxy) CreateFlowExportJob(ctx context.Context, flowId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	return a.createFlowExportJobAttr(ctx, a, flowId)
+}
+
+func (a *architectFlowProxy) GetFlowExportJob(ctx context.Context, jobId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	return a.getFlowExportJobAttr(ctx, a, jobId)
+}
+
+// GetFlowVersions returns all saved versions of a flow
+func (a *architectFlowProxy) GetFlowVersions(ctx context.Context, flowId string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	return a.getFlowVersionsAttr(ctx, a, flowId)
+}
+
+// PublishFlowVersion starts publishing a saved version of a flow. Publishing is asynchronous.
+func (a *architectFlowProxy) PublishFlowVersion(ctx context.Context, flowId string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+	return a.publishFlowVersionAttr(ctx, a, flowId, version)
+}
+
 func (a *architectFlowProxy) getFlowIdByNameAndType(ctx context.Context, name, varType string) (string, *platformclientv2.APIResponse, bool, error) {
 	return a.getFlowIdByNameAndTypeAttr(ctx, a, name, varType)
 }
@@ -128,8 +172,12 @@ func getFlowIdByNameAndTypeFn(ctx context.Context, a *architectFlowProxy, name,
 	return "", nil, true, noFlowsFoundErr
 }
 
-func getArchitectFlowFn(_ context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
-	flow := rc.GetCacheItem(p.flowCache, id)
+func getArchitectFlowFn(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllArchitectFlowsFn(ctx, p, "", nil)
+		return err
+	}
+	flow := rc.GetCacheItemOrHydrate(ctx, p.flowCache, id, hydrate)
 	if flow != nil {
 		return flow, nil, nil
 	}
@@ -180,8 +228,73 @@ func getAllArchitectFlowsFn(ctx context.Context, p *architectFlowProxy, name str
 	}
 
 	for _, flow := range totalFlows {
-		rc.SetCache(p.flowCache, *flow.Id, flow)
+		rc.SetCache(ctx, p.flowCache, *flow.Id, flow)
 	}
 
 	return &totalFlows, nil, nil
 }
+
+func getFlowVersionsFn(_ context.Context, p *architectFlowProxy, flowId string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allVersions []platformclientv2.Flowversion
+
+	for pageNum := 1; ; pageNum++ {
+		versions, resp, err := p.api.GetFlowVersions(flowId, pageNum, pageSize, false)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get page %d of versions of flow %s: %v", pageNum, flowId, err)
+		}
+		if versions.Entities == nil || len(*versions.Entities) == 0 {
+			break
+		}
+		allVersions = append(allVersions, *versions.Entities...)
+		if len(*versions.Entities) < pageSize {
+			break
+		}
+	}
+
+	return &allVersions, nil, nil
+}
+
+func publishFlowVersionFn(ctx context.Context, p *architectFlowProxy, flowId string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+	// Publishing changes the flow, so drop any copy of it cached during export
+	rc.DeleteCacheItem(ctx, p.flowCache, flowId)
+	return p.api.PostFlowsActionsPublish(flowId, version)
+}
+
+func createFlowExportJobFn(_ context.Context, p *architectFlowProxy, flowId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	body := map[string]interface{}{
+		"flows":      []map[string]interface{}{{"flow": map[string]string{"id": flowId}}},
+		"exportType": "Yaml",
+	}
+	return callFlowExportJobApi(p, http.MethodPost, "/api/v2/flows/export/jobs", body, nil)
+}
+
+func getFlowExportJobFn(_ context.Context, p *architectFlowProxy, jobId string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	return callFlowExportJobApi(p, http.MethodGet, "/api/v2/flows/export/jobs/"+jobId, nil, map[string]string{"expand": "messages"})
+}
+
+func callFlowExportJobApi(p *architectFlowProxy, method string, path string, body interface{}, queryParams map[string]string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+	apiClient := &p.clientConfig.APIClient
+
+	headerParams := make(map[string]string)
+	for key := range p.clientConfig.DefaultHeader {
+		headerParams[key] = p.clientConfig.DefaultHeader[key]
+	}
+	headerParams["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	response, err := apiClient.CallAPI(p.clientConfig.BasePath+path, method, body, headerParams, queryParams, nil, "", nil, "")
+	if err != nil {
+		return nil, response, err
+	}
+	if response.Error != nil {
+		return nil, response, errors.New(response.ErrorMessage)
+	}
+
+	var job flowExportJob
+	if err := json.Unmarshal(response.RawBody, &job); err != nil {
+		return nil, response, err
+	}
+	return &job, response, nil
+}
@@ -1,7 +1,9 @@
 package architect_flow
 
 import (
+	"regexp"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,12 +15,19 @@ import (
 )
 
 const (
-	resourceName = "genesyscloud_flow"
+	resourceName             = "genesyscloud_flow"
+	validationDataSourceName = "genesyscloud_flow_validation"
+	versionsDataSourceName   = "genesyscloud_flow_versions"
+
+	publishModePublish = "publish"
+	publishModeDebug   = "debug"
 )
 
 // SetRegistrar registers all resources, data sources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceArchitectFlow())
+	l.RegisterDataSource(validationDataSourceName, DataSourceFlowValidation())
+	l.RegisterDataSource(versionsDataSourceName, DataSourceFlowVersions())
 	l.RegisterResource(resourceName, ResourceArchitectFlow())
 	l.RegisterExporter(resourceName, ArchitectFlowExporter())
 }
@@ -27,12 +36,21 @@ func ArchitectFlowExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllFlows),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{},
+		ExcludedAttributes: []string{
+			"published_version",
+			"published_version_secure",
+		},
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"filepath": ResourceArchitectFlow().Schema["filepath"],
 		},
 		CustomFlowResolver: map[string]*resourceExporter.CustomFlowResolver{
 			"file_content_hash": {ResolverFunc: resourceExporter.FileContentHashResolver},
 		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: FlowYamlResolver,
+			SubDirectory:              "flows",
+			EnabledByAttribute:        "export_flows_as_yaml",
+		},
 	}
 }
 
@@ -44,6 +62,7 @@ func ResourceArchitectFlow() *schema.Resource {
 		UpdateContext: provider.UpdateWithPooledClient(updateFlow),
 		ReadContext:   provider.ReadWithPooledClient(readFlow),
 		DeleteContext: provider.DeleteWithPooledClient(deleteFlow),
+		CustomizeDiff: files.CustomizeTemplateValuesDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -77,16 +96,62 @@ func ResourceArchitectFlow() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"template_values": {
+				Description: `JSON object of values used to render the YAML file as a Go template, for example jsonencode({ queues = [...] }). Values can be nested objects and lists and are referenced as {{ .key }}.
+The helpers queueRef and scheduleGroupRef render the literal name/ID pair of a reference, promptRef renders a Prompt.<name> expression and toJson renders any value inline.
+Referencing a value that is not set fails the plan with an error naming the key. Cannot be used with 'substitutions'.`,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"substitutions"},
+			},
+			"template_delimiters": {
+				Description:  "The left and right delimiters of the template actions in the file, for example [\"[[\", \"]]\"]. Defaults to {{ and }}. Use other delimiters when the file contains a literal {{ or }}.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     2,
+				MaxItems:     2,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				RequiredWith: []string{"template_values"},
+			},
 			"force_unlock": {
 				Description: `Will perform a force unlock on an architect flow before beginning the publication process.  NOTE: The force unlock publishes the 'draft'
 				              architect flow and then publishes the flow named in this resource. This mirrors the behavior found in the archy CLI tool.`,
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"version": {
+				Description: `Saved version of the flow to publish, for example "3.0". Set this to a previous version to roll back without re-uploading the YAML file. When only this attribute changes the file is not deployed again; when the file also changes it is deployed as a new version and the pinned version is then republished.
+The versions of a flow are listed by the genesyscloud_flow_versions data source. If the published version is changed outside of Terraform, the next plan republishes the pinned version.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(flowVersionRegex, "must be a flow version number such as 3.0"),
+			},
+			"publish_mode": {
+				Description: `How a deployed file is published. "publish" makes the deployed version the published version. "debug" saves the deployed version so it can be tested with flow debugging and keeps the version that was published before the deploy live; publish it later by setting 'version' or switching back to "publish" with the next file change.
+A new flow is always published. Changing only this attribute doesn't deploy the file again. Cannot be used with 'version'.`,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       publishModePublish,
+				ValidateFunc:  validation.StringInSlice([]string{publishModePublish, publishModeDebug}, false),
+				ConflictsWith: []string{"version"},
+			},
+			"published_version": {
+				Description: "The version of the flow that is currently published.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_version_secure": {
+				Description: "Whether the published version of the flow is secure.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 		},
 	}
 }
 
+var flowVersionRegex = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
 var validFlowTypes = []string{
 	"bot",
 	"commonmodule",
@@ -128,3 +193,186 @@ func DataSourceArchitectFlow() *schema.Resource {
 		},
 	}
 }
+
+var flowValidationIssueResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"severity": {
+			Description: "Severity of the issue. Either `error` or `warning`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"line": {
+			Description: "Line of the flow configuration file the issue was found on. 0 when the issue applies to the whole file.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"column": {
+			Description: "Column of the flow configuration file the issue was found on.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"message": {
+			Description: "Description of the issue.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+func DataSourceFlowValidation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Validates a flow configuration file locally, without calling the Genesys Cloud API. The file is parsed after its substitutions are applied and checked for a valid flow type, a flow name, placeholders without a substitution and references to queues, prompts, datatables and data actions that are not defined in the configuration.
+Because the check is done when the data source is read, problems are reported at plan time with the line and column they were found on instead of when the flow deploy job fails.`,
+		ReadContext: dataSourceFlowValidationRead,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description:  "YAML file path for the flow configuration.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"substitutions": {
+				Description: "The substitutions that will be applied to the flow configuration by the `genesyscloud_flow` resource.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+			},
+			"template_values": {
+				Description:   "The template values that will be used to render the flow configuration by the `genesyscloud_flow` resource.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"substitutions"},
+			},
+			"template_delimiters": {
+				Description:  "The template delimiters that will be used to render the flow configuration by the `genesyscloud_flow` resource.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     2,
+				MaxItems:     2,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				RequiredWith: []string{"template_values"},
+			},
+			"queue_names": {
+				Description: "Names of the queues defined in the configuration. Queues referenced by the flow with a literal name must be in this list. References are not checked if this is not set.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"prompt_names": {
+				Description: "Names of the user prompts defined in the configuration. Prompts referenced by the flow as `Prompt.<name>` must be in this list. References are not checked if this is not set.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"datatable_names": {
+				Description: "Names of the datatables defined in the configuration. Datatables looked up by the flow must be in this list. References are not checked if this is not set.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"data_action_names": {
+				Description: "Names of the data actions defined in the configuration. Data actions called by the flow must be in this list. References are not checked if this is not set.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"fail_on_error": {
+				Description: "Fail the plan when the flow configuration has errors. When false, errors are reported as warnings and in `issues`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"valid": {
+				Description: "True when the flow configuration has no errors.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"flow_type": {
+				Description: "The flow type of the flow configuration, in lower case.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"flow_name": {
+				Description: "The name of the flow after substitutions are applied.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"issues": {
+				Description: "The errors and warnings found in the flow configuration, in the order they appear in the file.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        flowValidationIssueResource,
+			},
+		},
+	}
+}
+
+var flowVersionResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"version": {
+			Description: "Version number, for example `3.0`. This is the value to set as `version` on the `genesyscloud_flow` resource to publish it.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"commit_version": {
+			Description: "Commit version of the flow configuration.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"configuration_version": {
+			Description: "Version of the flow configuration format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_by_id": {
+			Description: "ID of the user that created the version.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"date_created": {
+			Description: "Date the version was created, in RFC 3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"debug": {
+			Description: "Whether the version is a debug version. Debug versions cannot be published.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"secure": {
+			Description: "Whether the version is secure.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"published": {
+			Description: "Whether this is the published version of the flow.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	},
+}
+
+func DataSourceFlowVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the version history of a Genesys Cloud Flow. Use it to find the version to pin with the `version` attribute of the `genesyscloud_flow` resource when rolling back.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceFlowVersionsRead),
+		Schema: map[string]*schema.Schema{
+			"flow_id": {
+				Description: "ID of the flow.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"published_version": {
+				Description: "The version of the flow that is currently published.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"versions": {
+				Description: "The saved versions of the flow, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        flowVersionResource,
+			},
+		},
+	}
+}
@@ -3,9 +3,11 @@ package architect_flow
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"path"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -44,6 +46,9 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 
 		//This is our go forward naming standard for flows.
 		resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.VarType + "_" + *flow.Name}
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			resources[*flow.Id].Version = *flow.PublishedVersion.Id
+		}
 	}
 
 	return resources, nil
@@ -66,6 +71,21 @@ func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		resourcedata.SetNillableValue(d, "name", flow.Name)
 		resourcedata.SetNillableValue(d, "type", flow.VarType)
 
+		publishedVersion := ""
+		if flow.PublishedVersion != nil {
+			resourcedata.SetNillableValue(d, "published_version_secure", flow.PublishedVersion.Secure)
+			if flow.PublishedVersion.Id != nil {
+				publishedVersion = *flow.PublishedVersion.Id
+			}
+		}
+		_ = d.Set("published_version", publishedVersion)
+
+		// Show a diff when the pinned version was unpublished outside of Terraform so that it is republished
+		if version := d.Get("version").(string); version != "" && version != publishedVersion {
+			log.Printf("Flow %s is pinned to version %s but version %s is published", d.Id(), version, publishedVersion)
+			_ = d.Set("version", publishedVersion)
+		}
+
 		log.Printf("Read flow %s %s", d.Id(), *flow.Name)
 		return nil
 	})
@@ -82,6 +102,17 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	log.Printf("Updating flow")
 
+	// Rolling back or forward to a saved version doesn't need the file to be deployed again
+	if d.Id() != "" && !d.HasChangesExcept("version", "publish_mode") {
+		return publishPinnedFlowVersion(ctx, d, meta, p)
+	}
+
+	fileTemplate, err := files.GetFileTemplate(d)
+	if err != nil {
+		setFileContentHashToNil(d)
+		return diag.FromErr(err)
+	}
+
 	//Check to see if we need to force and unlock on an architect flow
 	if isForceUnlockEnabled(d) {
 		resp, err := p.ForceUnlockFlow(ctx, d.Id())
@@ -91,6 +122,19 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
+	// A debug deploy republishes the version that is published now once the file is deployed
+	liveFlowId, liveVersion := d.Id(), ""
+	if d.Id() != "" && d.Get("publish_mode").(string) == publishModeDebug {
+		flow, resp, err := p.GetFlow(ctx, d.Id())
+		if err != nil {
+			setFileContentHashToNil(d)
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read flow %s: %s", d.Id(), err), resp)
+		}
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			liveVersion = *flow.PublishedVersion.Id
+		}
+	}
+
 	flowJob, response, err := p.CreateFlowsDeployJob(ctx)
 
 	if err != nil || response.Error != nil {
@@ -118,6 +162,7 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	s3Uploader := files.NewS3Uploader(reader, nil, substitutions, headers, "PUT", presignedUrl)
+	s3Uploader.SetTemplate(fileTemplate)
 
 	_, uploadErr := s3Uploader.UploadWithRetries(ctx, filePath, 20*time.Second)
 	if uploadErr != nil {
@@ -166,10 +211,67 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	d.SetId(flowID)
 
+	// Renaming the flow in the file deploys a new flow, which has no live version to keep
+	if liveVersion != "" && flowID == liveFlowId {
+		log.Printf("Republishing version %s of flow %s after deploying a debug version", liveVersion, d.Id())
+		if diagErr := publishFlowVersion(ctx, p, d.Id(), liveVersion, 5*time.Minute); diagErr != nil {
+			setFileContentHashToNil(d)
+			return diagErr
+		}
+	}
+
 	log.Printf("Updated flow %s. ", d.Id())
+	return publishPinnedFlowVersion(ctx, d, meta, p)
+}
+
+// publishPinnedFlowVersion publishes the saved version of the flow set in the version attribute, if it is not already
+// the published version, and then reads the flow
+func publishPinnedFlowVersion(ctx context.Context, d *schema.ResourceData, meta interface{}, p *architectFlowProxy) diag.Diagnostics {
+	version := d.Get("version").(string)
+	if version == "" {
+		return readFlow(ctx, d, meta)
+	}
+
+	flow, resp, err := p.GetFlow(ctx, d.Id())
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read flow %s: %s", d.Id(), err), resp)
+	}
+	if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil && *flow.PublishedVersion.Id == version {
+		return readFlow(ctx, d, meta)
+	}
+
+	log.Printf("Publishing version %s of flow %s", version, d.Id())
+	if diagErr := publishFlowVersion(ctx, p, d.Id(), version, 5*time.Minute); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Published version %s of flow %s", version, d.Id())
 	return readFlow(ctx, d, meta)
 }
 
+// publishFlowVersion publishes a saved version of a flow and waits for it to become the published version
+func publishFlowVersion(ctx context.Context, p *architectFlowProxy, flowId string, version string, timeout time.Duration) diag.Diagnostics {
+	_, resp, err := p.PublishFlowVersion(ctx, flowId, version)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to publish version %s of flow %s: %s", version, flowId, err), resp)
+	}
+
+	return util.WithRetries(ctx, timeout, func() *retry.RetryError {
+		flow, resp, err := p.GetFlow(ctx, flowId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read flow %s: %s", flowId, err), resp))
+		}
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil && *flow.PublishedVersion.Id == version {
+			return nil
+		}
+		if op := flow.CurrentOperation; op != nil && op.Complete != nil && *op.Complete && op.ErrorMessage != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("publishing version %s of flow %s failed: %s", version, flowId, *op.ErrorMessage), resp))
+		}
+		time.Sleep(2 * time.Second)
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("version %s of flow %s was not published in time", version, flowId), resp))
+	})
+}
+
 func deleteFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	p := getArchitectFlowProxy(sdkConfig)
@@ -216,3 +318,101 @@ func updateFile(filepath, content string) {
 
 	_, _ = file.WriteString(content)
 }
+
+// FlowYamlResolver exports the latest configuration of a published flow as YAML into the export directory. Names and
+// IDs of other exported resources in the configuration are replaced with substitutions that reference them.
+func FlowYamlResolver(flowId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}, resource resourceExporter.ResourceInfo) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	p := getArchitectFlowProxy(sdkConfig)
+	ctx := context.Background()
+
+	flow, _, err := p.GetFlow(ctx, flowId)
+	if err != nil {
+		return err
+	}
+	if flow.PublishedVersion == nil {
+		return fmt.Errorf("flow %s has not been published", flowId)
+	}
+
+	content, err := downloadFlowYaml(ctx, p, flowId)
+	if err != nil {
+		return err
+	}
+	content, substitutions, err := rewriteFlowReferences(content, flowReferenceLookup{
+		byName: resource.Exported.ByName,
+		byId: func(id string) (resourceExporter.ExportedResource, bool) {
+			return resource.Exported.ById(id)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	exportFileName := fmt.Sprintf("%s.yaml", resource.Name)
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path.Join(fullPath, exportFileName), content, 0644); err != nil {
+		return err
+	}
+
+	// Update filepath field in configMap to point to exported flow file
+	fileNameVal := path.Join(subDirectory, exportFileName)
+	configMap["filepath"] = fileNameVal
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, fileNameVal)
+	if len(substitutions) > 0 {
+		configMap["substitutions"] = substitutions
+	}
+
+	resource.State.Attributes["filepath"] = fileNameVal
+
+	hash, err := files.HashFileContent(path.Join(fullPath, exportFileName))
+	if err != nil {
+		log.Printf("Error Calculating Hash '%s' ", err)
+	} else {
+		resource.State.Attributes["file_content_hash"] = hash
+	}
+	return nil
+}
+
+// downloadFlowYaml runs an export job for a flow and downloads the YAML it produces
+func downloadFlowYaml(ctx context.Context, p *architectFlowProxy, flowId string) ([]byte, error) {
+	exportJob, resp, err := p.CreateFlowExportJob(ctx, flowId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start export job for flow %s: %v %v", flowId, err, resp)
+	}
+	jobId := exportJob.Id
+
+	var downloadUrl string
+	retryErr := util.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		exportJob, resp, err := p.GetFlowExportJob(ctx, jobId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error retrieving export job status. JobID: %s, error: %v %v", jobId, err, resp))
+		}
+
+		switch exportJob.Status {
+		case "Failure":
+			messages := make([]string, 0, len(exportJob.Messages))
+			for _, m := range exportJob.Messages {
+				messages = append(messages, m.Text)
+			}
+			return retry.NonRetryableError(fmt.Errorf("flow export failed. JobID: %s, tracing messages: %v", jobId, strings.Join(messages, "\n\n")))
+		case "Success":
+			downloadUrl = exportJob.DownloadUrl
+			return nil
+		}
+
+		time.Sleep(5 * time.Second)
+		return retry.RetryableError(fmt.Errorf("export job (%s) could not finish in 5 minutes and timed out", jobId))
+	})
+	if retryErr != nil {
+		return nil, fmt.Errorf("%v", retryErr)
+	}
+
+	reader, _, err := files.DownloadOrOpenFile(downloadUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download export of flow %s: %v", flowId, err)
+	}
+	return io.ReadAll(reader)
+}
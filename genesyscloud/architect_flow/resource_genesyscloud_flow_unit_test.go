@@ -0,0 +1,318 @@
+package architect_flow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+const testExportedFlow = `inboundCall:
+  name: Main IVR
+  division: Home
+  defaultLanguage: en-us
+  initialGreeting:
+    exp: ToAudio(Prompt.Welcome)
+  tasks:
+    - task:
+        name: Lookup
+        actions:
+          - dataTableLookup:
+              dataTable:
+                Opening Hours:
+                  foundOutputs: {}
+          - transferToAcd:
+              targetQueue:
+                lit:
+                  name: Sales Queue
+          - transferToAcd:
+              targetQueue:
+                lit:
+                  name: Unexported Queue
+          - setParticipantData:
+              attributeValue:
+                lit: 4f1c9b0e-2a11-4d3e-9d0e-1b7e8f6e6c8d
+`
+
+func testFlowReferenceLookup() flowReferenceLookup {
+	names := map[string]map[string]string{
+		"genesyscloud_routing_queue":         {"Sales Queue": "Sales_Queue"},
+		"genesyscloud_auth_division":         {"Home": "Home"},
+		"genesyscloud_architect_datatable":   {"Opening Hours": "Opening_Hours"},
+		"genesyscloud_architect_user_prompt": {"Welcome": "Welcome"},
+	}
+	return flowReferenceLookup{
+		byName: func(resourceType string, name string) (resourceExporter.ExportedResource, bool) {
+			label, ok := names[resourceType][name]
+			return resourceExporter.ExportedResource{Type: resourceType, Label: label, IsDataSource: resourceType == "genesyscloud_auth_division"}, ok
+		},
+		byId: func(id string) (resourceExporter.ExportedResource, bool) {
+			if id == "4f1c9b0e-2a11-4d3e-9d0e-1b7e8f6e6c8d" {
+				return resourceExporter.ExportedResource{Type: "genesyscloud_architect_schedule", Label: "Weekdays"}, true
+			}
+			return resourceExporter.ExportedResource{}, false
+		},
+	}
+}
+
+func TestUnitRewriteFlowReferences(t *testing.T) {
+	content, substitutions, err := rewriteFlowReferences([]byte(testExportedFlow), testFlowReferenceLookup())
+	assert.Nil(t, err)
+	assert.Equal(t, `inboundCall:
+  name: Main IVR
+  division: "{{auth_division_Home_name}}"
+  defaultLanguage: en-us
+  initialGreeting:
+    exp: ToAudio(Prompt.{{architect_user_prompt_Welcome_name}})
+  tasks:
+    - task:
+        name: Lookup
+        actions:
+          - dataTableLookup:
+              dataTable:
+                "{{architect_datatable_Opening_Hours_name}}":
+                  foundOutputs: {}
+          - transferToAcd:
+              targetQueue:
+                lit:
+                  name: "{{routing_queue_Sales_Queue_name}}"
+          - transferToAcd:
+              targetQueue:
+                lit:
+                  name: Unexported Queue
+          - setParticipantData:
+              attributeValue:
+                lit: "{{architect_schedule_Weekdays_id}}"
+`, string(content))
+	assert.Equal(t, map[string]interface{}{
+		"auth_division_Home_name":                "${data.genesyscloud_auth_division.Home.name}",
+		"architect_user_prompt_Welcome_name":     "${genesyscloud_architect_user_prompt.Welcome.name}",
+		"architect_datatable_Opening_Hours_name": "${genesyscloud_architect_datatable.Opening_Hours.name}",
+		"routing_queue_Sales_Queue_name":         "${genesyscloud_routing_queue.Sales_Queue.name}",
+		"architect_schedule_Weekdays_id":         "${genesyscloud_architect_schedule.Weekdays.id}",
+	}, substitutions)
+
+	// Applying the substitutions gives back the original configuration
+	resolved := string(content)
+	for key, value := range map[string]string{
+		"auth_division_Home_name":                "Home",
+		"architect_user_prompt_Welcome_name":     "Welcome",
+		"architect_datatable_Opening_Hours_name": "Opening Hours",
+		"routing_queue_Sales_Queue_name":         "Sales Queue",
+		"architect_schedule_Weekdays_id":         "4f1c9b0e-2a11-4d3e-9d0e-1b7e8f6e6c8d",
+	} {
+		resolved = applyFlowSubstitutions(resolved, map[string]interface{}{key: value})
+	}
+	result := validateFlowYaml([]byte(resolved), nil)
+	assert.Empty(t, result.issues)
+	assert.Equal(t, "Main IVR", result.flowName)
+}
+
+func TestUnitFlowYamlResolver(t *testing.T) {
+	flowId := uuid.NewString()
+	jobId := uuid.NewString()
+	versionId := "3.0"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testExportedFlow))
+	}))
+	defer server.Close()
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		return &platformclientv2.Flow{Id: &id, PublishedVersion: &platformclientv2.Flowversion{Id: &versionId}}, nil, nil
+	}
+	flowProxy.createFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		return &flowExportJob{Id: jobId, Status: "Started"}, nil, nil
+	}
+	flowProxy.getFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, jobId, id)
+		return &flowExportJob{Id: jobId, Status: "Success", DownloadUrl: server.URL + "/export.yaml"}, nil, nil
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	exportDir := t.TempDir()
+	configMap := map[string]interface{}{"filepath": "${var.genesyscloud_flow_inboundcall_Main_IVR_filepath}"}
+	resource := resourceExporter.ResourceInfo{
+		Name:  "inboundcall_Main_IVR",
+		Type:  resourceName,
+		State: &terraform.InstanceState{ID: flowId, Attributes: map[string]string{}},
+	}
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	err := FlowYamlResolver(flowId, exportDir, "flows", configMap, gcloud, resource)
+	assert.Nil(t, err)
+	assert.Equal(t, "flows/inboundcall_Main_IVR.yaml", configMap["filepath"])
+	assert.Equal(t, `${filesha256("flows/inboundcall_Main_IVR.yaml")}`, configMap["file_content_hash"])
+	assert.Equal(t, "flows/inboundcall_Main_IVR.yaml", resource.State.Attributes["filepath"])
+	assert.NotEmpty(t, resource.State.Attributes["file_content_hash"])
+
+	content, err := os.ReadFile(filepath.Join(exportDir, "flows", "inboundcall_Main_IVR.yaml"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "name: Main IVR")
+}
+
+func TestUnitPublishPinnedFlowVersion(t *testing.T) {
+	flowId := uuid.NewString()
+	publishedVersion := "5.0"
+	publishCalls := 0
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		name, flowType, version := "Main IVR", "inboundcall", publishedVersion
+		return &platformclientv2.Flow{Id: &id, Name: &name, VarType: &flowType, PublishedVersion: &platformclientv2.Flowversion{Id: &version}}, nil, nil
+	}
+	flowProxy.publishFlowVersionAttr = func(ctx context.Context, p *architectFlowProxy, id string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		publishCalls++
+		publishedVersion = version
+		return &platformclientv2.Operation{}, nil, nil
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceArchitectFlow().Schema, map[string]interface{}{"version": "3.0"})
+	d.SetId(flowId)
+
+	// Rolling back publishes the pinned version
+	diagErr := publishPinnedFlowVersion(context.Background(), d, gcloud, flowProxy)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, 1, publishCalls)
+	assert.Equal(t, "3.0", d.Get("published_version"))
+	assert.Equal(t, "3.0", d.Get("version"))
+
+	// The pinned version is not published again when it is already published
+	diagErr = publishPinnedFlowVersion(context.Background(), d, gcloud, flowProxy)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, 1, publishCalls)
+
+	// Publishing another version outside of Terraform shows as a change to the pinned version
+	publishedVersion = "6.0"
+	diagErr = readFlow(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, "6.0", d.Get("version"))
+}
+
+func TestUnitDataSourceFlowVersionsRead(t *testing.T) {
+	flowId := uuid.NewString()
+	publishedVersion := "10.0"
+	debug := true
+	dateCreated := 1700000000000
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Flow{Id: &id, PublishedVersion: &platformclientv2.Flowversion{Id: &publishedVersion}}, nil, nil
+	}
+	flowProxy.getFlowVersionsAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		versions := []platformclientv2.Flowversion{
+			{Id: platformclientv2.String("9.0"), DateCreated: &dateCreated},
+			{Id: platformclientv2.String("11.0"), Debug: &debug},
+			{Id: platformclientv2.String("10.0")},
+		}
+		return &versions, nil, nil
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, DataSourceFlowVersions().Schema, map[string]interface{}{"flow_id": flowId})
+
+	diagErr := dataSourceFlowVersionsRead(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, flowId, d.Id())
+	assert.Equal(t, "10.0", d.Get("published_version"))
+
+	versions := d.Get("versions").([]interface{})
+	assert.Len(t, versions, 3)
+	var order []string
+	for _, v := range versions {
+		order = append(order, v.(map[string]interface{})["version"].(string))
+	}
+	assert.Equal(t, []string{"11.0", "10.0", "9.0"}, order)
+	assert.Equal(t, true, versions[0].(map[string]interface{})["debug"])
+	assert.Equal(t, true, versions[1].(map[string]interface{})["published"])
+	assert.Equal(t, "2023-11-14T22:13:20Z", versions[2].(map[string]interface{})["date_created"])
+}
+
+func TestUnitUpdateFlowDebugPublishMode(t *testing.T) {
+	flowId := uuid.NewString()
+	publishedVersion := "5.0"
+	var publishedVersions []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The deploy job publishes the deployed file as a new version
+		publishedVersion = "6.0"
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		name, flowType, version := "Main IVR", "inboundcall", publishedVersion
+		return &platformclientv2.Flow{Id: &id, Name: &name, VarType: &flowType, PublishedVersion: &platformclientv2.Flowversion{Id: &version}}, nil, nil
+	}
+	flowProxy.createArchitectFlowJobsAttr = func(ctx context.Context, p *architectFlowProxy) (*platformclientv2.Registerarchitectjobresponse, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Registerarchitectjobresponse{
+			Id:           platformclientv2.String(uuid.NewString()),
+			PresignedUrl: platformclientv2.String(server.URL),
+			Headers:      &map[string]string{},
+		}, &platformclientv2.APIResponse{}, nil
+	}
+	flowProxy.getArchitectFlowJobsAttr = func(ctx context.Context, p *architectFlowProxy, jobId string) (*platformclientv2.Architectjobstateresponse, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Architectjobstateresponse{
+			Status: platformclientv2.String("Success"),
+			Flow:   &platformclientv2.Addressableentityref{Id: &flowId},
+		}, nil, nil
+	}
+	flowProxy.publishFlowVersionAttr = func(ctx context.Context, p *architectFlowProxy, id string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+		publishedVersions = append(publishedVersions, version)
+		publishedVersion = version
+		return &platformclientv2.Operation{}, nil, nil
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	filePath := filepath.Join(t.TempDir(), "flow.yaml")
+	assert.Nil(t, os.WriteFile(filePath, []byte(testExportedFlow), 0644))
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	newResourceData := func(publishMode string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, ResourceArchitectFlow().Schema, map[string]interface{}{
+			"filepath":          filePath,
+			"file_content_hash": "hash",
+			"publish_mode":      publishMode,
+		})
+		d.SetId(flowId)
+		return d
+	}
+
+	// A debug deploy keeps the version that was published before the deploy live
+	d := newResourceData(publishModeDebug)
+	diagErr := updateFlow(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, []string{"5.0"}, publishedVersions)
+	assert.Equal(t, "5.0", d.Get("published_version"))
+
+	// The deployed version is published by default
+	publishedVersions = nil
+	d = newResourceData(publishModePublish)
+	diagErr = updateFlow(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Empty(t, publishedVersions)
+	assert.Equal(t, "6.0", d.Get("published_version"))
+}
@@ -106,7 +106,7 @@ func createArchitectGrammarFn(_ context.Context, p *architectGrammarProxy, gramm
 }
 
 // getAllArchitectGrammarFn is the implementation for retrieving all Architect Grammars in Genesys Cloud
-func getAllArchitectGrammarFn(_ context.Context, p *architectGrammarProxy) (*[]platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
+func getAllArchitectGrammarFn(ctx context.Context, p *architectGrammarProxy) (*[]platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
 	var allGrammars []platformclientv2.Grammar
 
 	grammars, resp, err := p.architectApi.GetArchitectGrammars(1, 100, "", "", []string{}, "", "", "", true)
@@ -134,15 +134,19 @@ func getAllArchitectGrammarFn(_ context.Context, p *architectGrammarProxy) (*[]p
 	}
 
 	for _, grammar := range allGrammars {
-		rc.SetCache(p.grammarCache, *grammar.Id, grammar)
+		rc.SetCache(ctx, p.grammarCache, *grammar.Id, grammar)
 	}
 
 	return &allGrammars, resp, nil
 }
 
 // getArchitectGrammarByIdFn is an implementation of the function to get a Genesys Cloud Architect Grammar by ID
-func getArchitectGrammarByIdFn(_ context.Context, p *architectGrammarProxy, grammarId string) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
-	grammar := rc.GetCacheItem(p.grammarCache, grammarId)
+func getArchitectGrammarByIdFn(ctx context.Context, p *architectGrammarProxy, grammarId string) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllArchitectGrammarFn(ctx, p)
+		return err
+	}
+	grammar := rc.GetCacheItemOrHydrate(ctx, p.grammarCache, grammarId, hydrate)
 	if grammar != nil {
 		return grammar, nil, nil
 	}
@@ -122,8 +122,12 @@ func createArchitectGrammarLanguageFn(_ context.Context, p *architectGrammarLang
 }
 
 // getArchitectGrammarLanguageByIdFn is an implementation of the function to get a Genesys Cloud Architect Grammar Language by ID
-func getArchitectGrammarLanguageByIdFn(_ context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
-	language := rc.GetCacheItem(p.grammarLanguageCache, fmt.Sprintf("%s:%s", grammarId, languageCode))
+func getArchitectGrammarLanguageByIdFn(ctx context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllArchitectGrammarLanguageFn(ctx, p)
+		return err
+	}
+	language := rc.GetCacheItemOrHydrate(ctx, p.grammarLanguageCache, fmt.Sprintf("%s:%s", grammarId, languageCode), hydrate)
 	if language != nil {
 		return language, nil, nil
 	}
@@ -202,7 +206,7 @@ func uploadGrammarLanguageFile(p *architectGrammarLanguageProxy, language *platf
 }
 
 // getAllArchitectGrammarLanguageFn is the implementation for retrieving all Architect Grammars in Genesys Cloud
-func getAllArchitectGrammarLanguageFn(_ context.Context, p *architectGrammarLanguageProxy) (*[]platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
+func getAllArchitectGrammarLanguageFn(ctx context.Context, p *architectGrammarLanguageProxy) (*[]platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
 	var allLanguages []platformclientv2.Grammarlanguage
 
 	grammars, resp, err := p.architectApi.GetArchitectGrammars(1, 100, "", "", []string{}, "", "", "", true)
@@ -239,7 +243,7 @@ func getAllArchitectGrammarLanguageFn(_ context.Context, p *architectGrammarLang
 	}
 
 	for _, language := range allLanguages {
-		rc.SetCache(p.grammarLanguageCache, fmt.Sprintf("%s:%s", *language.GrammarId, *language.Language), language)
+		rc.SetCache(ctx, p.grammarLanguageCache, fmt.Sprintf("%s:%s", *language.GrammarId, *language.Language), language)
 	}
 
 	return &allLanguages, resp, nil
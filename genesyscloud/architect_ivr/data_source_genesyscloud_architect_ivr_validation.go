@@ -0,0 +1,476 @@
+package architect_ivr
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+const (
+	ivrIssueError   = "error"
+	ivrIssueWarning = "warning"
+
+	// The maximum number of occurrences of a single schedule that are checked for overlaps
+	maxValidationOccurrences = 50000
+)
+
+type ivrIssue struct {
+	Severity string
+	Subject  string
+	Message  string
+}
+
+// validationIvr is an IVR of the configuration, or one that exists in the org
+type validationIvr struct {
+	name     string
+	dnis     []string
+	existing bool
+}
+
+type didRange struct {
+	start string
+	end   string
+}
+
+// contains checks if an E.164 number is in the range
+func (r didRange) contains(number string) bool {
+	return util.IsNumberInDidRange(number, r.start, r.end)
+}
+
+// validationScheduleGroup is a schedule group with the schedules it references in each state
+type validationScheduleGroup struct {
+	name      string
+	timeZone  string
+	schedules map[string][]platformclientv2.Schedule
+}
+
+// scheduleWindow is a single occurrence of a schedule
+type scheduleWindow struct {
+	schedule string
+	start    time.Time
+	end      time.Time
+}
+
+func dataSourceArchitectIvrValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectIvrProxy(sdkConfig)
+
+	ivrs := make([]validationIvr, 0)
+	for _, ivr := range d.Get("ivr").([]interface{}) {
+		ivrMap := ivr.(map[string]interface{})
+		dnis := *lists.SetToStringList(ivrMap["dnis"].(*schema.Set))
+		sort.Strings(dnis)
+		ivrs = append(ivrs, validationIvr{name: ivrMap["name"].(string), dnis: dnis})
+	}
+	didRanges := make([]didRange, 0)
+	for _, pool := range d.Get("did_pool").([]interface{}) {
+		poolMap := pool.(map[string]interface{})
+		didRanges = append(didRanges, didRange{start: poolMap["start_phone_number"].(string), end: poolMap["end_phone_number"].(string)})
+	}
+
+	checkOrg := d.Get("check_org").(bool)
+	if checkOrg {
+		orgIvrs, resp, err := proxy.getAllArchitectIvrs(ctx, "")
+		if err != nil {
+			return util.BuildAPIDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to get IVRs: %s", err), resp)
+		}
+		for _, orgIvr := range *orgIvrs {
+			if orgIvr.Name == nil || orgIvr.Dnis == nil || isConfiguredIvr(*orgIvr.Name, ivrs) {
+				continue
+			}
+			ivrs = append(ivrs, validationIvr{name: *orgIvr.Name, dnis: *orgIvr.Dnis, existing: true})
+		}
+
+		didPools, resp, err := proxy.getAllDidPools(ctx)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to get DID pools: %s", err), resp)
+		}
+		for _, pool := range *didPools {
+			if pool.StartPhoneNumber != nil && pool.EndPhoneNumber != nil {
+				didRanges = append(didRanges, didRange{start: *pool.StartPhoneNumber, end: *pool.EndPhoneNumber})
+			}
+		}
+	}
+
+	issues := checkIvrDnis(ivrs, didRanges, checkOrg || len(didRanges) > 0)
+
+	for _, groupConfig := range d.Get("schedule_group").([]interface{}) {
+		group, err := buildValidationScheduleGroup(groupConfig.(map[string]interface{}))
+		if err != nil {
+			return util.BuildDiagnosticError(validationDataSourceName, "Failed to read schedule group", err)
+		}
+		groupIssues, err := checkScheduleGroup(group, d.Get("start_date").(string), d.Get("horizon_days").(int))
+		if err != nil {
+			return util.BuildDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to check schedule group %s", group.name), err)
+		}
+		issues = append(issues, groupIssues...)
+	}
+
+	groupIds, diagErr := getValidationScheduleGroupIds(ctx, proxy, d)
+	if diagErr != nil {
+		return diagErr
+	}
+	schedules := make(map[string]*platformclientv2.Schedule)
+	for _, groupId := range groupIds {
+		group, diagErr := getValidationScheduleGroup(ctx, proxy, groupId, schedules)
+		if diagErr != nil {
+			return diagErr
+		}
+		groupIssues, err := checkScheduleGroup(group, d.Get("start_date").(string), d.Get("horizon_days").(int))
+		if err != nil {
+			return util.BuildDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to check schedule group %s", groupId), err)
+		}
+		issues = append(issues, groupIssues...)
+	}
+
+	valid := true
+	for _, issue := range issues {
+		if issue.Severity == ivrIssueError {
+			valid = false
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprint(d.Get("ivr"), d.Get("did_pool"), d.Get("schedule_group"), d.Get("ivr_ids"), d.Get("schedule_group_ids"))))))
+	_ = d.Set("valid", valid)
+	_ = d.Set("issues", flattenIvrIssues(issues))
+
+	return buildIvrIssueDiagnostics(issues, d.Get("fail_on_error").(bool))
+}
+
+// buildValidationScheduleGroup builds a schedule group of the configuration. The start and end of its schedules are wall
+// clock times, which are placed in the time zone of the group when the schedules are checked.
+func buildValidationScheduleGroup(groupConfig map[string]interface{}) (validationScheduleGroup, error) {
+	group := validationScheduleGroup{
+		name:      groupConfig["name"].(string),
+		timeZone:  groupConfig["time_zone"].(string),
+		schedules: make(map[string][]platformclientv2.Schedule),
+	}
+	for _, state := range []string{"open", "closed", "holiday"} {
+		for _, scheduleConfig := range groupConfig[state+"_schedule"].([]interface{}) {
+			scheduleMap := scheduleConfig.(map[string]interface{})
+			name := scheduleMap["name"].(string)
+			start, err := time.Parse(resourcedata.TimeParseFormat, scheduleMap["start"].(string))
+			if err != nil {
+				return validationScheduleGroup{}, fmt.Errorf("schedule %s has an invalid start: %v", name, err)
+			}
+			end, err := time.Parse(resourcedata.TimeParseFormat, scheduleMap["end"].(string))
+			if err != nil {
+				return validationScheduleGroup{}, fmt.Errorf("schedule %s has an invalid end: %v", name, err)
+			}
+			schedule := platformclientv2.Schedule{Name: &name, Start: &start, End: &end}
+			if rule := scheduleMap["rrule"].(string); rule != "" {
+				schedule.Rrule = &rule
+			}
+			group.schedules[state] = append(group.schedules[state], schedule)
+		}
+	}
+	return group, nil
+}
+
+// getValidationScheduleGroupIds returns the IDs of the schedule groups to check, which are the schedule groups of the IVRs
+// and the schedule groups given directly
+func getValidationScheduleGroupIds(ctx context.Context, proxy *architectIvrProxy, d *schema.ResourceData) ([]string, diag.Diagnostics) {
+	groupIds := *lists.SetToStringList(d.Get("schedule_group_ids").(*schema.Set))
+	ivrIds := *lists.SetToStringList(d.Get("ivr_ids").(*schema.Set))
+	sort.Strings(ivrIds)
+	for _, ivrId := range ivrIds {
+		ivr, resp, err := proxy.getArchitectIvr(ctx, ivrId)
+		if err != nil {
+			return nil, util.BuildAPIDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to read IVR %s: %s", ivrId, err), resp)
+		}
+		if ivr.ScheduleGroup == nil || ivr.ScheduleGroup.Id == nil {
+			log.Printf("IVR %s has no schedule group to check", ivrId)
+			continue
+		}
+		if !lists.ItemInSlice(*ivr.ScheduleGroup.Id, groupIds) {
+			groupIds = append(groupIds, *ivr.ScheduleGroup.Id)
+		}
+	}
+	sort.Strings(groupIds)
+	return groupIds, nil
+}
+
+// getValidationScheduleGroup reads a schedule group and its schedules. Schedules are kept in schedules, as schedule
+// groups can share them.
+func getValidationScheduleGroup(ctx context.Context, proxy *architectIvrProxy, id string, schedules map[string]*platformclientv2.Schedule) (validationScheduleGroup, diag.Diagnostics) {
+	scheduleGroup, resp, err := proxy.getScheduleGroup(ctx, id)
+	if err != nil {
+		return validationScheduleGroup{}, util.BuildAPIDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to read schedule group %s: %s", id, err), resp)
+	}
+
+	group := validationScheduleGroup{name: id, schedules: make(map[string][]platformclientv2.Schedule)}
+	if scheduleGroup.Name != nil {
+		group.name = *scheduleGroup.Name
+	}
+	if scheduleGroup.TimeZone != nil {
+		group.timeZone = *scheduleGroup.TimeZone
+	}
+	refsByState := map[string]*[]platformclientv2.Domainentityref{
+		"open":    scheduleGroup.OpenSchedules,
+		"closed":  scheduleGroup.ClosedSchedules,
+		"holiday": scheduleGroup.HolidaySchedules,
+	}
+	for state, refs := range refsByState {
+		if refs == nil {
+			continue
+		}
+		for _, ref := range *refs {
+			if ref.Id == nil {
+				continue
+			}
+			schedule, ok := schedules[*ref.Id]
+			if !ok {
+				schedule, resp, err = proxy.getSchedule(ctx, *ref.Id)
+				if err != nil {
+					return validationScheduleGroup{}, util.BuildAPIDiagnosticError(validationDataSourceName, fmt.Sprintf("Failed to read schedule %s of schedule group %s: %s", *ref.Id, id, err), resp)
+				}
+				schedules[*ref.Id] = schedule
+			}
+			group.schedules[state] = append(group.schedules[state], *schedule)
+		}
+	}
+	return group, nil
+}
+
+func isConfiguredIvr(name string, ivrs []validationIvr) bool {
+	for _, ivr := range ivrs {
+		if !ivr.existing && ivr.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIvrDnis reports DNIS claimed by more than one IVR and, when checkPools is set, DNIS of the configuration that are
+// not in any DID pool. Conflicts between IVRs that only exist in the org are not reported.
+func checkIvrDnis(ivrs []validationIvr, didRanges []didRange, checkPools bool) []ivrIssue {
+	issues := make([]ivrIssue, 0)
+	claims := make(map[string][]validationIvr)
+	numbers := make([]string, 0)
+	for _, ivr := range ivrs {
+		for _, number := range ivr.dnis {
+			if _, ok := claims[number]; !ok {
+				numbers = append(numbers, number)
+			}
+			claims[number] = append(claims[number], ivr)
+		}
+	}
+	sort.Strings(numbers)
+
+	for _, number := range numbers {
+		configured := false
+		names := make([]string, 0, len(claims[number]))
+		for _, ivr := range claims[number] {
+			if ivr.existing {
+				names = append(names, fmt.Sprintf("%s (existing in the org)", ivr.name))
+			} else {
+				configured = true
+				names = append(names, ivr.name)
+			}
+		}
+		if !configured {
+			continue
+		}
+		subject := fmt.Sprintf("DNIS %s", number)
+		if len(names) > 1 {
+			issues = append(issues, ivrIssue{
+				Severity: ivrIssueError,
+				Subject:  subject,
+				Message:  fmt.Sprintf("%s is claimed by more than one IVR: %s", number, strings.Join(names, ", ")),
+			})
+		}
+		if checkPools && !inDidPool(number, didRanges) {
+			issues = append(issues, ivrIssue{
+				Severity: ivrIssueError,
+				Subject:  subject,
+				Message:  fmt.Sprintf("%s of IVR %s is not in any DID pool", number, strings.Join(names, ", ")),
+			})
+		}
+	}
+	return issues
+}
+
+func inDidPool(number string, didRanges []didRange) bool {
+	for _, r := range didRanges {
+		if r.contains(number) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkScheduleGroup reports schedules with an empty window, a group that is never open and open schedules that overlap
+// closed schedules over the horizon
+func checkScheduleGroup(group validationScheduleGroup, startDate string, horizonDays int) ([]ivrIssue, error) {
+	subject := fmt.Sprintf("schedule group %s", group.name)
+	loc, err := time.LoadLocation(group.timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Now().In(loc)
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	if startDate != "" {
+		if from, err = time.ParseInLocation(resourcedata.DateParseFormat, startDate, loc); err != nil {
+			return nil, err
+		}
+	}
+	to := from.AddDate(0, 0, horizonDays)
+	period := fmt.Sprintf("between %s and %s", from.Format(resourcedata.DateParseFormat), to.AddDate(0, 0, -1).Format(resourcedata.DateParseFormat))
+
+	issues := make([]ivrIssue, 0)
+	windows := make(map[string][]scheduleWindow)
+	unchecked := make(map[string]bool)
+	for _, state := range []string{"open", "closed", "holiday"} {
+		for _, schedule := range group.schedules[state] {
+			scheduleWindows, issue := buildScheduleWindows(schedule, loc, from, to)
+			if issue != nil {
+				issue.Subject = subject
+				issues = append(issues, *issue)
+				unchecked[state] = true
+				continue
+			}
+			if len(scheduleWindows) == 0 {
+				issues = append(issues, ivrIssue{
+					Severity: ivrIssueWarning,
+					Subject:  subject,
+					Message:  fmt.Sprintf("%s schedule %s has no occurrences %s", state, scheduleName(schedule), period),
+				})
+			}
+			windows[state] = append(windows[state], scheduleWindows...)
+		}
+	}
+
+	if len(group.schedules["open"]) == 0 {
+		issues = append(issues, ivrIssue{Severity: ivrIssueError, Subject: subject, Message: fmt.Sprintf("schedule group %s has no open schedules", group.name)})
+	} else if len(windows["open"]) == 0 && !unchecked["open"] {
+		issues = append(issues, ivrIssue{Severity: ivrIssueError, Subject: subject, Message: fmt.Sprintf("schedule group %s is never open %s", group.name, period)})
+	}
+
+	for _, overlap := range findOverlaps(windows["open"], windows["closed"]) {
+		issues = append(issues, ivrIssue{
+			Severity: ivrIssueError,
+			Subject:  subject,
+			Message:  fmt.Sprintf("open schedule %s overlaps closed schedule %s, first at %s", overlap[0].schedule, overlap[1].schedule, rrule.Latest(overlap[0].start, overlap[1].start).Format(time.RFC3339)),
+		})
+	}
+	return issues, nil
+}
+
+// buildScheduleWindows returns the occurrences of a schedule that overlap the period. An issue is returned for a
+// schedule that cannot be evaluated.
+func buildScheduleWindows(schedule platformclientv2.Schedule, loc *time.Location, from, to time.Time) ([]scheduleWindow, *ivrIssue) {
+	name := scheduleName(schedule)
+	if schedule.Start == nil || schedule.End == nil {
+		return nil, &ivrIssue{Severity: ivrIssueError, Message: fmt.Sprintf("schedule %s has no start or end", name)}
+	}
+	if !schedule.End.After(*schedule.Start) {
+		return nil, &ivrIssue{Severity: ivrIssueError, Message: fmt.Sprintf("schedule %s has an empty window: its end %s is not after its start %s", name, schedule.End.Format(resourcedata.TimeParseFormat), schedule.Start.Format(resourcedata.TimeParseFormat))}
+	}
+	rule := ""
+	if schedule.Rrule != nil && strings.TrimSpace(*schedule.Rrule) != "" {
+		rule = *schedule.Rrule
+		if _, err := rrule.Parse(rule); err != nil {
+			return nil, &ivrIssue{Severity: ivrIssueError, Message: fmt.Sprintf("schedule %s has an invalid rrule: %v", name, err)}
+		}
+	}
+
+	occurrences, err := rrule.Occurrences(*schedule.Start, *schedule.End, rule, loc, from, to, maxValidationOccurrences)
+	if err != nil {
+		return nil, &ivrIssue{Severity: ivrIssueWarning, Message: fmt.Sprintf("schedule %s was not checked: %v", name, err)}
+	}
+	windows := make([]scheduleWindow, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		windows = append(windows, scheduleWindow{schedule: name, start: occurrence.Start, end: occurrence.End})
+	}
+	return windows, nil
+}
+
+func scheduleName(schedule platformclientv2.Schedule) string {
+	if schedule.Name != nil {
+		return *schedule.Name
+	}
+	if schedule.Id != nil {
+		return *schedule.Id
+	}
+	return ""
+}
+
+// findOverlaps returns the first overlapping window of each pair of schedules whose windows overlap
+func findOverlaps(a, b []scheduleWindow) [][2]scheduleWindow {
+	sortWindows := func(windows []scheduleWindow) {
+		sort.SliceStable(windows, func(i, j int) bool { return windows[i].start.Before(windows[j].start) })
+	}
+	sortWindows(a)
+	sortWindows(b)
+
+	var longest time.Duration
+	for _, window := range b {
+		if duration := window.end.Sub(window.start); duration > longest {
+			longest = duration
+		}
+	}
+
+	overlaps := make([][2]scheduleWindow, 0)
+	seen := make(map[[2]string]bool)
+	for _, windowA := range a {
+		// Windows of b that start more than the longest window before windowA cannot overlap it
+		first := sort.Search(len(b), func(i int) bool { return b[i].start.After(windowA.start.Add(-longest)) })
+		for _, windowB := range b[first:] {
+			if !windowB.start.Before(windowA.end) {
+				break
+			}
+			if !windowB.end.After(windowA.start) {
+				continue
+			}
+			pair := [2]string{windowA.schedule, windowB.schedule}
+			if !seen[pair] {
+				seen[pair] = true
+				overlaps = append(overlaps, [2]scheduleWindow{windowA, windowB})
+			}
+		}
+	}
+	return overlaps
+}
+
+func flattenIvrIssues(issues []ivrIssue) []interface{} {
+	flattened := make([]interface{}, len(issues))
+	for i, issue := range issues {
+		flattened[i] = map[string]interface{}{
+			"severity": issue.Severity,
+			"subject":  issue.Subject,
+			"message":  issue.Message,
+		}
+	}
+	return flattened
+}
+
+// buildIvrIssueDiagnostics reports each issue as a diagnostic. Errors are downgraded to warnings when failOnError is false.
+func buildIvrIssueDiagnostics(issues []ivrIssue, failOnError bool) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	for _, issue := range issues {
+		severity := diag.Warning
+		if issue.Severity == ivrIssueError && failOnError {
+			severity = diag.Error
+		}
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Call routing %s for %s", issue.Severity, issue.Subject),
+			Detail:   issue.Message,
+		})
+	}
+	return diagnostics
+}
@@ -0,0 +1,234 @@
+package architect_ivr
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDataSourceArchitectIvrValidationRead(t *testing.T) {
+	var (
+		existingIvrName = "Existing IVR"
+		existingDnis    = []string{"+13175550005", "+13175550100"}
+		mainIvrName     = "Main IVR"
+		orgMainDnis     = []string{"+13175550001"}
+		poolStart       = "+13175550000"
+		poolEnd         = "+13175550099"
+	)
+
+	archProxy := &architectIvrProxy{}
+	archProxy.getAllArchitectIvrsAttr = func(ctx context.Context, a *architectIvrProxy, name string) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Ivr{
+			{Name: &existingIvrName, Dnis: &existingDnis},
+			// The org version of an IVR of the configuration is ignored
+			{Name: &mainIvrName, Dnis: &orgMainDnis},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	archProxy.getAllDidPoolsAttr = func(ctx context.Context, a *architectIvrProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Didpool{{StartPhoneNumber: &poolStart, EndPhoneNumber: &poolEnd}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	archProxy.getArchitectIvrAttr = func(ctx context.Context, a *architectIvrProxy, id string) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+		ivr := platformclientv2.Ivr{Id: &id}
+		if id == "ivr-main" {
+			ivr.ScheduleGroup = &platformclientv2.Domainentityref{Id: platformclientv2.String("group-main")}
+		}
+		return &ivr, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	schedules := map[string]platformclientv2.Schedule{
+		"weekdays":    newValidationSchedule(t, "Weekdays", "2024-01-01T08:00:00.000000", "2024-01-01T18:00:00.000000", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"),
+		"evenings":    newValidationSchedule(t, "Evenings", "2024-01-01T17:00:00.000000", "2024-01-02T08:00:00.000000", "FREQ=DAILY"),
+		"nights":      newValidationSchedule(t, "Nights", "2024-01-01T20:00:00.000000", "2024-01-02T06:00:00.000000", "FREQ=DAILY"),
+		"christmas":   newValidationSchedule(t, "Christmas", "2024-12-25T00:00:00.000000", "2024-12-26T00:00:00.000000", "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25"),
+		"old-holiday": newValidationSchedule(t, "Old Holiday", "2020-01-01T00:00:00.000000", "2020-01-02T00:00:00.000000", ""),
+		"backwards":   newValidationSchedule(t, "Backwards", "2024-07-04T00:00:00.000000", "2024-07-04T00:00:00.000000", ""),
+		"ended":       newValidationSchedule(t, "Ended", "2020-01-01T09:00:00.000000", "2020-01-01T17:00:00.000000", "FREQ=DAILY;UNTIL=20201231"),
+		// Days and nights meet at 08:00 and 20:00 local time, including across the daylight saving time change in March
+		"days":        newValidationSchedule(t, "Days", "2024-01-01T08:00:00.000000", "2024-01-01T20:00:00.000000", "FREQ=DAILY"),
+		"night-shift": newValidationSchedule(t, "Night Shift", "2024-01-01T20:00:00.000000", "2024-01-02T08:00:00.000000", "FREQ=DAILY"),
+	}
+	scheduleReads := 0
+	archProxy.getScheduleAttr = func(ctx context.Context, a *architectIvrProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+		scheduleReads++
+		schedule := schedules[id]
+		schedule.Id = &id
+		return &schedule, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	refs := func(ids ...string) *[]platformclientv2.Domainentityref {
+		result := make([]platformclientv2.Domainentityref, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, platformclientv2.Domainentityref{Id: platformclientv2.String(id)})
+		}
+		return &result
+	}
+	scheduleGroups := map[string]platformclientv2.Schedulegroup{
+		"group-main": {
+			Name:             platformclientv2.String("Main Hours"),
+			TimeZone:         platformclientv2.String("America/Chicago"),
+			OpenSchedules:    refs("weekdays"),
+			ClosedSchedules:  refs("evenings", "nights"),
+			HolidaySchedules: refs("christmas", "old-holiday", "backwards"),
+		},
+		"group-never-open": {
+			Name:          platformclientv2.String("Never Open"),
+			TimeZone:      platformclientv2.String("Europe/London"),
+			OpenSchedules: refs("ended"),
+		},
+		"group-shifts": {
+			Name:             platformclientv2.String("Shifts"),
+			TimeZone:         platformclientv2.String("America/Chicago"),
+			OpenSchedules:    refs("days"),
+			ClosedSchedules:  refs("night-shift"),
+			HolidaySchedules: refs("christmas"),
+		},
+	}
+	archProxy.getScheduleGroupAttr = func(ctx context.Context, a *architectIvrProxy, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error) {
+		group := scheduleGroups[id]
+		return &group, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = archProxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectIvrValidation().Schema, map[string]interface{}{
+		"ivr": []interface{}{
+			map[string]interface{}{"name": mainIvrName, "dnis": []interface{}{"+13175550002", "+13175550003"}},
+			map[string]interface{}{"name": "Sales IVR", "dnis": []interface{}{"+13175550003", "+13175550005", "+13175559999"}},
+		},
+		"did_pool": []interface{}{
+			map[string]interface{}{"start_phone_number": "+13175559990", "end_phone_number": "+13175559998"},
+		},
+		// The schedule group of an IVR without one is not checked, and a schedule group is checked once
+		"ivr_ids":            []interface{}{"ivr-main", "ivr-no-schedule-group"},
+		"schedule_group_ids": []interface{}{"group-main", "group-never-open"},
+		"start_date":         "2024-12-01",
+		"horizon_days":       31,
+	})
+
+	diag := dataSourceArchitectIvrValidationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, true, diag.HasError())
+	assert.Equal(t, false, d.Get("valid").(bool))
+
+	expected := []string{
+		"error|DNIS +13175550003|+13175550003 is claimed by more than one IVR: Main IVR, Sales IVR",
+		"error|DNIS +13175550005|+13175550005 is claimed by more than one IVR: Sales IVR, Existing IVR (existing in the org)",
+		"error|DNIS +13175559999|+13175559999 of IVR Sales IVR is not in any DID pool",
+		"warning|schedule group Main Hours|holiday schedule Old Holiday has no occurrences between 2024-12-01 and 2024-12-31",
+		"error|schedule group Main Hours|schedule Backwards has an empty window: its end 2024-07-04T00:00:00.000000 is not after its start 2024-07-04T00:00:00.000000",
+		"error|schedule group Main Hours|open schedule Weekdays overlaps closed schedule Evenings, first at 2024-12-02T17:00:00-06:00",
+		"warning|schedule group Never Open|open schedule Ended has no occurrences between 2024-12-01 and 2024-12-31",
+		"error|schedule group Never Open|schedule group Never Open is never open between 2024-12-01 and 2024-12-31",
+	}
+	actual := make([]string, 0)
+	for _, issue := range d.Get("issues").([]interface{}) {
+		issueMap := issue.(map[string]interface{})
+		actual = append(actual, issueMap["severity"].(string)+"|"+issueMap["subject"].(string)+"|"+issueMap["message"].(string))
+	}
+	assert.Equal(t, expected, actual)
+
+	// Schedules are checked across daylight saving time changes on the wall clock
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectIvrValidation().Schema, map[string]interface{}{
+		"check_org":          false,
+		"schedule_group_ids": []interface{}{"group-shifts"},
+		"start_date":         "2024-03-01",
+		"horizon_days":       31,
+	})
+	scheduleReads = 0
+	diag = dataSourceArchitectIvrValidationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, false, diag.HasError(), diag)
+	assert.Equal(t, true, d.Get("valid").(bool))
+	assert.Equal(t, 3, scheduleReads)
+}
+
+func newValidationSchedule(t *testing.T, name, start, end, rule string) platformclientv2.Schedule {
+	startTime, err := time.Parse(resourcedata.TimeParseFormat, start)
+	assert.Nil(t, err)
+	endTime, err := time.Parse(resourcedata.TimeParseFormat, end)
+	assert.Nil(t, err)
+	schedule := platformclientv2.Schedule{Name: &name, Start: &startTime, End: &endTime}
+	if rule != "" {
+		schedule.Rrule = &rule
+	}
+	return schedule
+}
+
+func TestUnitDataSourceArchitectIvrValidationWarnOnly(t *testing.T) {
+	internalProxy = &architectIvrProxy{}
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectIvrValidation().Schema, map[string]interface{}{
+		"ivr": []interface{}{
+			map[string]interface{}{"name": "IVR 1", "dnis": []interface{}{"+13175550001"}},
+			map[string]interface{}{"name": "IVR 2", "dnis": []interface{}{"+13175550001"}},
+		},
+		"check_org":     false,
+		"fail_on_error": false,
+	})
+
+	diag := dataSourceArchitectIvrValidationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, false, diag.HasError())
+	assert.Len(t, diag, 1)
+	assert.Equal(t, false, d.Get("valid").(bool))
+	// DID pools are not checked when there are none to check against
+	assert.Len(t, d.Get("issues").([]interface{}), 1)
+}
+
+func TestUnitDataSourceArchitectIvrValidationScheduleGroupConfig(t *testing.T) {
+	internalProxy = &architectIvrProxy{}
+	defer func() { internalProxy = nil }()
+
+	schedule := func(name, start, end, rule string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "start": start, "end": end, "rrule": rule}
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectIvrValidation().Schema, map[string]interface{}{
+		"schedule_group": []interface{}{
+			map[string]interface{}{
+				"name":      "Main Hours",
+				"time_zone": "America/Chicago",
+				"open_schedule": []interface{}{
+					schedule("Weekdays", "2024-01-01T08:00:00.000000", "2024-01-01T18:00:00.000000", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"),
+				},
+				"closed_schedule": []interface{}{
+					schedule("Evenings", "2024-01-01T17:00:00.000000", "2024-01-02T08:00:00.000000", "FREQ=DAILY"),
+				},
+				"holiday_schedule": []interface{}{
+					schedule("Christmas", "2024-12-25T00:00:00.000000", "2024-12-26T00:00:00.000000", "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25"),
+					schedule("Backwards", "2024-07-04T00:00:00.000000", "2024-07-04T00:00:00.000000", ""),
+				},
+			},
+			map[string]interface{}{
+				"name":      "Closed",
+				"time_zone": "Europe/London",
+				"closed_schedule": []interface{}{
+					schedule("Always", "2024-01-01T00:00:00.000000", "2024-01-02T00:00:00.000000", "FREQ=DAILY"),
+				},
+			},
+		},
+		"check_org":    false,
+		"start_date":   "2024-12-01",
+		"horizon_days": 31,
+	})
+
+	// Schedule groups of the configuration are checked without reading the org
+	diag := dataSourceArchitectIvrValidationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, true, diag.HasError())
+	assert.Equal(t, false, d.Get("valid").(bool))
+
+	expected := []string{
+		"error|schedule group Main Hours|schedule Backwards has an empty window: its end 2024-07-04T00:00:00.000000 is not after its start 2024-07-04T00:00:00.000000",
+		"error|schedule group Main Hours|open schedule Weekdays overlaps closed schedule Evenings, first at 2024-12-02T17:00:00-06:00",
+		"error|schedule group Closed|schedule group Closed has no open schedules",
+	}
+	actual := make([]string, 0)
+	for _, issue := range d.Get("issues").([]interface{}) {
+		issueMap := issue.(map[string]interface{})
+		actual = append(actual, issueMap["severity"].(string)+"|"+issueMap["subject"].(string)+"|"+issueMap["message"].(string))
+	}
+	assert.Equal(t, expected, actual)
+}
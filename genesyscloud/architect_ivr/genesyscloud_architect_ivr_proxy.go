@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/util"
 	utillists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"time"
 
@@ -40,11 +41,15 @@ type updateArchitectIvrFunc func(context.Context, *architectIvrProxy, string, pl
 type deleteArchitectIvrFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.APIResponse, error)
 type getAllArchitectIvrsFunc func(context.Context, *architectIvrProxy, string) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error)
 type getArchitectIvrIdByNameFunc func(context.Context, *architectIvrProxy, string) (id string, retryable bool, response *platformclientv2.APIResponse, err error)
+type getAllDidPoolsFunc func(context.Context, *architectIvrProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+type getScheduleGroupFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error)
+type getScheduleFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
 
 // architectIvrProxy contains all methods that call genesys cloud APIs.
 type architectIvrProxy struct {
 	clientConfig *platformclientv2.Configuration
 	api          *platformclientv2.ArchitectApi
+	telephonyApi *platformclientv2.TelephonyProvidersEdgeApi
 
 	createArchitectIvrAttr      createArchitectIvrFunc
 	getArchitectIvrAttr         getArchitectIvrFunc
@@ -52,6 +57,9 @@ type architectIvrProxy struct {
 	deleteArchitectIvrAttr      deleteArchitectIvrFunc
 	getAllArchitectIvrsAttr     getAllArchitectIvrsFunc
 	getArchitectIvrIdByNameAttr getArchitectIvrIdByNameFunc
+	getAllDidPoolsAttr          getAllDidPoolsFunc
+	getScheduleGroupAttr        getScheduleGroupFunc
+	getScheduleAttr             getScheduleFunc
 
 	maxDnisPerRequest int
 
@@ -63,9 +71,11 @@ type architectIvrProxy struct {
 // newArchitectIvrProxy initializes the proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *architectIvrProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 	return &architectIvrProxy{
 		clientConfig: clientConfig,
 		api:          api,
+		telephonyApi: telephonyApi,
 
 		createArchitectIvrAttr:      createArchitectIvrFn,
 		getArchitectIvrAttr:         getArchitectIvrFn,
@@ -73,6 +83,9 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 		deleteArchitectIvrAttr:      deleteArchitectIvrFn,
 		getAllArchitectIvrsAttr:     getAllArchitectIvrsFn,
 		getArchitectIvrIdByNameAttr: getArchitectIvrIdByNameFn,
+		getAllDidPoolsAttr:          getAllDidPoolsFn,
+		getScheduleGroupAttr:        getScheduleGroupFn,
+		getScheduleAttr:             getScheduleFn,
 
 		maxDnisPerRequest: maxDnisPerRequest,
 
@@ -100,6 +113,21 @@ func (a *architectIvrProxy) getArchitectIvrIdByName(ctx context.Context, name st
 	return a.getArchitectIvrIdByNameAttr(ctx, a, name)
 }
 
+// getAllDidPools retrieves all Genesys Cloud DID pools, which the DNIS of IVRs are validated against
+func (a *architectIvrProxy) getAllDidPools(ctx context.Context) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	return a.getAllDidPoolsAttr(ctx, a)
+}
+
+// getScheduleGroup retrieves a Genesys Cloud schedule group, whose schedules the routing of IVRs is validated against
+func (a *architectIvrProxy) getScheduleGroup(ctx context.Context, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error) {
+	return a.getScheduleGroupAttr(ctx, a, id)
+}
+
+// getSchedule retrieves a Genesys Cloud schedule
+func (a *architectIvrProxy) getSchedule(ctx context.Context, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+	return a.getScheduleAttr(ctx, a, id)
+}
+
 // createArchitectIvr creates a Genesys Cloud Architect IVR
 func (a *architectIvrProxy) createArchitectIvr(ctx context.Context, ivr platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
 	return a.createArchitectIvrAttr(ctx, a, ivr)
@@ -197,6 +225,21 @@ func getAllArchitectIvrsFn(_ context.Context, a *architectIvrProxy, name string)
 	return &allIvrs, resp, nil
 }
 
+// getAllDidPoolsFn is an implementation function for retrieving all Genesys Cloud DID pools
+func getAllDidPoolsFn(_ context.Context, a *architectIvrProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	return util.GetAllDidPools(a.telephonyApi)
+}
+
+// getScheduleGroupFn is an implementation function for retrieving a Genesys Cloud schedule group by ID
+func getScheduleGroupFn(_ context.Context, a *architectIvrProxy, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error) {
+	return a.api.GetArchitectSchedulegroup(id)
+}
+
+// getScheduleFn is an implementation function for retrieving a Genesys Cloud schedule by ID
+func getScheduleFn(_ context.Context, a *architectIvrProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+	return a.api.GetArchitectSchedule(id)
+}
+
 // getArchitectIvrIdByNameFn is an implementation function for retrieving a Genesys Cloud Architect IVR ID by name
 func getArchitectIvrIdByNameFn(ctx context.Context, a *architectIvrProxy, name string) (string, bool, *platformclientv2.APIResponse, error) {
 	ivrs, resp, err := getAllArchitectIvrsFn(ctx, a, name)
@@ -3,6 +3,7 @@ package architect_ivr
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
@@ -10,13 +11,15 @@ import (
 )
 
 const (
-	resourceName      = "genesyscloud_architect_ivr"
-	maxDnisPerRequest = 50
+	resourceName             = "genesyscloud_architect_ivr"
+	validationDataSourceName = "genesyscloud_architect_ivr_validation"
+	maxDnisPerRequest        = 50
 )
 
 // SetRegistrar registers all resources, data sources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceArchitectIvr())
+	l.RegisterDataSource(validationDataSourceName, DataSourceArchitectIvrValidation())
 	l.RegisterResource(resourceName, ResourceArchitectIvrConfig())
 	l.RegisterExporter(resourceName, ArchitectIvrExporter())
 }
@@ -111,3 +114,189 @@ func DataSourceArchitectIvr() *schema.Resource {
 		},
 	}
 }
+
+var ivrValidationScheduleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Description: "Name of the schedule.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"start": {
+			Description:      "Start of the first occurrence of the schedule, as set on the genesyscloud_architect_schedules resource. For example: 2006-01-02T15:04:05.000000.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validators.ValidateLocalDateTimes,
+		},
+		"end": {
+			Description:      "End of the first occurrence of the schedule, as set on the genesyscloud_architect_schedules resource. For example: 2006-01-02T15:04:05.000000.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validators.ValidateLocalDateTimes,
+		},
+		"rrule": {
+			Description: "Recurrence rule of the schedule, as set on the genesyscloud_architect_schedules resource.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	},
+}
+
+var ivrValidationIssueResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"severity": {
+			Description: "Severity of the issue. Either `error` or `warning`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subject": {
+			Description: "The IVR, DNIS or schedule group the issue was found on.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"message": {
+			Description: "Description of the issue.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceArchitectIvrValidation registers the genesyscloud_architect_ivr_validation data source
+func DataSourceArchitectIvrValidation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Validates call routing across IVRs, DID pools and schedule groups. It reports DNIS claimed by more than one IVR, DNIS that are not in any DID pool, schedules with an empty window, schedule groups that are never open, and open schedules that overlap closed schedules.
+The IVRs and DID pools of the configuration are passed in, so the DNIS are checked when the data source is read at plan time rather than when routing breaks after apply. The IVRs and DID pools that already exist in the org can be included in the check.
+Schedule groups are passed in with the schedules they reference, so they are checked at plan time as well. Schedule groups that already exist in the org can be checked by ID or as the schedule groups of IVRs.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceArchitectIvrValidationRead),
+		Schema: map[string]*schema.Schema{
+			"ivr": {
+				Description: "IVRs of the configuration.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the IVR.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"dnis": {
+							Description: "The phone numbers of the IVR.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validators.ValidatePhoneNumber},
+						},
+					},
+				},
+			},
+			"did_pool": {
+				Description: "DID pools of the configuration.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_phone_number": {
+							Description:      "Starting phone number of the DID pool range.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidatePhoneNumber,
+						},
+						"end_phone_number": {
+							Description:      "Ending phone number of the DID pool range.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidatePhoneNumber,
+						},
+					},
+				},
+			},
+			"schedule_group": {
+				Description: "Schedule groups of the configuration, with the schedules they reference.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the schedule group.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"time_zone": {
+							Description:      "Time zone of the schedule group, for example `America/New_York`.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidateTimeZone,
+						},
+						"open_schedule": {
+							Description: "Schedules during which the group is open.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        ivrValidationScheduleResource,
+						},
+						"closed_schedule": {
+							Description: "Schedules during which the group is closed.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        ivrValidationScheduleResource,
+						},
+						"holiday_schedule": {
+							Description: "Schedules during which the group is on holiday. Holidays take precedence over the open schedules, so they are allowed to overlap them.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        ivrValidationScheduleResource,
+						},
+					},
+				},
+			},
+			"ivr_ids": {
+				Description: "IDs of IVRs whose schedule groups are checked.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule_group_ids": {
+				Description: "IDs of schedule groups to check.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"check_org": {
+				Description: "Include the IVRs and DID pools that exist in the org in the check. An IVR in the org with the same name as an IVR of the configuration is taken to be that IVR.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"start_date": {
+				Description:      "First day of the period schedules are checked over, in yyyy-MM-dd format. Defaults to the current date.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"horizon_days": {
+				Description:  "Number of days schedules are checked over.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      365,
+				ValidateFunc: validation.IntBetween(1, 3660),
+			},
+			"fail_on_error": {
+				Description: "Fail the plan when a conflict is found. When false, errors are reported as warnings and in `issues`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"valid": {
+				Description: "True when no errors were found.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"issues": {
+				Description: "The errors and warnings found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        ivrValidationIssueResource,
+			},
+		},
+	}
+}
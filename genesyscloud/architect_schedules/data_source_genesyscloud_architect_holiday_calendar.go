@@ -0,0 +1,119 @@
+package architect_schedules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/holidays"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// holidaySchedule is an all-day holiday schedule. Holidays falling on the same date share a schedule.
+type holidaySchedule struct {
+	date     time.Time
+	names    []string
+	observed bool
+}
+
+func dataSourceArchitectHolidayCalendarRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	countryCode := strings.ToUpper(d.Get("country_code").(string))
+	regionCode := holidays.NormalizeRegion(countryCode, d.Get("region_code").(string))
+	includeObserved := d.Get("include_observed").(bool)
+	namePrefix := d.Get("name_prefix").(string)
+
+	years := make([]int, 0)
+	for _, year := range d.Get("years").(*schema.Set).List() {
+		years = append(years, year.(int))
+	}
+	sort.Ints(years)
+
+	excludedHolidays := *lists.SetToStringList(d.Get("excluded_holidays").(*schema.Set))
+	knownNames := holidays.Names(countryCode)
+	for _, name := range excludedHolidays {
+		if knownNames != nil && !lists.ItemInSlice(name, knownNames) {
+			return diag.Errorf("Excluded holiday %s is not a holiday of %s. Holidays are %s", name, countryCode, strings.Join(knownNames, ", "))
+		}
+	}
+	excludedDates := *lists.SetToStringList(d.Get("excluded_dates").(*schema.Set))
+
+	schedules := make(map[time.Time]*holidaySchedule)
+	addHoliday := func(date time.Time, name string, observed bool) {
+		schedule, ok := schedules[date]
+		if !ok {
+			schedules[date] = &holidaySchedule{date: date, names: []string{name}, observed: observed}
+			return
+		}
+		if !lists.ItemInSlice(name, schedule.names) {
+			schedule.names = append(schedule.names, name)
+		}
+		// A day is only a substitute day when every holiday on it is
+		schedule.observed = schedule.observed && observed
+	}
+
+	for _, year := range years {
+		yearHolidays, err := holidays.ForYear(countryCode, regionCode, year, includeObserved)
+		if err != nil {
+			return diag.Errorf("Failed to generate holidays for %d: %v", year, err)
+		}
+		for _, holiday := range yearHolidays {
+			if lists.ItemInSlice(holiday.Name, excludedHolidays) || lists.ItemInSlice(holiday.Date.Format(resourcedata.DateParseFormat), excludedDates) {
+				continue
+			}
+			name := holiday.Name
+			if holiday.Observed {
+				name += " (observed)"
+			}
+			addHoliday(holiday.Date, name, holiday.Observed)
+		}
+	}
+
+	for _, additional := range d.Get("additional_holiday").([]interface{}) {
+		additionalMap := additional.(map[string]interface{})
+		name := additionalMap["name"].(string)
+		date, err := time.Parse(resourcedata.DateParseFormat, additionalMap["date"].(string))
+		if err != nil {
+			return diag.Errorf("Failed to parse date of additional holiday %s: %v", name, err)
+		}
+		for _, year := range years {
+			if additionalMap["recurring"].(bool) {
+				// Recurring holidays on 29 February are only held in leap years
+				if recurrence := time.Date(year, date.Month(), date.Day(), 0, 0, 0, 0, time.UTC); recurrence.Day() == date.Day() {
+					addHoliday(recurrence, name, false)
+				}
+			} else if date.Year() == year {
+				addHoliday(date, name, false)
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", countryCode, regionCode))
+	_ = d.Set("schedules", flattenHolidaySchedules(schedules, namePrefix))
+	return nil
+}
+
+// flattenHolidaySchedules converts holiday schedules to the schedules attribute, ordered by date
+func flattenHolidaySchedules(schedules map[time.Time]*holidaySchedule, namePrefix string) []interface{} {
+	ordered := make([]*holidaySchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		ordered = append(ordered, schedule)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].date.Before(ordered[j].date) })
+
+	flattened := make([]interface{}, 0, len(ordered))
+	for _, schedule := range ordered {
+		flattened = append(flattened, map[string]interface{}{
+			"date":     schedule.date.Format(resourcedata.DateParseFormat),
+			"name":     fmt.Sprintf("%s%s %d", namePrefix, strings.Join(schedule.names, " / "), schedule.date.Year()),
+			"observed": schedule.observed,
+			"start":    schedule.date.Format(timeFormat),
+			"end":      schedule.date.AddDate(0, 0, 1).Format(timeFormat),
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,72 @@
+package architect_schedules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDataSourceArchitectHolidayCalendarRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectHolidayCalendar().Schema, map[string]interface{}{
+		"country_code":      "GB",
+		"region_code":       "GB-SCT",
+		"years":             []interface{}{2021},
+		"name_prefix":       "UK - ",
+		"excluded_holidays": []interface{}{"St Andrew's Day"},
+		"excluded_dates":    []interface{}{"2021-01-04"},
+		"additional_holiday": []interface{}{
+			map[string]interface{}{"name": "Christmas Eve", "date": "2020-12-24", "recurring": true},
+			map[string]interface{}{"name": "Company Day", "date": "2021-08-02"},
+			map[string]interface{}{"name": "Summer Party", "date": "2022-07-01"},
+		},
+	})
+
+	diag := dataSourceArchitectHolidayCalendarRead(context.Background(), d, nil)
+	assert.Equal(t, false, diag.HasError(), diag)
+
+	expected := []string{
+		"2021-01-01 UK - New Year's Day 2021",
+		"2021-01-02 UK - 2nd January 2021",
+		"2021-04-02 UK - Good Friday 2021",
+		"2021-05-03 UK - Early May bank holiday 2021",
+		"2021-05-31 UK - Spring bank holiday 2021",
+		"2021-08-02 UK - Summer bank holiday / Company Day 2021",
+		"2021-12-24 UK - Christmas Eve 2021",
+		"2021-12-25 UK - Christmas Day 2021",
+		"2021-12-26 UK - Boxing Day 2021",
+		"2021-12-27 UK - Christmas Day (observed) 2021",
+		"2021-12-28 UK - Boxing Day (observed) 2021",
+	}
+	schedules := d.Get("schedules").([]interface{})
+	actual := make([]string, 0, len(schedules))
+	for _, schedule := range schedules {
+		scheduleMap := schedule.(map[string]interface{})
+		actual = append(actual, scheduleMap["date"].(string)+" "+scheduleMap["name"].(string))
+	}
+	assert.Equal(t, expected, actual)
+
+	observed := schedules[len(schedules)-1].(map[string]interface{})
+	assert.Equal(t, true, observed["observed"])
+	assert.Equal(t, "2021-12-28T00:00:00.000000", observed["start"])
+	assert.Equal(t, "2021-12-29T00:00:00.000000", observed["end"])
+}
+
+func TestUnitDataSourceArchitectHolidayCalendarInvalidOverrides(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectHolidayCalendar().Schema, map[string]interface{}{
+		"country_code":      "US",
+		"years":             []interface{}{2025},
+		"excluded_holidays": []interface{}{"Colombus Day"},
+	})
+	diag := dataSourceArchitectHolidayCalendarRead(context.Background(), d, nil)
+	assert.Equal(t, true, diag.HasError())
+
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectHolidayCalendar().Schema, map[string]interface{}{
+		"country_code": "US",
+		"region_code":  "US-NY",
+		"years":        []interface{}{2025},
+	})
+	diag = dataSourceArchitectHolidayCalendarRead(context.Background(), d, nil)
+	assert.Equal(t, true, diag.HasError())
+}
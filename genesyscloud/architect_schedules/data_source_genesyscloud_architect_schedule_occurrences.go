@@ -0,0 +1,167 @@
+package architect_schedules
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+// The maximum number of occurrences of a single schedule in the window
+const maxScheduleOccurrences = 10000
+
+// scheduleStates are the states of a schedule group in order of precedence
+var scheduleStates = []string{"holiday", "closed", "open"}
+
+// scheduleSpan is a single occurrence of a schedule
+type scheduleSpan struct {
+	state      string
+	scheduleId string
+	start      time.Time
+	end        time.Time
+}
+
+// scheduleInterval is a period of the window during which the schedule group is in the same state
+type scheduleInterval struct {
+	state       string
+	start       time.Time
+	end         time.Time
+	scheduleIds []string
+}
+
+func dataSourceArchitectScheduleOccurrencesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectSchedulesProxy(sdkConfig)
+
+	timeZone := d.Get("time_zone").(string)
+	startDate := d.Get("start_date").(string)
+	endDate := d.Get("end_date").(string)
+
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return util.BuildDiagnosticError(occurrencesDataSourceName, fmt.Sprintf("Invalid time zone %s", timeZone), err)
+	}
+	windowStart, err := time.ParseInLocation(resourcedata.DateParseFormat, startDate, loc)
+	if err != nil {
+		return util.BuildDiagnosticError(occurrencesDataSourceName, fmt.Sprintf("Failed to parse date %s", startDate), err)
+	}
+	windowEnd, err := time.ParseInLocation(resourcedata.DateParseFormat, endDate, loc)
+	if err != nil {
+		return util.BuildDiagnosticError(occurrencesDataSourceName, fmt.Sprintf("Failed to parse date %s", endDate), err)
+	}
+	windowEnd = windowEnd.AddDate(0, 0, 1)
+	if !windowEnd.After(windowStart) {
+		return diag.Errorf("end_date %s must not be before start_date %s", endDate, startDate)
+	}
+
+	spans := make([]scheduleSpan, 0)
+	for _, state := range scheduleStates {
+		ids := *lists.SetToStringList(d.Get(state + "_schedule_ids").(*schema.Set))
+		sort.Strings(ids)
+		for _, id := range ids {
+			schedule, proxyResponse, err := proxy.getArchitectSchedulesById(ctx, id)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(occurrencesDataSourceName, fmt.Sprintf("Failed to read schedule %s | error: %s", id, err), proxyResponse)
+			}
+			scheduleSpans, err := occurrenceSpans(schedule, state, loc, windowStart, windowEnd)
+			if err != nil {
+				return util.BuildDiagnosticError(occurrencesDataSourceName, fmt.Sprintf("Failed to evaluate schedule %s", id), err)
+			}
+			log.Printf("Schedule %s has %d occurrences between %s and %s", id, len(scheduleSpans), startDate, endDate)
+			spans = append(spans, scheduleSpans...)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", timeZone, startDate, endDate))
+	_ = d.Set("intervals", flattenScheduleIntervals(buildScheduleIntervals(spans, windowStart, windowEnd)))
+	return nil
+}
+
+// occurrenceSpans returns the occurrences of a schedule that overlap the window, clipped to the window. The start and end
+// of a schedule are local times, so they are placed in the time zone of the schedule group.
+func occurrenceSpans(schedule *platformclientv2.Schedule, state string, loc *time.Location, windowStart, windowEnd time.Time) ([]scheduleSpan, error) {
+	if schedule.Start == nil || schedule.End == nil {
+		return nil, fmt.Errorf("schedule has no start or end")
+	}
+	rule := ""
+	if schedule.Rrule != nil {
+		rule = *schedule.Rrule
+	}
+
+	occurrences, err := rrule.Occurrences(*schedule.Start, *schedule.End, rule, loc, windowStart, windowEnd, maxScheduleOccurrences)
+	if err != nil {
+		return nil, err
+	}
+	spans := make([]scheduleSpan, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		spans = append(spans, scheduleSpan{
+			state:      state,
+			scheduleId: *schedule.Id,
+			start:      occurrence.Start,
+			end:        occurrence.End,
+		})
+	}
+	return spans, nil
+}
+
+// buildScheduleIntervals splits the window into intervals with the effective state of the schedule group. Each part of
+// the window takes the state of the highest precedence schedule covering it and is closed when no schedule covers it.
+func buildScheduleIntervals(spans []scheduleSpan, windowStart, windowEnd time.Time) []scheduleInterval {
+	boundaries := []time.Time{windowStart, windowEnd}
+	for _, span := range spans {
+		boundaries = append(boundaries, span.start, span.end)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+	intervals := make([]scheduleInterval, 0)
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		if !start.Before(end) {
+			continue
+		}
+
+		interval := scheduleInterval{state: "closed", start: start, end: end, scheduleIds: []string{}}
+		for _, state := range scheduleStates {
+			for _, span := range spans {
+				if span.state == state && !span.start.After(start) && span.end.After(start) && !lists.ItemInSlice(span.scheduleId, interval.scheduleIds) {
+					interval.scheduleIds = append(interval.scheduleIds, span.scheduleId)
+				}
+			}
+			if len(interval.scheduleIds) > 0 {
+				interval.state = state
+				break
+			}
+		}
+		sort.Strings(interval.scheduleIds)
+
+		if last := len(intervals) - 1; last >= 0 && intervals[last].state == interval.state && lists.AreEquivalent(intervals[last].scheduleIds, interval.scheduleIds) {
+			intervals[last].end = end
+			continue
+		}
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+func flattenScheduleIntervals(intervals []scheduleInterval) []interface{} {
+	flattened := make([]interface{}, 0, len(intervals))
+	for _, interval := range intervals {
+		flattened = append(flattened, map[string]interface{}{
+			"state":        interval.state,
+			"start":        interval.start.Format(time.RFC3339),
+			"end":          interval.end.Format(time.RFC3339),
+			"schedule_ids": lists.StringListToInterfaceList(interval.scheduleIds),
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,110 @@
+package architect_schedules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDataSourceArchitectScheduleOccurrencesRead(t *testing.T) {
+	var (
+		openId    = uuid.NewString()
+		lunchId   = uuid.NewString()
+		holidayId = uuid.NewString()
+	)
+
+	buildSchedule := func(id, start, end, rrule string) *platformclientv2.Schedule {
+		schedStart, _ := time.Parse(timeFormat, start)
+		schedEnd, _ := time.Parse(timeFormat, end)
+		return &platformclientv2.Schedule{Id: &id, Start: &schedStart, End: &schedEnd, Rrule: &rrule}
+	}
+	schedules := map[string]*platformclientv2.Schedule{
+		openId:    buildSchedule(openId, "2024-01-01T09:00:00.000000", "2024-01-01T17:00:00.000000", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"),
+		lunchId:   buildSchedule(lunchId, "2024-01-01T12:00:00.000000", "2024-01-01T13:00:00.000000", "FREQ=DAILY"),
+		holidayId: buildSchedule(holidayId, "2024-12-25T00:00:00.000000", "2024-12-26T00:00:00.000000", "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25"),
+	}
+
+	schedProxy := &architectSchedulesProxy{}
+	schedProxy.getArchitectSchedulesByIdAttr = func(ctx context.Context, p *architectSchedulesProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+		schedule, ok := schedules[id]
+		if !ok {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("schedule %s not found", id)
+		}
+		return schedule, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = schedProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectScheduleOccurrences().Schema, map[string]interface{}{
+		"open_schedule_ids":    []interface{}{openId},
+		"closed_schedule_ids":  []interface{}{lunchId},
+		"holiday_schedule_ids": []interface{}{holidayId},
+		"time_zone":            "America/New_York",
+		"start_date":           "2024-12-24",
+		"end_date":             "2024-12-26",
+	})
+
+	diag := dataSourceArchitectScheduleOccurrencesRead(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError(), diag)
+
+	expected := []string{
+		"closed 2024-12-24T00:00:00-05:00 2024-12-24T09:00:00-05:00 ",
+		"open 2024-12-24T09:00:00-05:00 2024-12-24T12:00:00-05:00 " + openId,
+		"closed 2024-12-24T12:00:00-05:00 2024-12-24T13:00:00-05:00 " + lunchId,
+		"open 2024-12-24T13:00:00-05:00 2024-12-24T17:00:00-05:00 " + openId,
+		"closed 2024-12-24T17:00:00-05:00 2024-12-25T00:00:00-05:00 ",
+		"holiday 2024-12-25T00:00:00-05:00 2024-12-26T00:00:00-05:00 " + holidayId,
+		"closed 2024-12-26T00:00:00-05:00 2024-12-26T09:00:00-05:00 ",
+		"open 2024-12-26T09:00:00-05:00 2024-12-26T12:00:00-05:00 " + openId,
+		"closed 2024-12-26T12:00:00-05:00 2024-12-26T13:00:00-05:00 " + lunchId,
+		"open 2024-12-26T13:00:00-05:00 2024-12-26T17:00:00-05:00 " + openId,
+		"closed 2024-12-26T17:00:00-05:00 2024-12-27T00:00:00-05:00 ",
+	}
+	intervals := d.Get("intervals").([]interface{})
+	actual := make([]string, 0, len(intervals))
+	for _, interval := range intervals {
+		intervalMap := interval.(map[string]interface{})
+		ids := make([]string, 0)
+		for _, id := range intervalMap["schedule_ids"].([]interface{}) {
+			ids = append(ids, id.(string))
+		}
+		actual = append(actual, fmt.Sprintf("%s %s %s %s", intervalMap["state"], intervalMap["start"], intervalMap["end"], strings.Join(ids, ",")))
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitDataSourceArchitectScheduleOccurrencesInvalidRrule(t *testing.T) {
+	scheduleId := uuid.NewString()
+	start, _ := time.Parse(timeFormat, "2024-01-01T09:00:00.000000")
+	end, _ := time.Parse(timeFormat, "2024-01-01T17:00:00.000000")
+	rrule := "FREQ=WEEKLY;BYMONTHDAY=1"
+
+	schedProxy := &architectSchedulesProxy{}
+	schedProxy.getArchitectSchedulesByIdAttr = func(ctx context.Context, p *architectSchedulesProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Schedule{Id: &scheduleId, Start: &start, End: &end, Rrule: &rrule}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = schedProxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectScheduleOccurrences().Schema, map[string]interface{}{
+		"open_schedule_ids": []interface{}{scheduleId},
+		"time_zone":         "Europe/Dublin",
+		"start_date":        "2024-01-01",
+		"end_date":          "2024-01-31",
+	})
+
+	diag := dataSourceArchitectScheduleOccurrencesRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, true, diag.HasError())
+}
@@ -39,6 +39,8 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceArchitectSchedules()
+	providerDataSources[occurrencesDataSourceName] = DataSourceArchitectScheduleOccurrences()
+	providerDataSources[holidaysDataSourceName] = DataSourceArchitectHolidayCalendar()
 }
 
 // initTestResources initializes all test resources and data sources.
@@ -101,7 +101,11 @@ func (p *architectSchedulesProxy) getArchitectSchedulesIdByName(ctx context.Cont
 
 // getArchitectSchedulesById returns a single Genesys Cloud architect schedules by Id
 func (p *architectSchedulesProxy) getArchitectSchedulesById(ctx context.Context, id string) (architectSchedules *platformclientv2.Schedule, response *platformclientv2.APIResponse, err error) {
-	if schedule := rc.GetCacheItem(p.schedulesCache, id); schedule != nil { // Get the schedule from the cache, if not there in the cache then call p.getArchitectSchedulesByIdAttr()
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllArchitectSchedules(ctx)
+		return err
+	}
+	if schedule := rc.GetCacheItemOrHydrate(ctx, p.schedulesCache, id, hydrate); schedule != nil { // Get the schedule from the cache, if not there in the cache then call p.getArchitectSchedulesByIdAttr()
 		return schedule, nil, nil
 	}
 	return p.getArchitectSchedulesByIdAttr(ctx, p, id)
@@ -157,7 +161,7 @@ func getAllArchitectSchedulesFn(ctx context.Context, p *architectSchedulesProxy)
 
 	// Cache the architect schedules resource into the p.schedulesCache for later use
 	for _, schedule := range allSchedules {
-		rc.SetCache(p.schedulesCache, *schedule.Id, schedule)
+		rc.SetCache(ctx, p.schedulesCache, *schedule.Id, schedule)
 	}
 
 	return &allSchedules, apiResponse, nil
@@ -7,14 +7,21 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-const resourceName = "genesyscloud_architect_schedules"
+const (
+	resourceName              = "genesyscloud_architect_schedules"
+	occurrencesDataSourceName = "genesyscloud_architect_schedule_occurrences"
+	holidaysDataSourceName    = "genesyscloud_architect_holiday_calendar"
+)
 
 // SetRegistrar registers all of the resources, datasources and exporters in the pakage
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceArchitectSchedules())
 	regInstance.RegisterDataSource(resourceName, DataSourceArchitectSchedules())
+	regInstance.RegisterDataSource(occurrencesDataSourceName, DataSourceArchitectScheduleOccurrences())
+	regInstance.RegisterDataSource(holidaysDataSourceName, DataSourceArchitectHolidayCalendar())
 	regInstance.RegisterExporter(resourceName, ArchitectSchedulesExporter())
 }
 
@@ -61,7 +68,7 @@ func ResourceArchitectSchedules() *schema.Resource {
 				ValidateDiagFunc: validators.ValidateLocalDateTimes,
 			},
 			"rrule": {
-				Description:      "An iCal Recurrence Rule (RRULE) string. It is required to be set for schedules determining when upgrades to the Edge software can be applied.",
+				Description:      "An iCal Recurrence Rule (RRULE) string. It is required to be set for schedules determining when upgrades to the Edge software can be applied. The rule is validated against RFC 5545 at plan time.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: validators.ValidateRrule,
@@ -100,3 +107,196 @@ func DataSourceArchitectSchedules() *schema.Resource {
 		},
 	}
 }
+
+var scheduleIntervalResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"state": {
+			Description: "State of the schedule group during the interval: `open`, `closed` or `holiday`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start": {
+			Description: "Start of the interval in RFC 3339 format, with the offset of the time zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end": {
+			Description: "End of the interval in RFC 3339 format, with the offset of the time zone. The end is exclusive.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"schedule_ids": {
+			Description: "IDs of the schedules that are active during the interval and determine its state. Empty for closed intervals that no schedule covers.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+// DataSourceArchitectScheduleOccurrences registers the genesyscloud_architect_schedule_occurrences data source
+func DataSourceArchitectScheduleOccurrences() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source that previews when a schedule group is open, closed or on holiday. The recurrence rules of the schedules are evaluated in the time zone of the group for every day of the window.
+Holiday schedules take precedence over closed schedules, which take precedence over open schedules. Times not covered by any schedule are closed.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceArchitectScheduleOccurrencesRead),
+		Schema: map[string]*schema.Schema{
+			"open_schedule_ids": {
+				Description: "IDs of the schedules during which the group is open.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"closed_schedule_ids": {
+				Description: "IDs of the schedules during which the group is closed.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"holiday_schedule_ids": {
+				Description: "IDs of the schedules during which the group is on holiday.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"time_zone": {
+				Description:      "IANA time zone the schedules are evaluated in, for example `America/New_York`. This is the time zone of the schedule group.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateTimeZone,
+			},
+			"start_date": {
+				Description:      "First day of the window, in yyyy-MM-dd format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"end_date": {
+				Description:      "Last day of the window, in yyyy-MM-dd format. The window includes the whole of this day.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"intervals": {
+				Description: "Consecutive intervals covering the whole window, each with the effective state of the schedule group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        scheduleIntervalResource,
+			},
+		},
+	}
+}
+
+var holidayScheduleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"date": {
+			Description: "Date of the holiday in yyyy-MM-dd format. Dates are unique, so this can be used as the key of a `for_each`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Schedule name, made of the name prefix, the name of the holiday and the year. Holidays on the same date are combined into one schedule.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"observed": {
+			Description: "Whether this is the substitute day for a holiday that falls on a weekend.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"start": {
+			Description: "Start of the schedule, for the start attribute of genesyscloud_architect_schedules.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end": {
+			Description: "End of the schedule, for the end attribute of genesyscloud_architect_schedules.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceArchitectHolidayCalendar registers the genesyscloud_architect_holiday_calendar data source
+func DataSourceArchitectHolidayCalendar() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source that generates all-day holiday schedule definitions from a bundled holiday dataset. Create a genesyscloud_architect_schedules resource for each schedule with for_each and pass their IDs to the holiday_schedules_id attribute of genesyscloud_architect_schedulegroups.
+The dataset covers the national and regional public holidays of AU, CA, DE, FR, GB, IE, NL, NZ and US. It is not fetched from the internet, so the same configuration always produces the same schedules.`,
+		ReadContext: dataSourceArchitectHolidayCalendarRead,
+		Schema: map[string]*schema.Schema{
+			"country_code": {
+				Description:      "ISO 3166-1 alpha-2 code of the country, for example `GB`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateCountryCode,
+			},
+			"region_code": {
+				Description: "ISO 3166-2 code of a subdivision of the country whose regional holidays are included, for example `GB-SCT` or `SCT`. If not set, only national holidays are included.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"years": {
+				Description: "Years to generate holiday schedules for.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(2000, 2099)},
+			},
+			"include_observed": {
+				Description: "Whether to include the substitute days given when a holiday falls on a weekend, in addition to the holiday itself.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"name_prefix": {
+				Description: "Prefix added to the name of every schedule, for example `UK - `. Schedule names must be unique, so use a different prefix for each calendar.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"excluded_holidays": {
+				Description: "Names of holidays in the dataset that the company does not observe, for example `Columbus Day`. Their substitute days are excluded as well.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_dates": {
+				Description: "Dates in yyyy-MM-dd format on which holidays in the dataset are not observed.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validators.ValidateDate},
+			},
+			"additional_holiday": {
+				Description: "Company-specific holidays added to the calendar.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the holiday.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"date": {
+							Description:      "Date of the holiday in yyyy-MM-dd format. Unless the holiday is recurring, it is only included when its year is one of the years.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidateDate,
+						},
+						"recurring": {
+							Description: "Whether the holiday is held on the same month and day in every year.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"schedules": {
+				Description: "Holiday schedule definitions, ordered by date.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        holidayScheduleResource,
+			},
+		},
+	}
+}
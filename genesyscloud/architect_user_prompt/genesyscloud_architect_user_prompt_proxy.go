@@ -135,9 +135,15 @@ func createArchitectUserPromptFn(_ context.Context, p *architectUserPromptProxy,
 	return p.architectApi.PostArchitectPrompts(body)
 }
 
-func getArchitectUserPromptFn(_ context.Context, p *architectUserPromptProxy, id string, includeMediaUris, includeResources bool, languages []string, checkCache bool) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error) {
-	if prompt := rc.GetCacheItem(p.promptCache, id); prompt != nil && checkCache {
-		return prompt, nil, nil
+func getArchitectUserPromptFn(ctx context.Context, p *architectUserPromptProxy, id string, includeMediaUris, includeResources bool, languages []string, checkCache bool) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error) {
+	if checkCache {
+		hydrate := func(ctx context.Context) error {
+			_, _, err := getAllArchitectUserPromptsFn(ctx, p, true, true, "")
+			return err
+		}
+		if prompt := rc.GetCacheItemOrHydrate(ctx, p.promptCache, id, hydrate); prompt != nil {
+			return prompt, nil, nil
+		}
 	}
 	return p.architectApi.GetArchitectPrompt(id, includeMediaUris, includeResources, languages)
 }
@@ -146,16 +152,16 @@ func updateArchitectUserPromptFn(_ context.Context, p *architectUserPromptProxy,
 	return p.architectApi.PutArchitectPrompt(id, body)
 }
 
-func deleteArchitectUserPromptFn(_ context.Context, p *architectUserPromptProxy, id string, allResources bool) (*platformclientv2.APIResponse, error) {
+func deleteArchitectUserPromptFn(ctx context.Context, p *architectUserPromptProxy, id string, allResources bool) (*platformclientv2.APIResponse, error) {
 	resp, err := p.architectApi.DeleteArchitectPrompt(id, allResources)
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.promptCache, id)
+	rc.DeleteCacheItem(ctx, p.promptCache, id)
 	return nil, nil
 }
 
-func getAllArchitectUserPromptsFn(_ context.Context, p *architectUserPromptProxy, includeMediaUris, includeResources bool, name string) (*[]platformclientv2.Prompt, *platformclientv2.APIResponse, error) {
+func getAllArchitectUserPromptsFn(ctx context.Context, p *architectUserPromptProxy, includeMediaUris, includeResources bool, name string) (*[]platformclientv2.Prompt, *platformclientv2.APIResponse, error) {
 	const pageSize = 100
 	var allPrompts []platformclientv2.Prompt
 
@@ -183,7 +189,7 @@ func getAllArchitectUserPromptsFn(_ context.Context, p *architectUserPromptProxy
 	}
 
 	for _, prompt := range allPrompts {
-		rc.SetCache(p.promptCache, *prompt.Id, prompt)
+		rc.SetCache(ctx, p.promptCache, *prompt.Id, prompt)
 	}
 
 	return &allPrompts, response, nil
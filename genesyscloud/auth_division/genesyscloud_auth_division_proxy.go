@@ -108,7 +108,7 @@ func getAllAuthDivisionFn(ctx context.Context, p *authDivisionProxy, name string
 	}
 
 	for _, div := range allAuthzDivisions {
-		rc.SetCache(p.authDivisionCache, *div.Id, div)
+		rc.SetCache(ctx, p.authDivisionCache, *div.Id, div)
 	}
 
 	return &allAuthzDivisions, resp, nil
@@ -121,7 +121,11 @@ func createAuthDivisionFn(ctx context.Context, p *authDivisionProxy, authDivisio
 
 func getAuthDivisionByIdFn(ctx context.Context, p *authDivisionProxy, id string, objectCount, checkCache bool) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
 	if checkCache {
-		div := rc.GetCacheItem(p.authDivisionCache, id)
+		hydrate := func(ctx context.Context) error {
+			_, _, err := getAllAuthDivisionFn(ctx, p, "")
+			return err
+		}
+		div := rc.GetCacheItemOrHydrate(ctx, p.authDivisionCache, id, hydrate)
 		if div != nil {
 			return div, nil, nil
 		}
@@ -161,6 +165,6 @@ func deleteAuthDivisionFn(ctx context.Context, p *authDivisionProxy, id string,
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.authDivisionCache, id)
+	rc.DeleteCacheItem(ctx, p.authDivisionCache, id)
 	return resp, nil
 }
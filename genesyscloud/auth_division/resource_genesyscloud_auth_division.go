@@ -131,7 +131,7 @@ func deleteAuthDivision(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Sometimes a division with resources in it priorly still thinks it is attached to those resources during a destroy run.
 	// We're retrying again as those resources should detach completely eventually.
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting division %s", name)
 		resp, err := proxy.deleteAuthDivision(ctx, d.Id(), false)
 		if err != nil {
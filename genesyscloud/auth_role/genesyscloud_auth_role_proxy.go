@@ -91,7 +91,11 @@ func (p *authRoleProxy) getAuthRoleIdByName(ctx context.Context, name string) (i
 
 // getAuthRoleById returns a single Genesys Cloud auth role by Id
 func (p *authRoleProxy) getAuthRoleById(ctx context.Context, id string) (authRole *platformclientv2.Domainorganizationrole, response *platformclientv2.APIResponse, err error) {
-	if authRole := rc.GetCacheItem(p.authRoleCache, id); authRole != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllAuthRole(ctx)
+		return err
+	}
+	if authRole := rc.GetCacheItemOrHydrate(ctx, p.authRoleCache, id, hydrate); authRole != nil {
 		return authRole, nil, nil
 	}
 	return p.getAuthRoleByIdAttr(ctx, p, id)
@@ -99,7 +103,11 @@ func (p *authRoleProxy) getAuthRoleById(ctx context.Context, id string) (authRol
 
 // getAuthRoleById returns a single Genesys Cloud auth role by Id
 func (p *authRoleProxy) getDefaultRoleById(ctx context.Context, defaultRoleId string) (roleId string, response *platformclientv2.APIResponse, err error) {
-	if authRole := rc.GetCacheItem(p.authRoleCache, defaultRoleId); authRole != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllAuthRole(ctx)
+		return err
+	}
+	if authRole := rc.GetCacheItemOrHydrate(ctx, p.authRoleCache, defaultRoleId, hydrate); authRole != nil {
 		return *authRole.Id, nil, nil
 	}
 	return p.getDefaultRoleIdAttr(ctx, p, defaultRoleId)
@@ -162,7 +170,7 @@ func getAllAuthRoleFn(ctx context.Context, p *authRoleProxy) (*[]platformclientv
 
 	//Cache the Auth Role resource into the p.authRoleCache for later use
 	for _, authRole := range allAuthRoles {
-		rc.SetCache(p.authRoleCache, *authRole.Id, authRole)
+		rc.SetCache(ctx, p.authRoleCache, *authRole.Id, authRole)
 	}
 
 	return &allAuthRoles, resp, nil
@@ -126,7 +126,7 @@ func getAllConversationsMessagingSettingsFn(ctx context.Context, p *conversation
 	}
 
 	for _, setting := range allMessagingSettings {
-		rc.SetCache(p.messagingSettingsCache, *setting.Id, setting)
+		rc.SetCache(ctx, p.messagingSettingsCache, *setting.Id, setting)
 	}
 
 	return &allMessagingSettings, response, nil
@@ -139,7 +139,11 @@ func createConversationsMessagingSettingsFn(ctx context.Context, p *conversation
 
 // getConversationsMessagingSettingsByIdFn is an implementation of the function to get a Genesys Cloud conversations messaging settings by Id
 func getConversationsMessagingSettingsByIdFn(ctx context.Context, p *conversationsMessagingSettingsProxy, id string) (*platformclientv2.Messagingsetting, *platformclientv2.APIResponse, error) {
-	if setting := rc.GetCacheItem(p.messagingSettingsCache, id); setting != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllConversationsMessagingSettingsFn(ctx, p)
+		return err
+	}
+	if setting := rc.GetCacheItemOrHydrate(ctx, p.messagingSettingsCache, id, hydrate); setting != nil {
 		return setting, nil, nil
 	}
 	return p.conversationsApi.GetConversationsMessagingSetting(id)
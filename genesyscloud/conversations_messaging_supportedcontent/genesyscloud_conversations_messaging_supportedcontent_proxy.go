@@ -130,7 +130,7 @@ func getAllSupportedContentFn(ctx context.Context, p *supportedContentProxy) (*[
 	}
 
 	for _, content := range allSupportedContents {
-		rc.SetCache(p.supportedContentCache, *content.Id, content)
+		rc.SetCache(ctx, p.supportedContentCache, *content.Id, content)
 	}
 
 	return &allSupportedContents, resp, nil
@@ -159,7 +159,11 @@ func getSupportedContentIdByNameFn(ctx context.Context, p *supportedContentProxy
 
 // getSupportedContentByIdFn is an implementation of the function to get a Genesys Cloud supported content by Id
 func getSupportedContentByIdFn(ctx context.Context, p *supportedContentProxy, id string) (supportedContent *platformclientv2.Supportedcontent, response *platformclientv2.APIResponse, err error) {
-	content := rc.GetCacheItem(p.supportedContentCache, id)
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllSupportedContentFn(ctx, p)
+		return err
+	}
+	content := rc.GetCacheItemOrHydrate(ctx, p.supportedContentCache, id, hydrate)
 	if content != nil {
 		return content, nil, nil
 	}
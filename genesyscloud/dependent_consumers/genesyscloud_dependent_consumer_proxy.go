@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"log"
+	"sort"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
@@ -18,6 +19,7 @@ type DependentConsumerProxy struct {
 	ClientConfig                   *platformclientv2.Configuration
 	ArchitectApi                   *platformclientv2.ArchitectApi
 	RetrieveDependentConsumersAttr retrieveDependentConsumersFunc
+	RetrieveConsumingResourcesAttr retrieveConsumingResourcesFunc
 	GetPooledClientAttr            retrievePooledClientFunc
 }
 
@@ -27,11 +29,18 @@ func (p *DependentConsumerProxy) GetDependentConsumers(ctx context.Context, reso
 	return p.RetrieveDependentConsumersAttr(ctx, p, resourceKeys)
 }
 
+// GetConsumingResources returns the resources that consume the resource, such as the flows that transfer to a queue.
+// The flow type is only used when the resource is a flow.
+func (p *DependentConsumerProxy) GetConsumingResources(ctx context.Context, resourceType string, id string, flowType string) (resourceExporter.ResourceIDMetaMap, error) {
+	return p.RetrieveConsumingResourcesAttr(ctx, p, resourceType, id, flowType)
+}
+
 func (p *DependentConsumerProxy) GetAllWithPooledClient(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
 	return p.GetPooledClientAttr(method)
 }
 
 type retrieveDependentConsumersFunc func(ctx context.Context, p *DependentConsumerProxy, resourceKeys resourceExporter.ResourceInfo) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, error)
+type retrieveConsumingResourcesFunc func(ctx context.Context, p *DependentConsumerProxy, resourceType string, id string, flowType string) (resourceExporter.ResourceIDMetaMap, error)
 type retrievePooledClientFunc func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
 
 var InternalProxy *DependentConsumerProxy
@@ -54,6 +63,7 @@ func newDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *De
 		InternalProxy.ClientConfig = ClientConfig
 		InternalProxy.ArchitectApi = api
 		InternalProxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
+		InternalProxy.RetrieveConsumingResourcesAttr = retrieveConsumingResourcesFn
 	}
 	return InternalProxy
 }
@@ -85,6 +95,56 @@ func retrieveDependentConsumersFn(ctx context.Context, p *DependentConsumerProxy
 	}, nil
 }
 
+func retrieveConsumingResourcesFn(_ context.Context, p *DependentConsumerProxy, resourceType string, id string, flowType string) (resourceExporter.ResourceIDMetaMap, error) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	objectType, ok := getDependencyObjectType(resourceType, flowType)
+	if !ok {
+		log.Printf("Consuming resources of %s are not tracked", resourceType)
+		return resources, nil
+	}
+
+	const pageSize = 100
+	dependentConsumerMap := SetDependentObjectMaps()
+	for pageNum := 1; ; pageNum++ {
+		consumers, _, err := p.ArchitectApi.GetArchitectDependencytrackingConsumingresources(id, objectType, nil, "", pageNum, pageSize, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resources consuming %s %s: %v", resourceType, id, err)
+		}
+		if consumers.Entities == nil || len(*consumers.Entities) == 0 {
+			break
+		}
+		for _, consumer := range *consumers.Entities {
+			if consumerType, exists := getResourceType(consumer, dependentConsumerMap); exists && *consumer.Id != id {
+				resources[*consumer.Id] = &resourceExporter.ResourceMeta{Name: getResourceFilter(consumer, consumerType)}
+			}
+		}
+		if consumers.PageCount == nil || pageNum >= *consumers.PageCount {
+			break
+		}
+	}
+	return resources, nil
+}
+
+// getDependencyObjectType returns the dependency tracking object type of a resource type
+func getDependencyObjectType(resourceType string, flowType string) (string, bool) {
+	if resourceType == gflow {
+		objectType, ok := SetFlowTypeObjectMaps()[strings.ToUpper(flowType)]
+		return objectType, ok
+	}
+
+	objectTypes := make([]string, 0)
+	for objectType, consumerType := range SetDependentObjectMaps() {
+		if consumerType == resourceType {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	if len(objectTypes) == 0 {
+		return "", false
+	}
+	sort.Strings(objectTypes)
+	return objectTypes[0], true
+}
+
 func fetchDepConsumers(ctx context.Context,
 	p *DependentConsumerProxy,
 	resType string,
@@ -98,7 +98,11 @@ func (p *externalContactsContactsProxy) deleteExternalContactId(ctx context.Cont
 
 // getExternalContactById returns a single Genesys Cloud External Contact by Id
 func (p *externalContactsContactsProxy) getExternalContactById(ctx context.Context, externalContactId string) (*platformclientv2.Externalcontact, *platformclientv2.APIResponse, error) {
-	if externalContacts := rc.GetCacheItem(p.externalContactsCache, externalContactId); externalContacts != nil { // Get the Externalcontact from the cache, if not there in the cache then call p.getExternalContactByIdAttr()
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllExternalContacts(ctx)
+		return err
+	}
+	if externalContacts := rc.GetCacheItemOrHydrate(ctx, p.externalContactsCache, externalContactId, hydrate); externalContacts != nil { // Get the Externalcontact from the cache, if not there in the cache then call p.getExternalContactByIdAttr()
 		return externalContacts, nil, nil
 	}
 	return p.getExternalContactByIdAttr(ctx, p, externalContactId)
@@ -142,7 +146,7 @@ func getAllExternalContactsFn(ctx context.Context, p *externalContactsContactsPr
 		if externalContact.Id == nil {
 			continue
 		}
-		rc.SetCache(p.externalContactsCache, *externalContact.Id, externalContact)
+		rc.SetCache(ctx, p.externalContactsCache, *externalContact.Id, externalContact)
 	}
 
 	return &allExternalContacts, response, nil
@@ -159,7 +163,7 @@ func deleteExternalContactsFn(ctx context.Context, p *externalContactsContactsPr
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.externalContactsCache, externalContactId)
+	rc.DeleteCacheItem(ctx, p.externalContactsCache, externalContactId)
 	return resp, nil
 }
 
@@ -101,17 +101,21 @@ func updateGroupFn(_ context.Context, p *groupProxy, id string, group *platformc
 	return p.groupsApi.PutGroup(id, *group)
 }
 
-func deleteGroupFn(_ context.Context, p *groupProxy, id string) (*platformclientv2.APIResponse, error) {
+func deleteGroupFn(ctx context.Context, p *groupProxy, id string) (*platformclientv2.APIResponse, error) {
 	resp, err := p.groupsApi.DeleteGroup(id)
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.groupCache, id)
+	rc.DeleteCacheItem(ctx, p.groupCache, id)
 	return nil, nil
 }
 
-func getGroupByIdFn(_ context.Context, p *groupProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
-	group := rc.GetCacheItem(p.groupCache, id)
+func getGroupByIdFn(ctx context.Context, p *groupProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllGroupFn(ctx, p)
+		return err
+	}
+	group := rc.GetCacheItemOrHydrate(ctx, p.groupCache, id, hydrate)
 	if group != nil {
 		return group, nil, nil
 	}
@@ -160,7 +164,7 @@ func getGroupByNameFn(_ context.Context, p *groupProxy, name string) (*platformc
 	return groups, resp, getErr
 }
 
-func getAllGroupFn(_ context.Context, p *groupProxy) (*[]platformclientv2.Group, *platformclientv2.APIResponse, error) {
+func getAllGroupFn(ctx context.Context, p *groupProxy) (*[]platformclientv2.Group, *platformclientv2.APIResponse, error) {
 	var allGroups []platformclientv2.Group
 	const pageSize = 100
 
@@ -180,7 +184,7 @@ func getAllGroupFn(_ context.Context, p *groupProxy) (*[]platformclientv2.Group,
 	}
 
 	for _, group := range allGroups {
-		rc.SetCache(p.groupCache, *group.Id, group)
+		rc.SetCache(ctx, p.groupCache, *group.Id, group)
 	}
 
 	return &allGroups, nil, nil
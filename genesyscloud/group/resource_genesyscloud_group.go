@@ -144,7 +144,7 @@ func updateGroup(ctx context.Context, d *schema.ResourceData, meta interface{})
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	gp := getGroupProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current group version
 		group, resp, getErr := gp.getGroupById(ctx, d.Id())
 		if getErr != nil {
@@ -193,7 +193,7 @@ func deleteGroup(ctx context.Context, d *schema.ResourceData, meta interface{})
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	gp := getGroupProxy(sdkConfig)
 
-	util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
 		log.Printf("Deleting group %s", name)
 		resp, err := gp.deleteGroup(ctx, d.Id())
@@ -252,7 +252,7 @@ func updateGroupMembers(ctx context.Context, d *schema.ResourceData, sdkConfig *
 
 			chunkProcessor := func(membersToRemove []string) diag.Diagnostics {
 				if len(membersToRemove) > 0 {
-					if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+					if diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 						_, resp, err := gp.deleteGroupMembers(ctx, d.Id(), strings.Join(membersToRemove, ","))
 						if err != nil {
 							return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove members from group %s: %s", d.Id(), err), resp)
@@ -311,7 +311,7 @@ func getGroupMemberIds(ctx context.Context, d *schema.ResourceData, sdkConfig *p
 
 func addGroupMembers(ctx context.Context, d *schema.ResourceData, membersToAdd []string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	gp := getGroupProxy(sdkConfig)
-	if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	if diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Need the current group version to add members
 		groupInfo, resp, getErr := gp.getGroupById(ctx, d.Id())
 		if getErr != nil {
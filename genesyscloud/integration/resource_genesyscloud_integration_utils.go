@@ -125,7 +125,7 @@ func updateIntegrationConfigFromResourceData(ctx context.Context, d *schema.Reso
 				credential = buildConfigCredentials(configMap["credentials"].(map[string]interface{}))
 			}
 
-			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 
 				// Get latest config version
 				integrationConfig, resp, err := p.getIntegrationConfig(ctx, d.Id())
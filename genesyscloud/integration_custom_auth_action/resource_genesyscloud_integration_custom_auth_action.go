@@ -107,7 +107,7 @@ func createIntegrationCustomAuthAction(ctx context.Context, d *schema.ResourceDa
 	log.Printf("Updating custom auth action of integration %s", integrationId)
 
 	// Update the custom auth action with the actual configuration
-	diagErr = util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr = util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get the latest action version to send with PATCH
 		action, resp, err := cap.getCustomAuthActionById(ctx, authActionId)
 		if err != nil {
@@ -199,7 +199,7 @@ func updateIntegrationCustomAuthAction(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("Updating integration custom auth action %s", *name)
 
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get the latest action version to send with PATCH
 		action, resp, err := cap.getCustomAuthActionById(ctx, d.Id())
 		if err != nil {
@@ -131,7 +131,7 @@ func getAllIntegrationFacebookFn(ctx context.Context, p *integrationFacebookProx
 	}
 
 	for _, facebookReq := range allFacebookIntegrationRequests {
-		rc.SetCache(p.facebookCache, *facebookReq.Id, facebookReq)
+		rc.SetCache(ctx, p.facebookCache, *facebookReq.Id, facebookReq)
 	}
 
 	return &allFacebookIntegrationRequests, resp, err
@@ -162,7 +162,11 @@ func getIntegrationFacebookIdByNameFn(ctx context.Context, p *integrationFaceboo
 
 // getIntegrationFacebookByIdFn is an implementation of the function to get a Genesys Cloud integration facebook by Id
 func getIntegrationFacebookByIdFn(ctx context.Context, p *integrationFacebookProxy, id string) (integrationFacebook *platformclientv2.Facebookintegration, response *platformclientv2.APIResponse, err error) {
-	facebookReq := rc.GetCacheItem(p.facebookCache, id)
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllIntegrationFacebookFn(ctx, p)
+		return err
+	}
+	facebookReq := rc.GetCacheItemOrHydrate(ctx, p.facebookCache, id, hydrate)
 	if facebookReq != nil {
 		return facebookReq, nil, nil
 	}
@@ -79,13 +79,17 @@ func (p *journeyViewsProxy) deleteJourneyView(ctx context.Context, viewId string
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.journeyViewCache, viewId)
+	rc.DeleteCacheItem(ctx, p.journeyViewCache, viewId)
 	return resp, nil
 }
 
-func getJourneyViewByViewIdFn(_ context.Context, p *journeyViewsProxy, viewId string) (*platformclientv2.Journeyview, *platformclientv2.APIResponse, error) {
+func getJourneyViewByViewIdFn(ctx context.Context, p *journeyViewsProxy, viewId string) (*platformclientv2.Journeyview, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllJourneyViewsFn(ctx, p, "")
+		return err
+	}
 	// Check the cache first
-	journeyView := rc.GetCacheItem(p.journeyViewCache, viewId)
+	journeyView := rc.GetCacheItemOrHydrate(ctx, p.journeyViewCache, viewId, hydrate)
 	if journeyView != nil {
 		return journeyView, nil, nil
 	}
@@ -135,8 +139,8 @@ func getAllJourneyViewsFn(ctx context.Context, p *journeyViewsProxy, name string
 	}
 
 	// Check if the journey view cache is populated, if it is, return that instead
-	if rc.GetCacheSize(p.journeyViewCache) != 0 {
-		return rc.GetCache(p.journeyViewCache), nil, nil
+	if rc.GetCacheSize(ctx, p.journeyViewCache) != 0 {
+		return rc.GetCache(ctx, p.journeyViewCache), nil, nil
 	}
 
 	if journeys.Entities == nil || len(*journeys.Entities) == 0 {
@@ -159,7 +163,7 @@ func getAllJourneyViewsFn(ctx context.Context, p *journeyViewsProxy, name string
 	}
 
 	for _, journeys := range allJourneys {
-		rc.SetCache(p.journeyViewCache, *journeys.Id, journeys)
+		rc.SetCache(ctx, p.journeyViewCache, *journeys.Id, journeys)
 	}
 
 	return &allJourneys, resp, nil
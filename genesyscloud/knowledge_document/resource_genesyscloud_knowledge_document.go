@@ -152,7 +152,7 @@ func updateKnowledgeDocument(ctx context.Context, d *schema.ResourceData, meta i
 	proxy := GetKnowledgeDocumentProxy(sdkConfig)
 
 	log.Printf("Updating Knowledge document %s", knowledgeDocumentId)
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current Knowledge document version
 		_, resp, getErr := proxy.getKnowledgeKnowledgebaseDocument(ctx, knowledgeBaseId, knowledgeDocumentId, nil, state)
 		if getErr != nil {
@@ -107,7 +107,7 @@ func getAllLocationFn(ctx context.Context, p *locationProxy) (*[]platformclientv
 	}
 
 	for _, location := range allLocations {
-		rc.SetCache(p.locationCache, *location.Id, location)
+		rc.SetCache(ctx, p.locationCache, *location.Id, location)
 	}
 
 	return &allLocations, resp, nil
@@ -118,7 +118,11 @@ func createLocationFn(ctx context.Context, p *locationProxy, location *platformc
 }
 
 func getLocationByIdFn(ctx context.Context, p *locationProxy, id string, expand []string) (*platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error) {
-	if location := rc.GetCacheItem(p.locationCache, id); location != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllLocationFn(ctx, p)
+		return err
+	}
+	if location := rc.GetCacheItemOrHydrate(ctx, p.locationCache, id, hydrate); location != nil {
 		return location, nil, nil
 	}
 	return p.locationsApi.GetLocation(id, expand)
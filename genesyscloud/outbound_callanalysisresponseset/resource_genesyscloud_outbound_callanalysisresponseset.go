@@ -102,7 +102,7 @@ func deleteOutboundCallanalysisresponseset(ctx context.Context, d *schema.Resour
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getOutboundCallanalysisresponsesetProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting Outbound Call Analysis Response Set")
 		resp, err := proxy.deleteOutboundCallanalysisresponseset(ctx, d.Id())
 		if err != nil {
@@ -87,7 +87,11 @@ func (p *outboundCampaignProxy) getOutboundCampaignIdByName(ctx context.Context,
 
 // getOutboundCampaignById returns a single Genesys Cloud outbound campaign by Id
 func (p *outboundCampaignProxy) getOutboundCampaignById(ctx context.Context, id string) (outboundCampaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error) {
-	if campaign := rc.GetCacheItem(p.campaignCache, id); campaign != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllOutboundCampaign(ctx)
+		return err
+	}
+	if campaign := rc.GetCacheItemOrHydrate(ctx, p.campaignCache, id, hydrate); campaign != nil {
 		return campaign, nil, nil
 	}
 	return p.getOutboundCampaignByIdAttr(ctx, p, id)
@@ -144,7 +148,7 @@ func createOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy, outbo
 }
 
 // getAllOutboundCampaignFn is the implementation for retrieving all outbound campaign in Genesys Cloud
-func getAllOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
+func getAllOutboundCampaignFn(ctx context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
 	var allCampaigns []platformclientv2.Campaign
 	const pageSize = 100
 
@@ -173,7 +177,7 @@ func getAllOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy) (*[]p
 	}
 
 	for _, campaign := range allCampaigns {
-		rc.SetCache(p.campaignCache, *campaign.Id, campaign)
+		rc.SetCache(ctx, p.campaignCache, *campaign.Id, campaign)
 	}
 
 	return &allCampaigns, resp, nil
@@ -223,11 +227,11 @@ func updateOutboundCampaignFn(ctx context.Context, p *outboundCampaignProxy, id
 }
 
 // deleteOutboundCampaignFn is an implementation function for deleting a Genesys Cloud outbound campaign
-func deleteOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy, id string) (response *platformclientv2.APIResponse, err error) {
+func deleteOutboundCampaignFn(ctx context.Context, p *outboundCampaignProxy, id string) (response *platformclientv2.APIResponse, err error) {
 	_, resp, err := p.outboundApi.DeleteOutboundCampaign(id)
 	if err != nil {
 		return resp, fmt.Errorf("failed to delete campaign: %s", err)
 	}
-	rc.DeleteCacheItem(p.campaignCache, id)
+	rc.DeleteCacheItem(ctx, p.campaignCache, id)
 	return resp, nil
 }
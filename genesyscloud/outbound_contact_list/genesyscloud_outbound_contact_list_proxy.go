@@ -124,7 +124,7 @@ func getAllOutboundContactlistFn(ctx context.Context, p *outboundContactlistProx
 	}
 
 	for _, contactList := range allContactlists {
-		rc.SetCache(p.contactListCache, *contactList.Id, contactList)
+		rc.SetCache(ctx, p.contactListCache, *contactList.Id, contactList)
 	}
 
 	return &allContactlists, resp, nil
@@ -149,10 +149,14 @@ func getOutboundContactlistIdByNameFn(ctx context.Context, p *outboundContactlis
 
 // getOutboundContactlistByIdFn is an implementation of the function to get a Genesys Cloud outbound contactlist by Id
 func getOutboundContactlistByIdFn(ctx context.Context, p *outboundContactlistProxy, id string) (outboundContactlist *platformclientv2.Contactlist, response *platformclientv2.APIResponse, err error) {
-	if contactList := rc.GetCacheItem(p.contactListCache, id); contactList != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllOutboundContactlistFn(ctx, p, "")
+		return err
+	}
+	if contactList := rc.GetCacheItemOrHydrate(ctx, p.contactListCache, id, hydrate); contactList != nil {
 		return contactList, nil, nil
 	}
-	if tfexporter_state.IsExporterActive() {
+	if tfexporter_state.IsExporterActiveContext(ctx) {
 		log.Printf("Could not read contact list '%s' from cache. Reading from the API...", id)
 	}
 	return p.outboundApi.GetOutboundContactlist(id, false, false)
@@ -175,6 +179,6 @@ func deleteOutboundContactlistFn(ctx context.Context, p *outboundContactlistProx
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.contactListCache, id)
+	rc.DeleteCacheItem(ctx, p.contactListCache, id)
 	return resp, nil
 }
@@ -114,7 +114,7 @@ func updateOutboundContactList(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	log.Printf("Updating Outbound Contact List %s", name)
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 
 		_, resp, updateErr := proxy.updateOutboundContactlist(ctx, d.Id(), &sdkContactList)
 		if updateErr != nil {
@@ -189,7 +189,7 @@ func deleteOutboundContactList(ctx context.Context, d *schema.ResourceData, meta
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getOutboundContactlistProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting Outbound Contact List")
 		resp, err := proxy.deleteOutboundContactlist(ctx, d.Id())
 		if err != nil {
@@ -70,11 +70,11 @@ func createContactFn(_ context.Context, p *contactProxy, contactListId string, c
 	return p.outboundApi.PostOutboundContactlistContacts(contactListId, []platformclientv2.Writabledialercontact{contact}, priority, clearSystemData, doNotQueue)
 }
 
-func readContactByIdFn(_ context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
-	if contact := rc.GetCacheItem(p.contactCache, createComplexContact(contactListId, contactId)); contact != nil {
+func readContactByIdFn(ctx context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	if contact := rc.GetCacheItem(ctx, p.contactCache, createComplexContact(contactListId, contactId)); contact != nil {
 		return contact, nil, nil
 	}
-	if tfexporter_state.IsExporterActive() {
+	if tfexporter_state.IsExporterActiveContext(ctx) {
 		log.Printf("Could not read contact '%s' from cache (Contact list '%s'). Reading from the API...", contactId, contactListId)
 	}
 	return p.outboundApi.GetOutboundContactlistContact(contactListId, contactId)
@@ -84,12 +84,12 @@ func updateContactFn(_ context.Context, p *contactProxy, contactListId, contactI
 	return p.outboundApi.PutOutboundContactlistContact(contactListId, contactId, contact)
 }
 
-func deleteContactFn(_ context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.APIResponse, error) {
+func deleteContactFn(ctx context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.APIResponse, error) {
 	resp, err := p.outboundApi.DeleteOutboundContactlistContact(contactListId, contactId)
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.contactCache, createComplexContact(contactListId, contactId))
+	rc.DeleteCacheItem(ctx, p.contactCache, createComplexContact(contactListId, contactId))
 	return resp, nil
 }
 
@@ -113,7 +113,7 @@ func getAllContactsFn(ctx context.Context, p *contactProxy) ([]platformclientv2.
 
 	for contactListId, contactListContacts := range contactMatrix {
 		for _, contact := range contactListContacts {
-			rc.SetCache(p.contactCache, createComplexContact(contactListId, *contact.Id), contact)
+			rc.SetCache(ctx, p.contactCache, createComplexContact(contactListId, *contact.Id), contact)
 		}
 	}
 
@@ -0,0 +1,159 @@
+package outbound_contact_list_contacts
+
+import (
+	"context"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The genesyscloud_outbound_contact_list_contacts_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+type getContactListByIdFunc func(ctx context.Context, p *contactListContactsProxy, contactListId string) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error)
+type getAllContactListsFunc func(ctx context.Context, p *contactListContactsProxy) ([]platformclientv2.Contactlist, *platformclientv2.APIResponse, error)
+type getContactsByContactListIdFunc func(ctx context.Context, p *contactListContactsProxy, contactListId string) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error)
+type upsertContactsFunc func(ctx context.Context, p *contactListContactsProxy, contactListId string, contacts []platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error)
+type deleteContactsFunc func(ctx context.Context, p *contactListContactsProxy, contactListId string, contactIds []string) (*platformclientv2.APIResponse, error)
+
+// contactListContactsProxy contains all of the methods that call genesys cloud APIs.
+type contactListContactsProxy struct {
+	clientConfig                   *platformclientv2.Configuration
+	outboundApi                    *platformclientv2.OutboundApi
+	getContactListByIdAttr         getContactListByIdFunc
+	getAllContactListsAttr         getAllContactListsFunc
+	getContactsByContactListIdAttr getContactsByContactListIdFunc
+	upsertContactsAttr             upsertContactsFunc
+	deleteContactsAttr             deleteContactsFunc
+}
+
+var internalProxy *contactListContactsProxy
+
+// newContactListContactsProxy initializes the contact list contacts proxy with all of the data needed to communicate with Genesys Cloud
+func newContactListContactsProxy(clientConfig *platformclientv2.Configuration) *contactListContactsProxy {
+	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+	return &contactListContactsProxy{
+		clientConfig:                   clientConfig,
+		outboundApi:                    api,
+		getContactListByIdAttr:         getContactListByIdFn,
+		getAllContactListsAttr:         getAllContactListsFn,
+		getContactsByContactListIdAttr: getContactsByContactListIdFn,
+		upsertContactsAttr:             upsertContactsFn,
+		deleteContactsAttr:             deleteContactsFn,
+	}
+}
+
+// getContactListContactsProxy acts as a singleton for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getContactListContactsProxy(clientConfig *platformclientv2.Configuration) *contactListContactsProxy {
+	if internalProxy == nil {
+		internalProxy = newContactListContactsProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getContactListById returns the contact list the contacts belong to
+func (p *contactListContactsProxy) getContactListById(ctx context.Context, contactListId string) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+	return p.getContactListByIdAttr(ctx, p, contactListId)
+}
+
+// getAllContactLists returns every contact list in the org
+func (p *contactListContactsProxy) getAllContactLists(ctx context.Context) ([]platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+	return p.getAllContactListsAttr(ctx, p)
+}
+
+// getContactsByContactListId returns every contact in a contact list
+func (p *contactListContactsProxy) getContactsByContactListId(ctx context.Context, contactListId string) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	return p.getContactsByContactListIdAttr(ctx, p, contactListId)
+}
+
+// upsertContacts adds a chunk of contacts to a contact list. Contacts with an ID replace the existing contact.
+func (p *contactListContactsProxy) upsertContacts(ctx context.Context, contactListId string, contacts []platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	return p.upsertContactsAttr(ctx, p, contactListId, contacts, priority, clearSystemData, doNotQueue)
+}
+
+// deleteContacts removes a chunk of contacts from a contact list
+func (p *contactListContactsProxy) deleteContacts(ctx context.Context, contactListId string, contactIds []string) (*platformclientv2.APIResponse, error) {
+	return p.deleteContactsAttr(ctx, p, contactListId, contactIds)
+}
+
+func getContactListByIdFn(_ context.Context, p *contactListContactsProxy, contactListId string) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundContactlist(contactListId, false, false)
+}
+
+func getAllContactListsFn(_ context.Context, p *contactListContactsProxy) ([]platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allContactLists []platformclientv2.Contactlist
+
+	contactLists, resp, err := p.outboundApi.GetOutboundContactlists(false, false, pageSize, 1, true, "", "", []string{}, []string{}, "", "")
+	if err != nil {
+		return nil, resp, err
+	}
+	if contactLists.Entities == nil || len(*contactLists.Entities) == 0 {
+		return allContactLists, resp, nil
+	}
+	allContactLists = append(allContactLists, *contactLists.Entities...)
+
+	for pageNum := 2; pageNum <= *contactLists.PageCount; pageNum++ {
+		contactLists, resp, err := p.outboundApi.GetOutboundContactlists(false, false, pageSize, pageNum, true, "", "", []string{}, []string{}, "", "")
+		if err != nil {
+			return nil, resp, err
+		}
+		if contactLists.Entities == nil || len(*contactLists.Entities) == 0 {
+			break
+		}
+		allContactLists = append(allContactLists, *contactLists.Entities...)
+	}
+
+	return allContactLists, resp, nil
+}
+
+func getContactsByContactListIdFn(_ context.Context, p *contactListContactsProxy, contactListId string) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	var (
+		pageNum     = 1
+		pageSize    = 100
+		allContacts []platformclientv2.Dialercontact
+	)
+
+	body := platformclientv2.Contactlistingrequest{
+		PageNumber: &pageNum,
+		PageSize:   &pageSize,
+	}
+
+	data, resp, err := p.outboundApi.PostOutboundContactlistContactsSearch(contactListId, body)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data == nil || data.Entities == nil || len(*data.Entities) == 0 {
+		return allContacts, resp, nil
+	}
+	allContacts = append(allContacts, *data.Entities...)
+
+	if data.PageCount == nil {
+		return allContacts, resp, nil
+	}
+
+	for pageNum = 2; pageNum <= *data.PageCount; pageNum++ {
+		body.PageNumber = &pageNum
+		data, resp, err = p.outboundApi.PostOutboundContactlistContactsSearch(contactListId, body)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data == nil || data.Entities == nil || len(*data.Entities) == 0 {
+			break
+		}
+		allContacts = append(allContacts, *data.Entities...)
+	}
+
+	return allContacts, resp, nil
+}
+
+func upsertContactsFn(_ context.Context, p *contactListContactsProxy, contactListId string, contacts []platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.PostOutboundContactlistContacts(contactListId, contacts, priority, clearSystemData, doNotQueue)
+}
+
+func deleteContactsFn(_ context.Context, p *contactListContactsProxy, contactListId string, contactIds []string) (*platformclientv2.APIResponse, error) {
+	return p.outboundApi.DeleteOutboundContactlistContacts(contactListId, contactIds)
+}
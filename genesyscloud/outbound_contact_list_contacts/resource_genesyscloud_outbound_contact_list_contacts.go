@@ -0,0 +1,244 @@
+package outbound_contact_list_contacts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_contact_list_contacts.go contains all of the methods that perform the core logic for a resource.
+The resource is identified by the ID of its contact list.
+*/
+
+// getAllContactListContacts retrieves the contacts of every contact list and is used for the exporter
+func getAllContactListContacts(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	proxy := getContactListContactsProxy(clientConfig)
+
+	contactLists, resp, err := proxy.getAllContactLists(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to get contact lists: %s", err), resp)
+	}
+
+	for _, contactList := range contactLists {
+		resources[*contactList.Id] = &resourceExporter.ResourceMeta{Name: *contactList.Name}
+	}
+
+	return resources, nil
+}
+
+func createOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	contactListId := d.Get("contact_list_id").(string)
+
+	log.Printf("Uploading contacts of contact list '%s'", contactListId)
+	if diagErr := applyContactsFile(ctx, d, meta, contactListId); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(contactListId)
+	log.Printf("Uploaded contacts of contact list '%s'", contactListId)
+	return readContactListContacts(ctx, d, meta, false)
+}
+
+func readOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return readContactListContacts(ctx, d, meta, true)
+}
+
+// readContactListContacts reads the contacts of the contact list. When checkDrift is set, the file_content_hash is
+// cleared if the contacts no longer match the file so that the next plan uploads the file again.
+func readContactListContacts(ctx context.Context, d *schema.ResourceData, meta any, checkDrift bool) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getContactListContactsProxy(sdkConfig)
+	contactListId := d.Id()
+
+	log.Printf("Reading contacts of contact list '%s'", contactListId)
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		contactList, resp, err := proxy.getContactListById(ctx, contactListId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read contact list %s | error: %s", contactListId, err), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read contact list %s | error: %s", contactListId, err), resp))
+		}
+
+		contacts, resp, err := proxy.getContactsByContactListId(ctx, contactListId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read contacts of contact list %s | error: %s", contactListId, err), resp))
+		}
+
+		keyColumn := d.Get("key_column").(string)
+		if keyColumn == "" {
+			keyColumn = defaultKeyColumn(contactList)
+		}
+
+		_ = d.Set("contact_list_id", contactListId)
+		_ = d.Set("key_column", keyColumn)
+		_ = d.Set("contact_count", len(contacts))
+
+		if checkDrift {
+			files.ClearFileHashOnDrift(d, func(filePath string) (string, error) {
+				rows, err := readContactsFile(filePath)
+				if err != nil {
+					return "", err
+				}
+				diff, err := diffContacts(rows, contacts, keyColumn)
+				if err != nil || diff.isEmpty() {
+					return "", err
+				}
+				return fmt.Sprintf("%d contacts to add, %d to update, %d to delete", len(diff.adds), len(diff.updates), len(diff.deletes)), nil
+			})
+		}
+
+		log.Printf("Read %d contacts of contact list '%s'", len(contacts), contactListId)
+		return nil
+	})
+}
+
+func updateOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	contactListId := d.Id()
+
+	log.Printf("Updating contacts of contact list '%s'", contactListId)
+	if diagErr := applyContactsFile(ctx, d, meta, contactListId); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated contacts of contact list '%s'", contactListId)
+	return readContactListContacts(ctx, d, meta, false)
+}
+
+func deleteOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getContactListContactsProxy(sdkConfig)
+	contactListId := d.Id()
+
+	contacts, resp, err := proxy.getContactsByContactListId(ctx, contactListId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			log.Printf("Contact list '%s' already deleted", contactListId)
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read contacts of contact list %s | error: %s", contactListId, err), resp)
+	}
+
+	log.Printf("Deleting %d contacts from contact list '%s'", len(contacts), contactListId)
+	contactIdChunks := chunks.ChunkItems(contacts, func(contact platformclientv2.Dialercontact) string {
+		return *contact.Id
+	}, maxContactIdsPerDelete)
+	if diagErr := deleteContactChunks(ctx, proxy, contactListId, contactIdChunks); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Deleted contacts from contact list '%s'", contactListId)
+	return nil
+}
+
+// applyContactsFile diffs the contacts file against the contact list and sends the adds, updates and deletes in chunks
+func applyContactsFile(ctx context.Context, d *schema.ResourceData, meta any, contactListId string) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getContactListContactsProxy(sdkConfig)
+
+	filePath := d.Get("filepath").(string)
+	priority := d.Get("priority").(bool)
+	clearSystemData := d.Get("clear_system_data").(bool)
+	doNotQueue := d.Get("do_not_queue").(bool)
+
+	rows, err := readContactsFile(filePath)
+	if err != nil {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("failed to read contacts file %s", filePath), err)
+	}
+
+	contactList, resp, err := proxy.getContactListById(ctx, contactListId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read contact list %s | error: %s", contactListId, err), resp)
+	}
+	keyColumn := d.Get("key_column").(string)
+	if keyColumn == "" {
+		keyColumn = defaultKeyColumn(contactList)
+	}
+	if keyColumn == "" {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("no key column for contact list %s", contactListId), fmt.Errorf("key_column must be set when the contact list has no columns"))
+	}
+
+	contacts, resp, err := proxy.getContactsByContactListId(ctx, contactListId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to read contacts of contact list %s | error: %s", contactListId, err), resp)
+	}
+
+	diff, err := diffContacts(rows, contacts, keyColumn)
+	if err != nil {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("invalid contacts file %s", filePath), err)
+	}
+	log.Printf("Contact list '%s': %d contacts to add, %d to update, %d to delete", contactListId, len(diff.adds), len(diff.updates), len(diff.deletes))
+
+	addChunks := chunks.ChunkItems(diff.adds, func(row contactRow) platformclientv2.Writabledialercontact {
+		return buildWritableContact(contactListId, "", row)
+	}, maxContactsPerRequest)
+	updateChunks := chunks.ChunkItems(diff.updates, func(update contactUpdate) platformclientv2.Writabledialercontact {
+		return buildWritableContact(contactListId, update.contactId, update.row)
+	}, maxContactsPerRequest)
+	deleteChunks := chunks.ChunkItems(diff.deletes, func(contactId string) string {
+		return contactId
+	}, maxContactIdsPerDelete)
+
+	// Rows are matched to contacts by key, so the deletes, updates and adds never touch the same contact
+	if diagErr := deleteContactChunks(ctx, proxy, contactListId, deleteChunks); diagErr != nil {
+		return diagErr
+	}
+	return chunks.ProcessChunks(append(updateChunks, addChunks...), func(chunk []platformclientv2.Writabledialercontact) diag.Diagnostics {
+		if len(chunk) == 0 {
+			return nil
+		}
+		if _, resp, err := proxy.upsertContacts(ctx, contactListId, chunk, priority, clearSystemData, doNotQueue); err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to upload %d contacts to contact list %s | error: %s", len(chunk), contactListId, err), resp)
+		}
+		return nil
+	})
+}
+
+func deleteContactChunks(ctx context.Context, proxy *contactListContactsProxy, contactListId string, contactIdChunks [][]string) diag.Diagnostics {
+	return chunks.ProcessChunks(contactIdChunks, func(chunk []string) diag.Diagnostics {
+		if len(chunk) == 0 {
+			return nil
+		}
+		if resp, err := proxy.deleteContacts(ctx, contactListId, chunk); err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to delete %d contacts from contact list %s | error: %s", len(chunk), contactListId, err), resp)
+		}
+		return nil
+	})
+}
+
+// ContactListContactsResolver writes the contacts of a contact list to a CSV file in the export directory and points
+// the exported filepath at it
+var ContactListContactsResolver = files.CsvExportResolver("contacts", getContactsCsv)
+
+// getContactsCsv returns the contacts of a contact list as CSV
+func getContactsCsv(ctx context.Context, meta interface{}, contactListId string) ([]byte, error) {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getContactListContactsProxy(sdkConfig)
+
+	contactList, _, err := proxy.getContactListById(ctx, contactListId)
+	if err != nil {
+		return nil, err
+	}
+	contacts, _, err := proxy.getContactsByContactListId(ctx, contactListId)
+	if err != nil {
+		return nil, err
+	}
+
+	var columnNames []string
+	if contactList.ColumnNames != nil {
+		columnNames = *contactList.ColumnNames
+	}
+	return writeContactsCsv(columnNames, contacts)
+}
@@ -0,0 +1,106 @@
+package outbound_contact_list_contacts
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+resource_genesyscloud_outbound_contact_list_contacts_schema.go holds three functions within it:
+
+1.  The registration code that registers the Resource and Exporter for the package.
+2.  The resource schema definitions for the outbound_contact_list_contacts resource.
+3.  The resource exporter configuration for the outbound_contact_list_contacts exporter.
+*/
+const resourceName = "genesyscloud_outbound_contact_list_contacts"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceOutboundContactListContacts())
+	regInstance.RegisterExporter(resourceName, ContactListContactsExporter())
+}
+
+// ResourceOutboundContactListContacts registers the genesyscloud_outbound_contact_list_contacts resource with Terraform
+func ResourceOutboundContactListContacts() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Outbound Contact List Contacts. Manages all of the contacts of a contact list from a CSV or JSONL file.
+Rows are matched to existing contacts by the key column, and only the contacts that were added, changed or removed are sent to the API.`,
+
+		CreateContext: provider.CreateWithPooledClient(createOutboundContactListContacts),
+		ReadContext:   provider.ReadWithPooledClient(readOutboundContactListContacts),
+		UpdateContext: provider.UpdateWithPooledClient(updateOutboundContactListContacts),
+		DeleteContext: provider.DeleteWithPooledClient(deleteOutboundContactListContacts),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"contact_list_id": {
+				Description: `The identifier of the contact list containing the contacts.`,
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filepath": {
+				Description: `Path or URL of the file containing the contacts. Files ending in .jsonl or .ndjson are read as one JSON object per line, all other files are read as CSV with a header row.
+Every contact in the contact list that is not in the file is deleted.`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: `Hash value of the contacts file content. Used to detect changes.`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"key_column": {
+				Description: `The column that uniquely identifies a contact. Rows are matched to the existing contacts of the contact list by the value of this column. Defaults to the first column of the contact list.`,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"priority": {
+				Description: `Contact priority. True means added contacts will be dialed next; false means they will go to the end of the contact queue.`,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"clear_system_data": {
+				Description: `Clear system data. True means the system columns (attempts, callable status, etc) stored on updated contacts will be cleared; false means they won't.`,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"do_not_queue": {
+				Description: `Do not queue. True means that updated contacts will not have their positions in the queue altered, so contacts that have already been dialed will not be redialed.`,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"contact_count": {
+				Description: `The number of contacts in the contact list.`,
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ContactListContactsExporter returns the resourceExporter object used to hold the genesyscloud_outbound_contact_list_contacts exporter's config
+func ContactListContactsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc:   provider.GetAllWithPooledClient(getAllContactListContacts),
+		ExcludedAttributes: []string{"contact_count"},
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"contact_list_id": {RefType: "genesyscloud_outbound_contact_list"},
+		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: ContactListContactsResolver,
+			SubDirectory:              "contacts",
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package outbound_contact_list_contacts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseContactsFiles(t *testing.T) {
+	csvRows, err := parseCsvContacts(strings.NewReader("\ufeffid,name,phone\n1,Alice,+13175550001\n2,\"Bob, Jr\",+13175550002\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []contactRow{
+		{"id": "1", "name": "Alice", "phone": "+13175550001"},
+		{"id": "2", "name": "Bob, Jr", "phone": "+13175550002"},
+	}, csvRows)
+
+	jsonlRows, err := parseJsonlContacts(strings.NewReader(`{"id": 1, "name": "Alice", "vip": true}
+{"id": "2", "name": null}
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, []contactRow{
+		{"id": "1", "name": "Alice", "vip": "true"},
+		{"id": "2", "name": ""},
+	}, jsonlRows)
+
+	_, err = parseJsonlContacts(strings.NewReader(`{"id": 1, "address": {"zip": "46240"}}`))
+	assert.NotNil(t, err)
+
+	assert.True(t, isJsonlFile("contacts.JSONL"))
+	assert.True(t, isJsonlFile("contacts.ndjson"))
+	assert.False(t, isJsonlFile("contacts.csv"))
+}
+
+func TestUnitDiffContacts(t *testing.T) {
+	rows := []contactRow{
+		{"id": "1", "name": "Alice"},
+		{"id": "2", "name": "Bob"},
+		{"id": "4", "name": "Dave"},
+	}
+	contacts := []platformclientv2.Dialercontact{
+		buildDialerContact("c1", map[string]string{"id": "1", "name": "Alice", "phone": ""}),
+		buildDialerContact("c2", map[string]string{"id": "2", "name": "Robert"}),
+		buildDialerContact("c3", map[string]string{"id": "3", "name": "Carol"}),
+		buildDialerContact("c4", map[string]string{"id": "1", "name": "Alice"}),
+	}
+
+	diff, err := diffContacts(rows, contacts, "id")
+	assert.Nil(t, err)
+	assert.Equal(t, []contactRow{{"id": "4", "name": "Dave"}}, diff.adds)
+	assert.Equal(t, []contactUpdate{{contactId: "c2", row: contactRow{"id": "2", "name": "Bob"}}}, diff.updates)
+	assert.Equal(t, []string{"c3", "c4"}, diff.deletes)
+
+	unchanged, err := diffContacts(rows[:1], contacts[:1], "id")
+	assert.Nil(t, err)
+	assert.True(t, unchanged.isEmpty())
+
+	_, err = diffContacts([]contactRow{{"id": "1"}, {"id": "1"}}, nil, "id")
+	assert.ErrorContains(t, err, "more than once")
+
+	_, err = diffContacts([]contactRow{{"name": "Alice"}}, nil, "id")
+	assert.ErrorContains(t, err, "no value for key column")
+}
+
+func TestUnitWriteContactsCsv(t *testing.T) {
+	contacts := []platformclientv2.Dialercontact{
+		buildDialerContact("c1", map[string]string{"id": "1", "name": "Alice", "zip": "46240"}),
+		buildDialerContact("c2", map[string]string{"id": "2", "name": "Bob, Jr"}),
+	}
+
+	content, err := writeContactsCsv([]string{"id", "name"}, contacts)
+	assert.Nil(t, err)
+	assert.Equal(t, "id,name,zip\n1,Alice,46240\n2,\"Bob, Jr\",\n", string(content))
+
+	rows, err := parseCsvContacts(strings.NewReader(string(content)))
+	assert.Nil(t, err)
+	diff, err := diffContacts(rows, contacts, "id")
+	assert.Nil(t, err)
+	assert.True(t, diff.isEmpty())
+}
+
+func TestUnitResourceOutboundContactListContactsCreate(t *testing.T) {
+	contactListId := uuid.NewString()
+	columnNames := []string{"id", "name"}
+	liveContacts := []platformclientv2.Dialercontact{
+		buildDialerContact("c1", map[string]string{"id": "1", "name": "Alice"}),
+		buildDialerContact("c2", map[string]string{"id": "2", "name": "Robert"}),
+		buildDialerContact("c3", map[string]string{"id": "3", "name": "Carol"}),
+	}
+
+	filePath := filepath.Join(t.TempDir(), "contacts.csv")
+	if err := os.WriteFile(filePath, []byte("id,name\n1,Alice\n2,Bob\n4,Dave\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		upserted   []platformclientv2.Writabledialercontact
+		deletedIds []string
+	)
+	contactsProxy := &contactListContactsProxy{}
+	contactsProxy.getContactListByIdAttr = func(ctx context.Context, p *contactListContactsProxy, id string) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, contactListId, id)
+		return &platformclientv2.Contactlist{Id: &contactListId, ColumnNames: &columnNames}, nil, nil
+	}
+	contactsProxy.getContactsByContactListIdAttr = func(ctx context.Context, p *contactListContactsProxy, id string) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+		return liveContacts, nil, nil
+	}
+	contactsProxy.upsertContactsAttr = func(ctx context.Context, p *contactListContactsProxy, id string, contacts []platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, contactListId, id)
+		assert.True(t, priority)
+		upserted = append(upserted, contacts...)
+		return nil, nil, nil
+	}
+	contactsProxy.deleteContactsAttr = func(ctx context.Context, p *contactListContactsProxy, id string, contactIds []string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, contactListId, id)
+		deletedIds = append(deletedIds, contactIds...)
+		return nil, nil
+	}
+	internalProxy = contactsProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceSchema := ResourceOutboundContactListContacts().Schema
+	resourceDataMap := map[string]interface{}{
+		"contact_list_id":   contactListId,
+		"filepath":          filePath,
+		"file_content_hash": "hash",
+		"priority":          true,
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, resourceDataMap)
+
+	diag := createOutboundContactListContacts(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, contactListId, d.Id())
+	assert.Equal(t, "id", d.Get("key_column").(string))
+	assert.Equal(t, "hash", d.Get("file_content_hash").(string))
+
+	assert.Equal(t, []string{"c3"}, deletedIds)
+	if assert.Len(t, upserted, 2) {
+		assert.Equal(t, "c2", *upserted[0].Id)
+		assert.Equal(t, "Bob", (*upserted[0].Data)["name"])
+		assert.Nil(t, upserted[1].Id)
+		assert.Equal(t, "Dave", (*upserted[1].Data)["name"])
+	}
+
+	// Reading while the contact list still differs from the file clears the hash so the file is uploaded again
+	diag = readOutboundContactListContacts(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, "", d.Get("file_content_hash").(string))
+	assert.Equal(t, len(liveContacts), d.Get("contact_count").(int))
+}
+
+func buildDialerContact(id string, data map[string]string) platformclientv2.Dialercontact {
+	return platformclientv2.Dialercontact{Id: &id, Data: &data}
+}
@@ -0,0 +1,254 @@
+package outbound_contact_list_contacts
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_contact_list_contacts_utils.go file contains the helpers that read a contacts file,
+diff its rows against the live contacts of a contact list and write the contacts of a list back out as CSV.
+*/
+
+const (
+	// The contacts endpoint accepts up to 1000 contacts per request
+	maxContactsPerRequest = 1000
+	// Contact IDs to delete are sent as a query parameter, so the chunks are kept small enough for the URL length limit
+	maxContactIdsPerDelete = 100
+)
+
+// contactRow holds the column values of one contact read from the contacts file
+type contactRow map[string]string
+
+type contactUpdate struct {
+	contactId string
+	row       contactRow
+}
+
+// contactDiff holds the changes needed to make a contact list match a contacts file
+type contactDiff struct {
+	adds    []contactRow
+	updates []contactUpdate
+	deletes []string
+}
+
+func (c *contactDiff) isEmpty() bool {
+	return len(c.adds) == 0 && len(c.updates) == 0 && len(c.deletes) == 0
+}
+
+// readContactsFile reads the rows of a CSV or JSONL contacts file from a local path or URL
+func readContactsFile(path string) ([]contactRow, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	if isJsonlFile(path) {
+		return parseJsonlContacts(reader)
+	}
+	return parseCsvContacts(reader)
+}
+
+func isJsonlFile(path string) bool {
+	lowerPath := strings.ToLower(path)
+	return strings.HasSuffix(lowerPath, ".jsonl") || strings.HasSuffix(lowerPath, ".ndjson")
+}
+
+// parseCsvContacts reads a CSV file where the first row holds the column names
+func parseCsvContacts(reader io.Reader) ([]contactRow, error) {
+	csvReader, header, err := files.NewCsvReader(reader)
+	if err != nil || header == nil {
+		return nil, err
+	}
+
+	var rows []contactRow
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV row: %v", err)
+		}
+		row := make(contactRow, len(header))
+		for i, column := range header {
+			row[column] = record[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseJsonlContacts reads a file with one JSON object per line. Values that are not strings are converted to their JSON text.
+func parseJsonlContacts(reader io.Reader) ([]contactRow, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	var rows []contactRow
+	for line := 1; ; line++ {
+		var object map[string]any
+		if err := decoder.Decode(&object); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read JSONL object %d: %v", line, err)
+		}
+
+		row := make(contactRow, len(object))
+		for column, value := range object {
+			switch v := value.(type) {
+			case nil:
+				row[column] = ""
+			case string:
+				row[column] = v
+			case json.Number, bool:
+				row[column] = fmt.Sprintf("%v", v)
+			default:
+				return nil, fmt.Errorf("column '%s' of JSONL object %d must be a string, number or boolean", column, line)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// diffContacts compares the rows of a contacts file with the live contacts of a contact list by the key column.
+// Rows without a matching contact are added, rows whose data differs update the contact and contacts without a
+// matching row are deleted.
+func diffContacts(rows []contactRow, contacts []platformclientv2.Dialercontact, keyColumn string) (*contactDiff, error) {
+	diff := &contactDiff{}
+
+	rowKeys := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		key := row[keyColumn]
+		if key == "" {
+			return nil, fmt.Errorf("row %d has no value for key column '%s'", i+1, keyColumn)
+		}
+		if rowKeys[key] {
+			return nil, fmt.Errorf("key '%s' appears more than once in column '%s'", key, keyColumn)
+		}
+		rowKeys[key] = true
+	}
+
+	liveContacts := make(map[string]platformclientv2.Dialercontact, len(contacts))
+	for _, contact := range contacts {
+		if contact.Id == nil {
+			continue
+		}
+		var key string
+		if contact.Data != nil {
+			key = (*contact.Data)[keyColumn]
+		}
+		// Contacts that aren't in the file, and duplicates of a key, are removed
+		if _, duplicate := liveContacts[key]; duplicate || !rowKeys[key] {
+			diff.deletes = append(diff.deletes, *contact.Id)
+			continue
+		}
+		liveContacts[key] = contact
+	}
+
+	for _, row := range rows {
+		contact, ok := liveContacts[row[keyColumn]]
+		if !ok {
+			diff.adds = append(diff.adds, row)
+			continue
+		}
+		var data map[string]string
+		if contact.Data != nil {
+			data = *contact.Data
+		}
+		if !contactDataEqual(row, data) {
+			diff.updates = append(diff.updates, contactUpdate{contactId: *contact.Id, row: row})
+		}
+	}
+
+	return diff, nil
+}
+
+// contactDataEqual treats missing columns as empty since the API returns every column of the contact list
+func contactDataEqual(row contactRow, data map[string]string) bool {
+	for column, value := range row {
+		if data[column] != value {
+			return false
+		}
+	}
+	for column, value := range data {
+		if _, ok := row[column]; !ok && value != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func buildWritableContact(contactListId string, contactId string, row contactRow) platformclientv2.Writabledialercontact {
+	data := map[string]string(row)
+	contact := platformclientv2.Writabledialercontact{
+		ContactListId: &contactListId,
+		Data:          &data,
+	}
+	if contactId != "" {
+		contact.Id = &contactId
+	}
+	return contact
+}
+
+// defaultKeyColumn returns the first column of a contact list
+func defaultKeyColumn(contactList *platformclientv2.Contactlist) string {
+	if contactList == nil || contactList.ColumnNames == nil || len(*contactList.ColumnNames) == 0 {
+		return ""
+	}
+	return (*contactList.ColumnNames)[0]
+}
+
+// writeContactsCsv writes contacts as CSV using the column order of the contact list. Columns that are only found on
+// the contacts are appended in alphabetical order.
+func writeContactsCsv(columnNames []string, contacts []platformclientv2.Dialercontact) ([]byte, error) {
+	header := append([]string{}, columnNames...)
+	knownColumns := make(map[string]bool, len(header))
+	for _, column := range header {
+		knownColumns[column] = true
+	}
+	var extraColumns []string
+	for _, contact := range contacts {
+		if contact.Data == nil {
+			continue
+		}
+		for column := range *contact.Data {
+			if !knownColumns[column] {
+				knownColumns[column] = true
+				extraColumns = append(extraColumns, column)
+			}
+		}
+	}
+	sort.Strings(extraColumns)
+	header = append(header, extraColumns...)
+
+	var buf bytes.Buffer
+	csvWriter := csv.NewWriter(&buf)
+	if err := csvWriter.Write(header); err != nil {
+		return nil, err
+	}
+	for _, contact := range contacts {
+		record := make([]string, len(header))
+		if contact.Data != nil {
+			for i, column := range header {
+				record[i] = (*contact.Data)[column]
+			}
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	csvWriter.Flush()
+	return buf.Bytes(), csvWriter.Error()
+}
@@ -115,7 +115,7 @@ func deleteOutboundContactlistfilter(ctx context.Context, d *schema.ResourceData
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getOutboundContactlistfilterProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting Outbound Contact List Filter")
 		resp, err := proxy.deleteOutboundContactlistfilter(ctx, d.Id())
 		if err != nil {
@@ -133,7 +133,7 @@ func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 		sdkDncList.DncSourceType = &dncSourceType
 	}
 	log.Printf("Updating Outbound DNC list %s", name)
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current Outbound DNC list version
 		outboundDncList, resp, getErr := proxy.getOutboundDnclistById(ctx, d.Id())
 		if getErr != nil {
@@ -221,7 +221,7 @@ func deleteOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getOutboundDnclistProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting Outbound DNC list")
 		resp, err := proxy.deleteOutboundDnclist(ctx, d.Id())
 		if err != nil {
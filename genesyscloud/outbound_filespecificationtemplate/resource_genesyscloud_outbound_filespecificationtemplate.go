@@ -105,7 +105,7 @@ func deleteOutboundFileSpecificationTemplate(ctx context.Context, d *schema.Reso
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getOutboundFilespecificationtemplateProxy(sdkConfig)
 
-	diagErr := util.RetryWhen(util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting Outbound File Specification Template")
 		resp, err := proxy.deleteOutboundFilespecificationtemplate(ctx, d.Id())
 		if err != nil {
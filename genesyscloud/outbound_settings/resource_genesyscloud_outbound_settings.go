@@ -74,20 +74,21 @@ func readOutboundSettings(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		// Only read values if they are part of the terraform plan or during Export
-		if maxCallsPerAgent != 0 || tfexporter_state.IsExporterActive() {
+		isExport := tfexporter_state.IsExporterActiveContext(ctx)
+		if maxCallsPerAgent != 0 || isExport {
 			resourcedata.SetNillableValue(d, "max_calls_per_agent", settings.MaxCallsPerAgent)
 		}
-		if maxLineUtilization != 0 || tfexporter_state.IsExporterActive() {
+		if maxLineUtilization != 0 || isExport {
 			resourcedata.SetNillableValue(d, "max_line_utilization", settings.MaxLineUtilization)
 		}
-		if abandonSeconds != 0 || tfexporter_state.IsExporterActive() {
+		if abandonSeconds != 0 || isExport {
 			resourcedata.SetNillableValue(d, "abandon_seconds", settings.AbandonSeconds)
 		}
-		if complianceAbandonRateDenominator != "" || tfexporter_state.IsExporterActive() {
+		if complianceAbandonRateDenominator != "" || isExport {
 			resourcedata.SetNillableValue(d, "compliance_abandon_rate_denominator", settings.ComplianceAbandonRateDenominator)
 		}
-		if settings.AutomaticTimeZoneMapping != nil && (len(automaticTimeZoneMapping) > 0 || tfexporter_state.IsExporterActive()) {
-			_ = d.Set("automatic_time_zone_mapping", flattenOutboundSettingsAutomaticTimeZoneMapping(*settings.AutomaticTimeZoneMapping, automaticTimeZoneMapping))
+		if settings.AutomaticTimeZoneMapping != nil && (len(automaticTimeZoneMapping) > 0 || isExport) {
+			_ = d.Set("automatic_time_zone_mapping", flattenOutboundSettingsAutomaticTimeZoneMapping(*settings.AutomaticTimeZoneMapping, automaticTimeZoneMapping, isExport))
 		}
 		resourcedata.SetNillableValue(d, "reschedule_time_zone_skipped_contacts", &rescheduleTimeZoneSkippedContacts)
 
@@ -109,7 +110,7 @@ func updateOutboundSettings(ctx context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("Updating Outbound Settings %s", d.Id())
 
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current Outbound settings version
 		setting, resp, getErr := proxy.getOutboundSettings(ctx)
 		if getErr != nil {
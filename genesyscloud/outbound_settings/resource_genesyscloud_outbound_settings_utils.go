@@ -1,7 +1,6 @@
 package outbound_settings
 
 import (
-	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
@@ -100,18 +99,18 @@ func buildCallableWindowsUnmapped(unmappedWindows *schema.Set) *platformclientv2
 	return &platformclientv2.Atzmtimeslotwithtimezone{}
 }
 
-func flattenOutboundSettingsAutomaticTimeZoneMapping(timeZoneMappings platformclientv2.Automatictimezonemappingsettings, automaticTimeZoneMapping []interface{}) []interface{} {
+func flattenOutboundSettingsAutomaticTimeZoneMapping(timeZoneMappings platformclientv2.Automatictimezonemappingsettings, automaticTimeZoneMapping []interface{}, isExport bool) []interface{} {
 	requestMap := make(map[string]interface{})
 
-	if tfexporter_state.IsExporterActive() {
+	if isExport {
 		if timeZoneMappings.CallableWindows != nil {
-			requestMap["callable_windows"] = flattenCallableWindows(*timeZoneMappings.CallableWindows, nil)
+			requestMap["callable_windows"] = flattenCallableWindows(*timeZoneMappings.CallableWindows, nil, isExport)
 		}
 	} else {
 		if len(automaticTimeZoneMapping) > 0 {
 			if callableWindows, ok := automaticTimeZoneMapping[0].(map[string]interface{})["callable_windows"].(*schema.Set); ok {
 				if timeZoneMappings.CallableWindows != nil {
-					requestMap["callable_windows"] = flattenCallableWindows(*timeZoneMappings.CallableWindows, callableWindows)
+					requestMap["callable_windows"] = flattenCallableWindows(*timeZoneMappings.CallableWindows, callableWindows, isExport)
 				}
 			}
 		}
@@ -121,7 +120,7 @@ func flattenOutboundSettingsAutomaticTimeZoneMapping(timeZoneMappings platformcl
 	return []interface{}{requestMap}
 }
 
-func flattenCallableWindows(windows []platformclientv2.Callablewindow, windowsSchema *schema.Set) *schema.Set {
+func flattenCallableWindows(windows []platformclientv2.Callablewindow, windowsSchema *schema.Set, isExport bool) *schema.Set {
 	if len(windows) == 0 {
 		return nil
 	}
@@ -129,13 +128,13 @@ func flattenCallableWindows(windows []platformclientv2.Callablewindow, windowsSc
 	callableWindowMap := make(map[string]interface{})
 	callableWindowsSet := schema.NewSet(schema.HashResource(callableWindowsResource), []interface{}{})
 
-	if tfexporter_state.IsExporterActive() {
+	if isExport {
 		for _, callableWindow := range windows {
 			if callableWindow.Mapped != nil {
-				callableWindowMap["mapped"] = flattenOutboundSettingsMapped(callableWindow.Mapped, nil)
+				callableWindowMap["mapped"] = flattenOutboundSettingsMapped(callableWindow.Mapped, nil, isExport)
 			}
 			if callableWindow.Unmapped != nil {
-				callableWindowMap["unmapped"] = flattenOutboundSettingsUnmapped(callableWindow.Unmapped, nil)
+				callableWindowMap["unmapped"] = flattenOutboundSettingsUnmapped(callableWindow.Unmapped, nil, isExport)
 			}
 		}
 	} else {
@@ -149,10 +148,10 @@ func flattenCallableWindows(windows []platformclientv2.Callablewindow, windowsSc
 
 		for _, callableWindow := range windows {
 			if callableWindow.Mapped != nil {
-				callableWindowMap["mapped"] = flattenOutboundSettingsMapped(callableWindow.Mapped, mappedSchema)
+				callableWindowMap["mapped"] = flattenOutboundSettingsMapped(callableWindow.Mapped, mappedSchema, isExport)
 			}
 			if callableWindow.Unmapped != nil {
-				callableWindowMap["unmapped"] = flattenOutboundSettingsUnmapped(callableWindow.Unmapped, unmappedSchema)
+				callableWindowMap["unmapped"] = flattenOutboundSettingsUnmapped(callableWindow.Unmapped, unmappedSchema, isExport)
 			}
 		}
 	}
@@ -161,11 +160,11 @@ func flattenCallableWindows(windows []platformclientv2.Callablewindow, windowsSc
 	return callableWindowsSet
 }
 
-func flattenOutboundSettingsMapped(mapped *platformclientv2.Atzmtimeslot, mappedSchema *schema.Set) *schema.Set {
+func flattenOutboundSettingsMapped(mapped *platformclientv2.Atzmtimeslot, mappedSchema *schema.Set, isExport bool) *schema.Set {
 	requestSet := schema.NewSet(schema.HashResource(mappedResource), []interface{}{})
 	requestMap := make(map[string]interface{})
 
-	if tfexporter_state.IsExporterActive() {
+	if isExport {
 		resourcedata.SetMapValueIfNotNil(requestMap, "earliest_callable_time", mapped.EarliestCallableTime)
 		resourcedata.SetMapValueIfNotNil(requestMap, "latest_callable_time", mapped.LatestCallableTime)
 	} else {
@@ -185,11 +184,11 @@ func flattenOutboundSettingsMapped(mapped *platformclientv2.Atzmtimeslot, mapped
 	return requestSet
 }
 
-func flattenOutboundSettingsUnmapped(unmapped *platformclientv2.Atzmtimeslotwithtimezone, unmappedSchema *schema.Set) *schema.Set {
+func flattenOutboundSettingsUnmapped(unmapped *platformclientv2.Atzmtimeslotwithtimezone, unmappedSchema *schema.Set, isExport bool) *schema.Set {
 	requestSet := schema.NewSet(schema.HashResource(UnmappedResource), []interface{}{})
 	requestMap := make(map[string]interface{})
 
-	if tfexporter_state.IsExporterActive() {
+	if isExport {
 		resourcedata.SetMapValueIfNotNil(requestMap, "earliest_callable_time", unmapped.EarliestCallableTime)
 		resourcedata.SetMapValueIfNotNil(requestMap, "latest_callable_time", unmapped.LatestCallableTime)
 		resourcedata.SetMapValueIfNotNil(requestMap, "time_zone_id", unmapped.TimeZoneId)
@@ -0,0 +1,255 @@
+package persistent_cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+The persistent cache stores the JSON of objects read from the Genesys Cloud API in a snapshot directory on disk so that
+they can be reused by later provider runs. Entries are stored in <directory>/<org id>/<namespace>/<key>.json and expire
+after the configured TTL. The provider configures it from the resource_cache block of the provider config and the
+resource_cache package uses it as a second level behind its in-memory caches.
+*/
+
+type cacheConfig struct {
+	dir string
+	ttl time.Duration
+}
+
+type cacheEntry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Value    json.RawMessage `json:"value"`
+}
+
+var (
+	config     *cacheConfig
+	configLock sync.RWMutex
+
+	// Resource IDs and namespaces that have been invalidated in this run
+	invalidatedIds        sync.Map
+	invalidatedNamespaces sync.Map
+
+	// Namespaces whose values include other resources, by the type of those resources
+	dependentNamespaces     = make(map[string][]string)
+	dependentNamespacesLock sync.RWMutex
+)
+
+// Cache keys of objects that belong to another object join the IDs with one of these, e.g. <contact list id>:<contact id>
+const keySeparators = ":/|"
+
+// Only owner access is given to the cache as it holds the configuration of the org
+const cacheDirPerm = 0o700
+
+// Enable turns on the persistent cache for an org. Entries older than ttl are ignored.
+func Enable(directory string, orgId string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("resource cache TTL must be greater than zero")
+	}
+	dir := filepath.Join(directory, orgId)
+	if err := os.MkdirAll(dir, cacheDirPerm); err != nil {
+		return fmt.Errorf("failed to create resource cache directory %s: %v", dir, err)
+	}
+
+	configLock.Lock()
+	defer configLock.Unlock()
+	config = &cacheConfig{dir: dir, ttl: ttl}
+	log.Printf("Persistent resource cache enabled in %s with a TTL of %s", dir, ttl)
+	return nil
+}
+
+// Disable turns off the persistent cache
+func Disable() {
+	configLock.Lock()
+	defer configLock.Unlock()
+	config = nil
+}
+
+func IsEnabled() bool {
+	return getConfig() != nil
+}
+
+func getConfig() *cacheConfig {
+	configLock.RLock()
+	defer configLock.RUnlock()
+	return config
+}
+
+// Load returns the JSON stored for a key if it has not expired or been invalidated
+func Load(namespace string, key string) ([]byte, bool) {
+	c := getConfig()
+	if c == nil || IsInvalidated(namespace, key) {
+		return nil, false
+	}
+
+	path := c.entryPath(namespace, key)
+	entry, err := readEntry(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Failed to read resource cache entry %s: %v", path, err)
+		}
+		return nil, false
+	}
+	if time.Since(entry.StoredAt) > c.ttl {
+		_ = os.Remove(path)
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// LoadAll returns the JSON of every entry in a namespace that has not expired or been invalidated
+func LoadAll(namespace string) [][]byte {
+	c := getConfig()
+	if c == nil {
+		return nil
+	}
+
+	paths, _ := filepath.Glob(filepath.Join(c.dir, escape(namespace), "*.json"))
+	var values [][]byte
+	for _, path := range paths {
+		key, _ := url.PathUnescape(strings.TrimSuffix(filepath.Base(path), ".json"))
+		if value, ok := Load(namespace, key); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Store writes the JSON of a key. The file is written to a temp file first so concurrent readers never see a partial entry.
+func Store(namespace string, key string, value []byte) error {
+	c := getConfig()
+	if c == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(cacheEntry{StoredAt: time.Now(), Value: value})
+	if err != nil {
+		return err
+	}
+
+	path := c.entryPath(namespace, key)
+	if err := os.MkdirAll(filepath.Dir(path), cacheDirPerm); err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+// Remove deletes the entry of a key
+func Remove(namespace string, key string) {
+	if c := getConfig(); c != nil {
+		_ = os.Remove(c.entryPath(namespace, key))
+	}
+}
+
+// AddDependentNamespace makes changes to resources of a type invalidate every entry of a namespace. It is used for objects
+// whose cached value includes other resources, e.g. users include their skills.
+func AddDependentNamespace(resourceType string, namespace string) {
+	dependentNamespacesLock.Lock()
+	defer dependentNamespacesLock.Unlock()
+	for _, existing := range dependentNamespaces[resourceType] {
+		if existing == namespace {
+			return
+		}
+	}
+	dependentNamespaces[resourceType] = append(dependentNamespaces[resourceType], namespace)
+}
+
+// InvalidateResource removes the entries of a resource and of every namespace that depends on its type, and stops them
+// being served from any cache for the rest of the run. Entries are matched when their key is the resource ID or contains
+// a part of it, as the keys of objects that belong to another object include the ID of both.
+// It is called before a resource is created, updated or deleted so that the following reads go to the API.
+func InvalidateResource(resourceType string, id string) {
+	ids := keyParts(id)
+	for _, part := range ids {
+		invalidatedIds.Store(part, true)
+	}
+
+	dependentNamespacesLock.RLock()
+	namespaces := append([]string(nil), dependentNamespaces[resourceType]...)
+	dependentNamespacesLock.RUnlock()
+	for _, namespace := range namespaces {
+		invalidatedNamespaces.Store(namespace, true)
+	}
+
+	c := getConfig()
+	if c == nil {
+		return
+	}
+	for _, namespace := range namespaces {
+		_ = os.RemoveAll(filepath.Join(c.dir, escape(namespace)))
+	}
+	if len(ids) == 0 {
+		return
+	}
+	paths, _ := filepath.Glob(filepath.Join(c.dir, "*", "*.json"))
+	for _, path := range paths {
+		key, err := url.PathUnescape(strings.TrimSuffix(filepath.Base(path), ".json"))
+		if err == nil && isInvalidatedKey(key) {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+// IsInvalidated returns true if the key or its namespace has been invalidated in this run
+func IsInvalidated(namespace string, key string) bool {
+	if _, ok := invalidatedNamespaces.Load(namespace); ok {
+		return true
+	}
+	return isInvalidatedKey(key)
+}
+
+func isInvalidatedKey(key string) bool {
+	for _, part := range keyParts(key) {
+		if _, ok := invalidatedIds.Load(part); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func keyParts(key string) []string {
+	return strings.FieldsFunc(key, func(r rune) bool {
+		return strings.ContainsRune(keySeparators, r)
+	})
+}
+
+func (c *cacheConfig) entryPath(namespace string, key string) string {
+	return filepath.Join(c.dir, escape(namespace), escape(key)+".json")
+}
+
+func readEntry(path string) (*cacheEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// escape makes a key or namespace safe to use as a file name
+func escape(s string) string {
+	return url.PathEscape(s)
+}
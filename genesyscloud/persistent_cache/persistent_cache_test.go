@@ -0,0 +1,104 @@
+package persistent_cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUnitPersistentCacheStoreAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	if err := Enable(dir, "org-id", time.Hour); err != nil {
+		t.Fatalf("Failed to enable the persistent cache: %v", err)
+	}
+	defer Disable()
+
+	if err := Store("platformclientv2.Queue", "queue/1", []byte(`{"name":"Sales"}`)); err != nil {
+		t.Fatalf("Failed to store entry: %v", err)
+	}
+	value, ok := Load("platformclientv2.Queue", "queue/1")
+	if !ok || string(value) != `{"name":"Sales"}` {
+		t.Errorf("Expected the stored entry, got %s", value)
+	}
+	if values := LoadAll("platformclientv2.Queue"); len(values) != 1 {
+		t.Errorf("Expected 1 entry in the namespace, got %d", len(values))
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "org-id", "platformclientv2.Queue"))
+	if err != nil {
+		t.Fatalf("Failed to stat namespace directory: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != cacheDirPerm {
+		t.Errorf("Expected namespace directory permissions %o, got %o", cacheDirPerm, perm)
+	}
+
+	Remove("platformclientv2.Queue", "queue/1")
+	if _, ok := Load("platformclientv2.Queue", "queue/1"); ok {
+		t.Error("Expected the removed entry to not be loaded")
+	}
+}
+
+func TestUnitPersistentCacheExpiry(t *testing.T) {
+	if err := Enable(t.TempDir(), "org-id", time.Millisecond); err != nil {
+		t.Fatalf("Failed to enable the persistent cache: %v", err)
+	}
+	defer Disable()
+
+	if err := Store("platformclientv2.Queue", "expired", []byte(`{}`)); err != nil {
+		t.Fatalf("Failed to store entry: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := Load("platformclientv2.Queue", "expired"); ok {
+		t.Error("Expected the expired entry to not be loaded")
+	}
+}
+
+func TestUnitPersistentCacheInvalidateResource(t *testing.T) {
+	if err := Enable(t.TempDir(), "org-id", time.Hour); err != nil {
+		t.Fatalf("Failed to enable the persistent cache: %v", err)
+	}
+	defer Disable()
+
+	const (
+		contactListId = "5b9e8e8a-6c3c-4b7e-9d47-1f0a7c8e2a01"
+		otherListId   = "5b9e8e8a-6c3c-4b7e-9d47-1f0a7c8e2a02"
+	)
+	entries := map[string][]string{
+		"platformclientv2.Dialercontact": {contactListId + ":contact-1", otherListId + ":contact-2"},
+		"platformclientv2.Contactlist":   {contactListId},
+		"platformclientv2.User":          {"user-1"},
+	}
+	for namespace, keys := range entries {
+		for _, key := range keys {
+			if err := Store(namespace, key, []byte(`{}`)); err != nil {
+				t.Fatalf("Failed to store entry: %v", err)
+			}
+		}
+	}
+	AddDependentNamespace("genesyscloud_routing_skill_invalidate_test", "platformclientv2.User")
+
+	// Keys that contain the ID of the changed resource are invalidated, others are kept
+	InvalidateResource("genesyscloud_outbound_contact_list", contactListId)
+	if _, ok := Load("platformclientv2.Dialercontact", contactListId+":contact-1"); ok {
+		t.Error("Expected the contact of the changed contact list to be invalidated")
+	}
+	if _, ok := Load("platformclientv2.Contactlist", contactListId); ok {
+		t.Error("Expected the changed contact list to be invalidated")
+	}
+	if _, ok := Load("platformclientv2.Dialercontact", otherListId+":contact-2"); !ok {
+		t.Error("Expected the contact of another contact list to be kept")
+	}
+	if _, ok := Load("platformclientv2.User", "user-1"); !ok {
+		t.Error("Expected the user to be kept")
+	}
+
+	// Changes to a type invalidate the namespaces that depend on it
+	InvalidateResource("genesyscloud_routing_skill_invalidate_test", "")
+	if _, ok := Load("platformclientv2.User", "user-1"); ok {
+		t.Error("Expected the user to be invalidated by the change to a dependent type")
+	}
+	if !IsInvalidated("platformclientv2.User", "user-2") {
+		t.Error("Expected every key of the dependent namespace to be invalidated")
+	}
+}
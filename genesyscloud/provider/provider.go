@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/constants"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -50,12 +51,12 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 		*/
 		copiedResources := make(map[string]*schema.Resource)
 		for k, v := range providerResources {
-			copiedResources[k] = v
+			copiedResources[k] = withResourceTypeLabel(k, v)
 		}
 
 		copiedDataSources := make(map[string]*schema.Resource)
 		for k, v := range providerDataSources {
-			copiedDataSources[k] = v
+			copiedDataSources[k] = withResourceTypeLabel(k, v)
 		}
 
 		return &schema.Provider{
@@ -228,6 +229,61 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 						},
 					},
 				},
+				"metrics": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Records the API path, status code, latency, retry count and resource operation of every API call made by the provider.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file_path": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_METRICS_FILE_PATH", "genesyscloud_metrics.txt"),
+								Description:  "Specifies the file path for the metrics. Can be set with the `GENESYSCLOUD_METRICS_FILE_PATH` environment variable. Default value is genesyscloud_metrics.txt",
+								ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile("^(|\\s+)$"), "Invalid File path "),
+							},
+							"format": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_METRICS_FORMAT", metricsFormatPrometheus),
+								Description:  fmt.Sprintf("Specifies the data format of the metrics file. `%s` writes the totals in the Prometheus text format and `%s` appends one JSON line per call. The file is written every 10 seconds and when the provider exits. Can be set with the `GENESYSCLOUD_METRICS_FORMAT` environment variable. Default value is %s.", metricsFormatPrometheus, metricsFormatJsonl, metricsFormatPrometheus),
+								ValidateFunc: validation.StringInSlice([]string{metricsFormatPrometheus, metricsFormatJsonl}, false),
+							},
+						},
+					},
+				},
+				"resource_cache": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configures where objects read from the API are cached. With the `disk` backend, objects are cached in a snapshot directory and reused by later plans, refreshes and exports until the TTL expires. Objects are removed from the cache when the provider creates, updates or deletes them or resources they include.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"backend": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_RESOURCE_CACHE_BACKEND", resourceCacheBackendMemory),
+								Description:  fmt.Sprintf("Cache backend. Valid values: %s, %s. The `memory` backend only caches objects during an export. Can be set with the `GENESYSCLOUD_RESOURCE_CACHE_BACKEND` environment variable.", resourceCacheBackendMemory, resourceCacheBackendDisk),
+								ValidateFunc: validation.StringInSlice([]string{resourceCacheBackendMemory, resourceCacheBackendDisk}, false),
+							},
+							"directory": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_RESOURCE_CACHE_DIRECTORY", ".genesyscloud_cache"),
+								Description:  "Directory of the `disk` backend. Can be set with the `GENESYSCLOUD_RESOURCE_CACHE_DIRECTORY` environment variable. Default value is .genesyscloud_cache",
+								ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile("^(|\\s+)$"), "Invalid directory "),
+							},
+							"ttl": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								DefaultFunc:      schema.EnvDefaultFunc("GENESYSCLOUD_RESOURCE_CACHE_TTL", "1h"),
+								Description:      "How long objects in the `disk` backend are reused, e.g. `30m` or `12h`. Can be set with the `GENESYSCLOUD_RESOURCE_CACHE_TTL` environment variable. Default value is 1h",
+								ValidateDiagFunc: validateDuration,
+							},
+						},
+					},
+				},
 			},
 			ResourcesMap:         copiedResources,
 			DataSourcesMap:       copiedDataSources,
@@ -236,6 +292,33 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 	}
 }
 
+// withResourceTypeLabel returns a copy of a resource or data source whose operations label their API calls with the
+// registered type in the provider metrics
+func withResourceTypeLabel(resourceType string, resource *schema.Resource) *schema.Resource {
+	if resource == nil {
+		return nil
+	}
+	labeled := *resource
+	labeled.CreateContext = labelOperation(resourceType, resource.CreateContext)
+	labeled.ReadContext = labelOperation(resourceType, resource.ReadContext)
+	labeled.UpdateContext = labelOperation(resourceType, resource.UpdateContext)
+	labeled.DeleteContext = labelOperation(resourceType, resource.DeleteContext)
+	labeled.CreateWithoutTimeout = labelOperation(resourceType, resource.CreateWithoutTimeout)
+	labeled.ReadWithoutTimeout = labelOperation(resourceType, resource.ReadWithoutTimeout)
+	labeled.UpdateWithoutTimeout = labelOperation(resourceType, resource.UpdateWithoutTimeout)
+	labeled.DeleteWithoutTimeout = labelOperation(resourceType, resource.DeleteWithoutTimeout)
+	return &labeled
+}
+
+func labelOperation[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](resourceType string, method F) F {
+	if method == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return method(WithResourceType(ctx, resourceType), d, meta)
+	}
+}
+
 type ProviderMeta struct {
 	Version      string
 	ClientConfig *platformclientv2.Configuration
@@ -245,6 +328,10 @@ type ProviderMeta struct {
 
 func configure(version string) schema.ConfigureContextFunc {
 	return func(context context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if err := setupMetrics(data); err != nil {
+			return nil, err
+		}
+
 		err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
 		if err != nil {
 			return nil, err
@@ -258,6 +345,10 @@ func configure(version string) schema.ConfigureContextFunc {
 		}
 		orgDefaultCountryCode = *currentOrg.DefaultCountryCode
 
+		if err := setupResourceCache(data, *currentOrg.Id); err != nil {
+			return nil, err
+		}
+
 		return &ProviderMeta{
 			Version:      version,
 			ClientConfig: defaultConfig,
@@ -311,7 +402,12 @@ func getRegionDomain(region string) string {
 	return getRegionMap()[strings.ToLower(region)]
 }
 
+// GetRegionBasePath returns the API base path for a region. The GENESYSCLOUD_API_BASE_PATH environment variable
+// overrides it, e.g. to run tests against the mock API server in util/mockapi.
 func GetRegionBasePath(region string) string {
+	if basePath := os.Getenv(constants.BasePathEnvVar); basePath != "" {
+		return basePath
+	}
 	return "https://api." + getRegionDomain(region)
 }
 
@@ -336,8 +432,11 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 		RetryWaitMax: time.Second * 30,
 		RetryMax:     20,
 		RequestLogHook: func(request *http.Request, count int) {
+			waitForRateLimit(request)
+
 			sdkDebugRequest := newSDKDebugRequest(request, count)
 			request.Header.Set("TF-Correlation-Id", sdkDebugRequest.TransactionId)
+			recordMetricsRequest(request, count)
 			err, jsonStr := sdkDebugRequest.ToJSON()
 
 			if err != nil {
@@ -346,6 +445,9 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 			log.Printf(jsonStr)
 		},
 		ResponseLogHook: func(response *http.Response) {
+			recordRateLimit(response)
+			recordMetricsResponse(config, response)
+
 			sdkDebugResponse := newSDKDebugResponse(response)
 			err, jsonStr := sdkDebugResponse.ToJSON()
 
@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+Provider metrics record every API call made through the SDK with the API path, status code, latency, retry count and the
+resource operation that made it. The resource type is the registered type of the resource or data source being run, and
+the operation is taken from the CRUD or getAll function that acquired the SDK client from the pool, e.g. readQueue.
+Metrics are kept in memory and written to a local file on an interval and when the provider shuts down, either as one
+JSON line per call or as Prometheus text.
+*/
+
+const (
+	metricsFormatPrometheus = "prometheus"
+	metricsFormatJsonl      = "jsonl"
+
+	metricsFlushInterval = 10 * time.Second
+	// Requests still waiting for a response after this long failed or timed out
+	pendingRequestTimeout = 10 * time.Minute
+)
+
+var idPathSegmentRegex = regexp.MustCompile(`^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|\+?[0-9]+)$`)
+
+type apiCallMetric struct {
+	Timestamp      time.Time `json:"timestamp"`
+	Method         string    `json:"method"`
+	Path           string    `json:"path"`
+	StatusCode     int       `json:"status_code"`
+	LatencySeconds float64   `json:"latency_seconds"`
+	RetryCount     int       `json:"retry_count"`
+	ResourceType   string    `json:"resource_type,omitempty"`
+	Operation      string    `json:"operation,omitempty"`
+}
+
+type apiCallSeries struct {
+	requests       int
+	retries        int
+	latencySeconds float64
+}
+
+type metricsRecorder struct {
+	mutex    sync.Mutex
+	filePath string
+	format   string
+	series   map[apiCallMetric]*apiCallSeries
+	changed  bool
+
+	jsonlFile   *os.File
+	jsonlWriter *bufio.Writer
+
+	// Serializes the writes of the Prometheus file, which happen outside of mutex
+	fileMutex sync.Mutex
+
+	stop chan struct{}
+	done chan struct{}
+
+	// Start time and retry count of requests waiting for a response, by correlation id
+	pendingRequests sync.Map
+}
+
+type pendingRequest struct {
+	start      time.Time
+	retryCount int
+}
+
+type clientOperation struct {
+	resourceType string
+	operation    string
+}
+
+type resourceTypeContextKey struct{}
+
+var (
+	metrics     *metricsRecorder
+	metricsLock sync.RWMutex
+
+	// Operation being run by each pooled client config while it is acquired
+	clientOperations sync.Map
+)
+
+func setupMetrics(data *schema.ResourceData) diag.Diagnostics {
+	metricsLock.Lock()
+	defer metricsLock.Unlock()
+	if metrics != nil {
+		metrics.close()
+		metrics = nil
+	}
+
+	for _, metricsObj := range data.Get("metrics").(*schema.Set).List() {
+		metricsConfig := metricsObj.(map[string]interface{})
+		filePath := metricsConfig["file_path"].(string)
+
+		dir, _ := filepath.Split(filePath)
+		if dir != "" {
+			if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+				return diag.Errorf("error while creating filepath for %s: %s", filePath, err)
+			}
+		}
+
+		recorder, err := newMetricsRecorder(filePath, metricsConfig["format"].(string))
+		if err != nil {
+			return diag.Errorf("error while opening metrics file %s: %s", filePath, err)
+		}
+		metrics = recorder
+		go metrics.run(metricsFlushInterval)
+		log.Printf("Writing %s API call metrics to %s", metrics.format, filePath)
+	}
+	return nil
+}
+
+// CloseMetrics writes the metrics that have not been written yet. It is called when the provider shuts down.
+func CloseMetrics() {
+	metricsLock.Lock()
+	defer metricsLock.Unlock()
+	if metrics != nil {
+		metrics.close()
+		metrics = nil
+	}
+}
+
+func newMetricsRecorder(filePath string, format string) (*metricsRecorder, error) {
+	m := &metricsRecorder{
+		filePath: filePath,
+		format:   format,
+		series:   make(map[apiCallMetric]*apiCallSeries),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if format == metricsFormatJsonl {
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		m.jsonlFile = file
+		m.jsonlWriter = bufio.NewWriterSize(file, 64*1024)
+	}
+	return m, nil
+}
+
+// run writes the metrics on every interval until the recorder is closed
+func (m *metricsRecorder) run(interval time.Duration) {
+	defer close(m.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			m.removeStaleRequests(time.Now().Add(-pendingRequestTimeout))
+			m.flush()
+		}
+	}
+}
+
+func (m *metricsRecorder) close() {
+	if m.stop != nil {
+		close(m.stop)
+		<-m.done
+	}
+	m.flush()
+	if m.jsonlFile != nil {
+		if err := m.jsonlFile.Close(); err != nil {
+			log.Printf("WARNING: Unable to close API call metrics file %s: %s", m.filePath, err)
+		}
+	}
+}
+
+// removeStaleRequests forgets the requests that started before the given time and never got a response
+func (m *metricsRecorder) removeStaleRequests(startedBefore time.Time) {
+	m.pendingRequests.Range(func(key, value interface{}) bool {
+		if value.(pendingRequest).start.Before(startedBefore) {
+			m.pendingRequests.Delete(key)
+		}
+		return true
+	})
+}
+
+func getMetricsRecorder() *metricsRecorder {
+	metricsLock.RLock()
+	defer metricsLock.RUnlock()
+	return metrics
+}
+
+// WithResourceType labels the API calls made with the context with the registered type of a resource or data source
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, resourceType)
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	resourceType, _ := ctx.Value(resourceTypeContextKey{}).(string)
+	return resourceType
+}
+
+// setClientOperation labels the API calls made with a pooled client config with the resource type of the context and
+// the function that acquired it. kind names the operation when the function has no name of its own.
+func setClientOperation(ctx context.Context, config *platformclientv2.Configuration, method interface{}, kind string) {
+	if getMetricsRecorder() == nil {
+		return
+	}
+	clientOperations.Store(config, clientOperation{
+		resourceType: resourceTypeFromContext(ctx),
+		operation:    operationName(method, kind),
+	})
+}
+
+func clearClientOperation(config *platformclientv2.Configuration) {
+	clientOperations.Delete(config)
+}
+
+// operationName returns the name of a function without its package, e.g. readQueue for
+// terraform-provider-genesyscloud/genesyscloud/routing_queue.readQueue. Closures are named after the function that
+// declares them, or after kind when they are declared at package level.
+func operationName(method interface{}, kind string) string {
+	funcName := runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name()
+	_, name, _ := strings.Cut(funcName[strings.LastIndex(funcName, "/")+1:], ".")
+	name, _, _ = strings.Cut(name, ".func")
+	if name == "" || name == "init" || strings.HasPrefix(name, "glob.") {
+		return kind
+	}
+	return name
+}
+
+func resourceOperation(config *platformclientv2.Configuration) (resourceType string, operation string) {
+	operationObj, ok := clientOperations.Load(config)
+	if !ok {
+		return "", ""
+	}
+	op := operationObj.(clientOperation)
+	return op.resourceType, op.operation
+}
+
+func recordMetricsRequest(request *http.Request, count int) {
+	m := getMetricsRecorder()
+	if m == nil {
+		return
+	}
+	m.pendingRequests.Store(request.Header.Get("TF-Correlation-Id"), pendingRequest{start: time.Now(), retryCount: count})
+}
+
+func recordMetricsResponse(config *platformclientv2.Configuration, response *http.Response) {
+	m := getMetricsRecorder()
+	if m == nil || response.Request == nil {
+		return
+	}
+	pendingObj, ok := m.pendingRequests.LoadAndDelete(response.Request.Header.Get("TF-Correlation-Id"))
+	if !ok {
+		return
+	}
+	pending := pendingObj.(pendingRequest)
+
+	resourceType, operation := resourceOperation(config)
+	m.record(apiCallMetric{
+		Timestamp:      time.Now(),
+		Method:         response.Request.Method,
+		Path:           normalizeApiPath(response.Request.URL.Path),
+		StatusCode:     response.StatusCode,
+		LatencySeconds: time.Since(pending.start).Seconds(),
+		RetryCount:     pending.retryCount,
+		ResourceType:   resourceType,
+		Operation:      operation,
+	})
+}
+
+// record adds an API call to the metrics in memory. The metrics file is only written by flush.
+func (m *metricsRecorder) record(call apiCallMetric) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.format == metricsFormatJsonl {
+		line, err := json.Marshal(call)
+		if err == nil {
+			_, err = m.jsonlWriter.Write(append(line, '\n'))
+		}
+		if err != nil {
+			log.Printf("WARNING: Unable to write API call metrics to %s: %s", m.filePath, err)
+		}
+		return
+	}
+
+	key := call
+	key.Timestamp = time.Time{}
+	key.LatencySeconds = 0
+	key.RetryCount = 0
+	series, ok := m.series[key]
+	if !ok {
+		series = &apiCallSeries{}
+		m.series[key] = series
+	}
+	series.requests++
+	series.latencySeconds += call.LatencySeconds
+	if call.RetryCount > 0 {
+		series.retries++
+	}
+	m.changed = true
+}
+
+// flush writes the metrics recorded since the last flush to the metrics file
+func (m *metricsRecorder) flush() {
+	var err error
+	if m.format == metricsFormatJsonl {
+		m.mutex.Lock()
+		err = m.jsonlWriter.Flush()
+		m.mutex.Unlock()
+	} else {
+		m.mutex.Lock()
+		if !m.changed {
+			m.mutex.Unlock()
+			return
+		}
+		text := m.prometheusText()
+		m.changed = false
+		m.mutex.Unlock()
+
+		m.fileMutex.Lock()
+		err = m.writePrometheus(text)
+		m.fileMutex.Unlock()
+	}
+	if err != nil {
+		log.Printf("WARNING: Unable to write API call metrics to %s: %s", m.filePath, err)
+	}
+}
+
+func (m *metricsRecorder) prometheusText() string {
+	keys := make([]apiCallMetric, 0, len(m.series))
+	for key := range m.series {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return prometheusLabels(keys[i]) < prometheusLabels(keys[j])
+	})
+
+	var sb strings.Builder
+	sb.WriteString("# HELP genesyscloud_api_requests_total Number of Genesys Cloud API calls.\n")
+	sb.WriteString("# TYPE genesyscloud_api_requests_total counter\n")
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("genesyscloud_api_requests_total{%s} %d\n", prometheusLabels(key), m.series[key].requests))
+	}
+	sb.WriteString("# HELP genesyscloud_api_retries_total Number of Genesys Cloud API calls that were retries.\n")
+	sb.WriteString("# TYPE genesyscloud_api_retries_total counter\n")
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("genesyscloud_api_retries_total{%s} %d\n", prometheusLabels(key), m.series[key].retries))
+	}
+	sb.WriteString("# HELP genesyscloud_api_request_duration_seconds Latency of Genesys Cloud API calls.\n")
+	sb.WriteString("# TYPE genesyscloud_api_request_duration_seconds summary\n")
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("genesyscloud_api_request_duration_seconds_sum{%s} %g\n", prometheusLabels(key), m.series[key].latencySeconds))
+		sb.WriteString(fmt.Sprintf("genesyscloud_api_request_duration_seconds_count{%s} %d\n", prometheusLabels(key), m.series[key].requests))
+	}
+	return sb.String()
+}
+
+func (m *metricsRecorder) writePrometheus(text string) error {
+	tmpPath := m.filePath + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(text), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, m.filePath)
+}
+
+func prometheusLabels(key apiCallMetric) string {
+	return fmt.Sprintf(`method=%q,path=%q,status_code="%d",resource_type=%q,operation=%q`,
+		key.Method, key.Path, key.StatusCode, key.ResourceType, key.Operation)
+}
+
+// normalizeApiPath replaces the IDs in a path so that calls for different objects are grouped together
+func normalizeApiPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if idPathSegmentRegex.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
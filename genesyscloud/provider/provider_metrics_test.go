@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+func TestUnitNormalizeApiPath(t *testing.T) {
+	path := normalizeApiPath("/api/v2/routing/queues/3f1b2c9e-8a4d-4c1e-9b7a-0d6e5f4a3b21/members")
+	if path != "/api/v2/routing/queues/{id}/members" {
+		t.Errorf("Expected the queue id to be replaced, got %s", path)
+	}
+}
+
+func TestUnitMetricsPrometheus(t *testing.T) {
+	config := platformclientv2.NewConfiguration()
+	m, err := newMetricsRecorder(filepath.Join(t.TempDir(), "metrics.txt"), metricsFormatPrometheus)
+	if err != nil {
+		t.Fatalf("Failed to create metrics recorder: %v", err)
+	}
+	clientOperations.Store(config, clientOperation{resourceType: "genesyscloud_routing_queue", operation: "readQueue"})
+	defer clearClientOperation(config)
+
+	resourceType, operation := resourceOperation(config)
+	call := apiCallMetric{Method: "GET", Path: "/api/v2/routing/queues/{id}", StatusCode: 200, LatencySeconds: 0.5, ResourceType: resourceType, Operation: operation}
+	m.record(call)
+	call.RetryCount = 1
+	m.record(call)
+
+	// Nothing is written until the metrics are flushed
+	if _, err := os.Stat(m.filePath); !os.IsNotExist(err) {
+		t.Fatalf("Expected no metrics file before the flush, got %v", err)
+	}
+	m.flush()
+
+	data, err := os.ReadFile(m.filePath)
+	if err != nil {
+		t.Fatalf("Failed to read metrics file: %v", err)
+	}
+	labels := `method="GET",path="/api/v2/routing/queues/{id}",status_code="200",resource_type="genesyscloud_routing_queue",operation="readQueue"`
+	for _, expected := range []string{
+		"genesyscloud_api_requests_total{" + labels + "} 2",
+		"genesyscloud_api_retries_total{" + labels + "} 1",
+		"genesyscloud_api_request_duration_seconds_sum{" + labels + "} 1",
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected metrics to contain %s, got:\n%s", expected, data)
+		}
+	}
+}
+
+func TestUnitMetricsJsonl(t *testing.T) {
+	m, err := newMetricsRecorder(filepath.Join(t.TempDir(), "metrics.jsonl"), metricsFormatJsonl)
+	if err != nil {
+		t.Fatalf("Failed to create metrics recorder: %v", err)
+	}
+	go m.run(time.Hour)
+
+	m.record(apiCallMetric{Method: "GET", Path: "/api/v2/users/{id}", StatusCode: 200})
+	m.record(apiCallMetric{Method: "PUT", Path: "/api/v2/users/{id}", StatusCode: 429, RetryCount: 1})
+	m.close()
+
+	data, err := os.ReadFile(m.filePath)
+	if err != nil {
+		t.Fatalf("Failed to read metrics file: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 {
+		t.Errorf("Expected 2 metrics lines, got:\n%s", data)
+	}
+}
+
+func TestUnitMetricsRemoveStaleRequests(t *testing.T) {
+	m, err := newMetricsRecorder(filepath.Join(t.TempDir(), "metrics.txt"), metricsFormatPrometheus)
+	if err != nil {
+		t.Fatalf("Failed to create metrics recorder: %v", err)
+	}
+	m.pendingRequests.Store("timed-out", pendingRequest{start: time.Now().Add(-time.Hour)})
+	m.pendingRequests.Store("waiting", pendingRequest{start: time.Now()})
+
+	m.removeStaleRequests(time.Now().Add(-pendingRequestTimeout))
+	if _, ok := m.pendingRequests.Load("timed-out"); ok {
+		t.Error("Expected the request without a response to be removed")
+	}
+	if _, ok := m.pendingRequests.Load("waiting"); !ok {
+		t.Error("Expected the request waiting for a response to be kept")
+	}
+}
+
+var readTestQueue = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { return nil }
+
+func TestUnitMetricsResourceOperation(t *testing.T) {
+	metricsLock.Lock()
+	originalMetrics := metrics
+	metrics = &metricsRecorder{}
+	metricsLock.Unlock()
+	defer func() {
+		metricsLock.Lock()
+		metrics = originalMetrics
+		metricsLock.Unlock()
+	}()
+
+	config := platformclientv2.NewConfiguration()
+	defer clearClientOperation(config)
+
+	// The resource type comes from the context and package level closures are named after the kind of operation
+	setClientOperation(WithResourceType(context.Background(), "genesyscloud_flow"), config, readTestQueue, "read")
+	resourceType, operation := resourceOperation(config)
+	if resourceType != "genesyscloud_flow" || operation != "read" {
+		t.Errorf("Expected resource type genesyscloud_flow and operation read, got %s and %s", resourceType, operation)
+	}
+
+	setClientOperation(context.Background(), config, TestUnitNormalizeApiPath, "read")
+	resourceType, operation = resourceOperation(config)
+	if resourceType != "" || operation != "TestUnitNormalizeApiPath" {
+		t.Errorf("Expected no resource type and operation TestUnitNormalizeApiPath, got %s and %s", resourceType, operation)
+	}
+
+	// Operations of resources registered with the provider are labeled with their type
+	var labeledType string
+	resource := withResourceTypeLabel("genesyscloud_script", &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			labeledType = resourceTypeFromContext(ctx)
+			return nil
+		},
+	})
+	resource.ReadContext(context.Background(), nil, nil)
+	if labeledType != "genesyscloud_script" {
+		t.Errorf("Expected resource type genesyscloud_script, got %s", labeledType)
+	}
+}
@@ -1,13 +1,21 @@
 package provider
 
 import (
+	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/util/mockapi"
 	"testing"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func TestProvider(t *testing.T) {
-	if err := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().InternalValidate(); err != nil {
-		t.Fatalf("err: %s", err)
+func TestUnitGetRegionBasePath(t *testing.T) {
+	t.Setenv(constants.BasePathEnvVar, "")
+	if basePath := GetRegionBasePath("us-east-1"); basePath != "https://api.mypurecloud.com" {
+		t.Errorf("Expected the us-east-1 base path, got %s", basePath)
+	}
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	t.Setenv(constants.BasePathEnvVar, server.URL)
+	if basePath := GetRegionBasePath("us-east-1"); basePath != server.URL {
+		t.Errorf("Expected the mock API base path %s, got %s", server.URL, basePath)
 	}
 }
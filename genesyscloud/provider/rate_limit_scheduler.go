@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The rate limit scheduler paces the requests made by every SDK client in the pool. Requests are grouped by API family, which
+is the first path segment after /api/v2 (e.g. routing or telephony). Each family has a token bucket shared by the whole pool
+that is sized from the inin-ratelimit-allowed response header. A 429 pauses the whole family for the Retry-After period, and
+waiting requests resume with jitter so that the pool doesn't retry all at once.
+*/
+
+const (
+	rateLimitWindow    = time.Minute
+	defaultRetryAfter  = time.Second
+	maxRateLimitJitter = time.Second
+	maxRetryBackoff    = 30 * time.Second
+)
+
+type rateLimitScheduler struct {
+	mutex    sync.Mutex
+	poolSize int
+	families map[string]*apiFamilyLimit
+	now      func() time.Time
+	jitter   func() time.Duration
+}
+
+type apiFamilyLimit struct {
+	// capacity is 0 until the rate limit of the family has been read from a response
+	capacity    float64
+	tokens      float64
+	refillRate  float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+}
+
+var rateLimiter = newRateLimitScheduler()
+
+func newRateLimitScheduler() *rateLimitScheduler {
+	return &rateLimitScheduler{
+		poolSize: 1,
+		families: make(map[string]*apiFamilyLimit),
+		now:      time.Now,
+		jitter: func() time.Duration {
+			return time.Duration(rand.Int63n(int64(maxRateLimitJitter)))
+		},
+	}
+}
+
+// setPoolSize scales the rate limits, which are per OAuth token, to the number of clients in the pool
+func (s *rateLimitScheduler) setPoolSize(size int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if size > 0 {
+		s.poolSize = size
+	}
+}
+
+// wait blocks until a request to the API family is allowed to be sent
+func (s *rateLimitScheduler) wait(ctx context.Context, family string) error {
+	for {
+		delay := s.reserve(family)
+		if delay <= 0 {
+			return nil
+		}
+		log.Printf("Rate limit scheduler delaying request to the %s API by %s", family, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token from the bucket of the family. It returns how long to wait before trying again if there is none.
+func (s *rateLimitScheduler) reserve(family string) time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	limit := s.family(family)
+	now := s.now()
+	if now.Before(limit.pausedUntil) {
+		return limit.pausedUntil.Sub(now) + s.jitter()
+	}
+
+	if limit.capacity == 0 {
+		return 0
+	}
+	limit.refill(now)
+	if limit.tokens >= 1 {
+		limit.tokens--
+		return 0
+	}
+	return time.Duration((1 - limit.tokens) / limit.refillRate * float64(time.Second))
+}
+
+// backoff returns how long to wait before retrying a failed request to the API family. The delay doubles with every
+// attempt up to maxRetryBackoff, is never shorter than a pause of the family, and has jitter.
+func (s *rateLimitScheduler) backoff(family string, attempt int) time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delay := maxRetryBackoff
+	if attempt < 6 {
+		delay = min(defaultRetryAfter<<attempt, maxRetryBackoff)
+	}
+	if paused := s.family(family).pausedUntil.Sub(s.now()); paused > delay {
+		delay = paused
+	}
+	return delay + s.jitter()
+}
+
+// record updates the limits of an API family from the status code and headers of a response
+func (s *rateLimitScheduler) record(family string, statusCode int, header http.Header) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	limit := s.family(family)
+	now := s.now()
+
+	if allowed := headerInt(header, "inin-ratelimit-allowed"); allowed > 0 {
+		capacity := float64(allowed * s.poolSize)
+		if limit.capacity == 0 {
+			limit.tokens = capacity
+			limit.lastRefill = now
+		}
+		limit.capacity = capacity
+		limit.refillRate = capacity / rateLimitWindow.Seconds()
+
+		// The count is per token, so the remaining requests are estimated for the whole pool
+		if count := headerInt(header, "inin-ratelimit-count"); count > 0 {
+			limit.refill(now)
+			remaining := float64((allowed - count) * s.poolSize)
+			if remaining < limit.tokens {
+				limit.tokens = remaining
+			}
+		}
+	}
+
+	if statusCode == http.StatusTooManyRequests {
+		retryAfter := defaultRetryAfter
+		if seconds := headerInt(header, "Retry-After"); seconds > 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		} else if seconds := headerInt(header, "inin-ratelimit-reset"); seconds > 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		if pausedUntil := now.Add(retryAfter); pausedUntil.After(limit.pausedUntil) {
+			limit.pausedUntil = pausedUntil
+		}
+		limit.tokens = 0
+		limit.lastRefill = limit.pausedUntil
+		log.Printf("Rate limit exceeded on the %s API. Pausing requests for %s", family, retryAfter)
+	}
+}
+
+func (s *rateLimitScheduler) family(family string) *apiFamilyLimit {
+	limit, ok := s.families[family]
+	if !ok {
+		limit = &apiFamilyLimit{}
+		s.families[family] = limit
+	}
+	return limit
+}
+
+func (l *apiFamilyLimit) refill(now time.Time) {
+	if now.After(l.lastRefill) {
+		l.tokens += now.Sub(l.lastRefill).Seconds() * l.refillRate
+		if l.tokens > l.capacity {
+			l.tokens = l.capacity
+		}
+		l.lastRefill = now
+	}
+}
+
+// apiFamily returns the family of a request path, e.g. routing for /api/v2/routing/queues/{id}
+func apiFamily(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) >= 3 && segments[0] == "api" {
+		return segments[2]
+	}
+	return segments[0]
+}
+
+func headerInt(header http.Header, key string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(header.Get(key)))
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+func waitForRateLimit(request *http.Request) {
+	if err := rateLimiter.wait(request.Context(), apiFamily(request.URL.Path)); err != nil {
+		log.Printf("Stopped waiting for the rate limit of %s: %v", request.URL.Path, err)
+	}
+}
+
+// WaitForRetry blocks before a request that failed with resp is retried by custom retry logic, so that the retries back
+// off like the retries of the SDK and wait out a pause of the API family. The retried request itself is paced by
+// waitForRateLimit. The attempt starts at 0.
+func WaitForRetry(ctx context.Context, resp *platformclientv2.APIResponse, attempt int) error {
+	family := ""
+	if resp != nil && resp.Response != nil && resp.Response.Request != nil {
+		family = apiFamily(resp.Response.Request.URL.Path)
+	}
+
+	timer := time.NewTimer(rateLimiter.backoff(family, attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+	return nil
+}
+
+func recordRateLimit(response *http.Response) {
+	if response.Request == nil {
+		return
+	}
+	rateLimiter.record(apiFamily(response.Request.URL.Path), response.StatusCode, response.Header)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestRateLimitScheduler(now *time.Time) *rateLimitScheduler {
+	s := newRateLimitScheduler()
+	s.now = func() time.Time { return *now }
+	s.jitter = func() time.Duration { return 0 }
+	return s
+}
+
+func TestUnitApiFamily(t *testing.T) {
+	tests := map[string]string{
+		"/api/v2/routing/queues/abc":             "routing",
+		"/api/v2/telephony/providers/edges/dids": "telephony",
+		"/oauth/token":                           "oauth",
+	}
+	for path, expected := range tests {
+		if family := apiFamily(path); family != expected {
+			t.Errorf("Expected family %s for %s, got %s", expected, path, family)
+		}
+	}
+}
+
+func TestUnitRateLimitSchedulerTokenBucket(t *testing.T) {
+	now := time.Now()
+	s := newTestRateLimitScheduler(&now)
+	s.setPoolSize(2)
+
+	// The limit is unknown until a response has been recorded
+	if delay := s.reserve("routing"); delay != 0 {
+		t.Fatalf("Expected no delay before the rate limit is known, got %s", delay)
+	}
+
+	header := http.Header{}
+	header.Set("inin-ratelimit-allowed", "30")
+	header.Set("inin-ratelimit-count", "29")
+	s.record("routing", http.StatusOK, header)
+
+	// One request left per token, for a pool of 2 tokens
+	for i := 0; i < 2; i++ {
+		if delay := s.reserve("routing"); delay != 0 {
+			t.Fatalf("Expected request %d to not be delayed, got %s", i, delay)
+		}
+	}
+	if delay := s.reserve("routing"); delay != time.Second {
+		t.Errorf("Expected a delay of 1s to refill a token at 60 requests per minute, got %s", delay)
+	}
+
+	// Other families are not affected
+	if delay := s.reserve("users"); delay != 0 {
+		t.Errorf("Expected no delay for another API family, got %s", delay)
+	}
+}
+
+func TestUnitRateLimitSchedulerRetryAfter(t *testing.T) {
+	now := time.Now()
+	s := newTestRateLimitScheduler(&now)
+
+	header := http.Header{}
+	header.Set("Retry-After", "5")
+	s.record("telephony", http.StatusTooManyRequests, header)
+
+	if delay := s.reserve("telephony"); delay != 5*time.Second {
+		t.Errorf("Expected a delay of 5s after a 429, got %s", delay)
+	}
+
+	now = now.Add(5 * time.Second)
+	if delay := s.reserve("telephony"); delay != 0 {
+		t.Errorf("Expected no delay once the Retry-After period has passed, got %s", delay)
+	}
+}
+
+func TestUnitRateLimitSchedulerBackoff(t *testing.T) {
+	now := time.Now()
+	s := newTestRateLimitScheduler(&now)
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second}
+	for attempt, delay := range expected {
+		if backoff := s.backoff("routing", attempt); backoff != delay {
+			t.Errorf("Expected a backoff of %s for attempt %d, got %s", delay, attempt, backoff)
+		}
+	}
+
+	// A paused family is not retried before the pause is over
+	header := http.Header{}
+	header.Set("Retry-After", "10")
+	s.record("routing", http.StatusTooManyRequests, header)
+	if backoff := s.backoff("routing", 0); backoff != 10*time.Second {
+		t.Errorf("Expected a backoff of 10s while the family is paused, got %s", backoff)
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/persistent_cache"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	resourceCacheBackendMemory = "memory"
+	resourceCacheBackendDisk   = "disk"
+)
+
+// setupResourceCache enables the persistent cache for the org when the disk backend is configured
+func setupResourceCache(data *schema.ResourceData, orgId string) diag.Diagnostics {
+	persistent_cache.Disable()
+
+	for _, cacheObj := range data.Get("resource_cache").(*schema.Set).List() {
+		cache := cacheObj.(map[string]interface{})
+		if cache["backend"].(string) != resourceCacheBackendDisk {
+			continue
+		}
+
+		ttl, err := time.ParseDuration(cache["ttl"].(string))
+		if err != nil {
+			return diag.Errorf("invalid resource cache TTL %s: %v", cache["ttl"].(string), err)
+		}
+		if err := persistent_cache.Enable(cache["directory"].(string), orgId, ttl); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// invalidateCachedResource stops an object, and the cached objects that include resources of its type, being served from
+// the resource cache once the provider starts modifying it
+func invalidateCachedResource(method resContextFunc) resContextFunc {
+	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		persistent_cache.InvalidateResource(resourceTypeFromContext(ctx), r.Id())
+		return method(ctx, r, meta)
+	}
+}
+
+func validateDuration(i interface{}, p cty.Path) diag.Diagnostics {
+	durationStr, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected a string but got %T", i)
+	}
+	if duration, err := time.ParseDuration(durationStr); err != nil || duration <= 0 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s is not a valid positive duration", durationStr),
+			AttributePath: p,
+		}}
+	}
+	return nil
+}
@@ -14,7 +14,8 @@ import (
 // SDKClientPool holds a Pool of client configs for the Genesys Cloud SDK. One should be
 // acquired at the beginning of any resource operation and released on completion.
 // This has the benefit of ensuring we don't issue too many concurrent requests and also
-// increases throughput as each token will have its own rate limit.
+// increases throughput as each token will have its own rate limit. Requests from every client
+// in the Pool are paced by the shared rate limit scheduler.
 type SDKClientPool struct {
 	Pool chan *platformclientv2.Configuration
 }
@@ -27,6 +28,8 @@ var Once sync.Once
 // This must be called during provider initialization before the Pool is used
 func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) diag.Diagnostics {
 	Once.Do(func() {
+		rateLimiter.setPoolSize(max)
+
 		log.Print("Initializing default SDK client.")
 		// Initialize the default config for tests and anything else that doesn't use the Pool
 		err := InitClientConfig(providerConfig, version, platformclientv2.GetDefaultConfiguration())
@@ -94,33 +97,64 @@ func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
 	}
 }
 
+// TryAcquireClients takes up to max idle clients from the Pool without waiting for busy clients to be released.
+// Resources that send many independent requests use them in addition to their own client to spread the requests
+// over several tokens. The clients must be given back with ReleaseClients.
+func TryAcquireClients(ctx context.Context, max int, method interface{}) []*platformclientv2.Configuration {
+	var clients []*platformclientv2.Configuration
+	if SdkClientPool == nil {
+		return clients
+	}
+	for len(clients) < max {
+		select {
+		case clientConfig := <-SdkClientPool.Pool:
+			setClientOperation(ctx, clientConfig, method, "")
+			clients = append(clients, clientConfig)
+		default:
+			return clients
+		}
+	}
+	return clients
+}
+
+// ReleaseClients returns clients taken with TryAcquireClients to the Pool
+func ReleaseClients(clients []*platformclientv2.Configuration) {
+	for _, clientConfig := range clients {
+		clearClientOperation(clientConfig)
+		SdkClientPool.release(clientConfig)
+	}
+}
+
 type resContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
 type GetAllConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics)
 type GetCustomConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
 
 func CreateWithPooledClient(method resContextFunc) schema.CreateContextFunc {
-	return schema.CreateContextFunc(runWithPooledClient(method))
+	return schema.CreateContextFunc(invalidateCachedResource(runWithPooledClient(method, "create")))
 }
 
 func ReadWithPooledClient(method resContextFunc) schema.ReadContextFunc {
-	return schema.ReadContextFunc(runWithPooledClient(method))
+	return schema.ReadContextFunc(runWithPooledClient(method, "read"))
 }
 
 func UpdateWithPooledClient(method resContextFunc) schema.UpdateContextFunc {
-	return schema.UpdateContextFunc(runWithPooledClient(method))
+	return schema.UpdateContextFunc(invalidateCachedResource(runWithPooledClient(method, "update")))
 }
 
 func DeleteWithPooledClient(method resContextFunc) schema.DeleteContextFunc {
-	return schema.DeleteContextFunc(runWithPooledClient(method))
+	return schema.DeleteContextFunc(invalidateCachedResource(runWithPooledClient(method, "delete")))
 }
 
 // Inject a pooled SDK client connection into a resource method's meta argument
 // and automatically return it to the Pool on completion
-func runWithPooledClient(method resContextFunc) resContextFunc {
+func runWithPooledClient(method resContextFunc, kind string) resContextFunc {
 	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		clientConfig := SdkClientPool.acquire()
 		defer SdkClientPool.release(clientConfig)
 
+		setClientOperation(ctx, clientConfig, method, kind)
+		defer clearClientOperation(clientConfig)
+
 		// Check if the request has been cancelled
 		select {
 		case <-ctx.Done():
@@ -141,6 +175,9 @@ func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllReso
 		clientConfig := SdkClientPool.acquire()
 		defer SdkClientPool.release(clientConfig)
 
+		setClientOperation(ctx, clientConfig, method, "getAll")
+		defer clearClientOperation(clientConfig)
+
 		// Check if the request has been cancelled
 		select {
 		case <-ctx.Done():
@@ -157,6 +194,9 @@ func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.G
 		clientConfig := SdkClientPool.acquire()
 		defer SdkClientPool.release(clientConfig)
 
+		setClientOperation(ctx, clientConfig, method, "getAll")
+		defer clearClientOperation(clientConfig)
+
 		// Check if the request has been cancelled
 		select {
 		case <-ctx.Done():
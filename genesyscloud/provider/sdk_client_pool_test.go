@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+func TestUnitTryAcquireClients(t *testing.T) {
+	originalPool := SdkClientPool
+	defer func() { SdkClientPool = originalPool }()
+
+	SdkClientPool = nil
+	if clients := TryAcquireClients(context.Background(), 2, TestUnitTryAcquireClients); len(clients) != 0 {
+		t.Fatalf("Expected no clients without a pool, got %d", len(clients))
+	}
+
+	SdkClientPool = &SDKClientPool{Pool: make(chan *platformclientv2.Configuration, 3)}
+	for i := 0; i < 3; i++ {
+		SdkClientPool.Pool <- platformclientv2.NewConfiguration()
+	}
+
+	// Only idle clients are taken, so asking for more than are available does not block
+	clients := TryAcquireClients(context.Background(), 5, TestUnitTryAcquireClients)
+	if len(clients) != 3 {
+		t.Fatalf("Expected 3 clients, got %d", len(clients))
+	}
+	if len(SdkClientPool.Pool) != 0 {
+		t.Fatalf("Expected the pool to be empty, it has %d clients", len(SdkClientPool.Pool))
+	}
+
+	ReleaseClients(clients)
+	if len(SdkClientPool.Pool) != 3 {
+		t.Fatalf("Expected the clients to be returned to the pool, it has %d clients", len(SdkClientPool.Pool))
+	}
+}
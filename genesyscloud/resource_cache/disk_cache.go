@@ -0,0 +1,67 @@
+package resource_cache
+
+import (
+	"encoding/json"
+	"log"
+	"reflect"
+	"terraform-provider-genesyscloud/genesyscloud/persistent_cache"
+)
+
+// diskCache stores values as JSON in the persistent cache directory. Values of each type are kept in their own namespace.
+type diskCache[T any] struct {
+	namespace string
+}
+
+func newDiskCache[T any]() *diskCache[T] {
+	return &diskCache[T]{
+		namespace: reflect.TypeOf((*T)(nil)).Elem().String(),
+	}
+}
+
+// Set stores a value in the disk cache
+func (c *diskCache[T]) Set(key string, value T) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Printf("Failed to marshal %s %s for the resource cache: %v", c.namespace, key, err)
+		return
+	}
+	if err := persistent_cache.Store(c.namespace, key, data); err != nil {
+		log.Printf("Failed to write %s %s to the resource cache: %v", c.namespace, key, err)
+	}
+}
+
+func (c *diskCache[T]) Delete(key string) {
+	persistent_cache.Remove(c.namespace, key)
+}
+
+// Get retrieves a value from the disk cache. Entries that can no longer be unmarshalled are removed.
+func (c *diskCache[T]) Get(key string) (T, bool) {
+	var value T
+	data, ok := persistent_cache.Load(c.namespace, key)
+	if !ok {
+		return value, false
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		log.Printf("Failed to unmarshal %s %s from the resource cache: %v", c.namespace, key, err)
+		c.Delete(key)
+		return value, false
+	}
+	return value, true
+}
+
+// GetAll retrieves all the values from the disk cache
+func (c *diskCache[T]) GetAll() []T {
+	var items []T
+	for _, data := range persistent_cache.LoadAll(c.namespace) {
+		var item T
+		if err := json.Unmarshal(data, &item); err == nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// GetSize retrieves the size of the disk cache
+func (c *diskCache[T]) GetSize() int {
+	return len(persistent_cache.LoadAll(c.namespace))
+}
@@ -0,0 +1,73 @@
+package resource_cache
+
+import (
+	"context"
+	"log"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
+)
+
+// HydrateFunc fills a cache in bulk. It is usually a proxy's getAll function, which caches every object it lists.
+type HydrateFunc func(ctx context.Context) error
+
+// Outside of an export, a cache is only hydrated once this many objects have been read from the API individually,
+// so that reading a handful of objects doesn't list every object in the org
+const hydrateMissThreshold = 10
+
+type hydrationState struct {
+	lock     sync.Mutex
+	misses   int
+	hydrated bool
+}
+
+var hydrationStates sync.Map
+
+// GetCacheItemOrHydrate returns an item from the cache like GetCacheItem. On a cache miss, the cache is filled once by
+// calling hydrate so that the remaining objects can be read from the cache instead of with one API call each.
+// During an export the cache is only hydrated if the exporter has not already filled it.
+func GetCacheItemOrHydrate[T any](ctx context.Context, cache CacheInterface[T], key string, hydrate HydrateFunc) *T {
+	if !isCacheActive(ctx) {
+		return nil
+	}
+	if item, ok := cache.Get(key); ok {
+		return &item
+	}
+
+	if hydrateOnMiss(ctx, cache, cache.GetSize(), hydrate) {
+		if item, ok := cache.Get(key); ok {
+			return &item
+		}
+	}
+	log.Printf("Resource Data not present in the Cache for %v, will do API call to fetch", key)
+	return nil
+}
+
+// hydrateOnMiss records a cache miss and hydrates the cache if it is due. It returns true if the cache was hydrated.
+func hydrateOnMiss(ctx context.Context, cache any, size int, hydrate HydrateFunc) bool {
+	stateObj, _ := hydrationStates.LoadOrStore(cache, &hydrationState{})
+	state := stateObj.(*hydrationState)
+
+	// Concurrent reads wait here until the cache has been hydrated
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	if state.hydrated {
+		return false
+	}
+	state.misses++
+	if tfexporter_state.IsExporterActiveContext(ctx) {
+		if size > 0 {
+			state.hydrated = true
+			return false
+		}
+	} else if state.misses < hydrateMissThreshold {
+		return false
+	}
+
+	state.hydrated = true
+	if err := hydrate(ctx); err != nil {
+		log.Printf("Failed to hydrate the resource cache, objects will be read individually: %v", err)
+		return false
+	}
+	return true
+}
@@ -1,7 +1,9 @@
 package resource_cache
 
 import (
+	"context"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/persistent_cache"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 )
 
@@ -13,27 +15,48 @@ type CacheInterface[T any] interface {
 	Delete(key string)
 }
 
-// NewResourceCache is a factory method to return the cache implementation. We have made this a cache so we can plugin in
+// NewResourceCache is a factory method to return the cache implementation: an in-memory cache backed by a disk cache
+// that is only used once the persistent cache is enabled in the provider config (see tieredCache).
 func NewResourceCache[T any]() CacheInterface[T] {
-	return &inMemoryCache[T]{ //This will show as a missing type in goland, but it compiles.  I think golang is have a problem resolving this
-		data: make(map[string]T),
+	return &tieredCache[T]{
+		memory: &inMemoryCache[T]{ //This will show as a missing type in goland, but it compiles.  I think golang is have a problem resolving this
+			data: make(map[string]T),
+		},
+		disk: newDiskCache[T](),
 	}
 }
 
-func SetCache[T any](cache CacheInterface[T], key string, value T) {
-	if tfexporter_state.IsExporterActive() {
+// InvalidateOnChange makes changes to resources of the given types invalidate every value of the cache. It is used for
+// caches whose values include other resources, e.g. users include their skills.
+func InvalidateOnChange[T any](cache CacheInterface[T], resourceTypes ...string) {
+	tiered, ok := cache.(*tieredCache[T])
+	if !ok {
+		return
+	}
+	for _, resourceType := range resourceTypes {
+		persistent_cache.AddDependentNamespace(resourceType, tiered.disk.namespace)
+	}
+}
+
+// isCacheActive returns true during an export, or during any run when the persistent cache is enabled
+func isCacheActive(ctx context.Context) bool {
+	return tfexporter_state.IsExporterActiveContext(ctx) || persistent_cache.IsEnabled()
+}
+
+func SetCache[T any](ctx context.Context, cache CacheInterface[T], key string, value T) {
+	if isCacheActive(ctx) {
 		cache.Set(key, value)
 	}
 }
 
-func DeleteCacheItem[T any](cache CacheInterface[T], key string) {
-	if tfexporter_state.IsExporterActive() {
+func DeleteCacheItem[T any](ctx context.Context, cache CacheInterface[T], key string) {
+	if isCacheActive(ctx) {
 		cache.Delete(key)
 	}
 }
 
-func GetCacheItem[T any](cache CacheInterface[T], key string) *T {
-	if tfexporter_state.IsExporterActive() {
+func GetCacheItem[T any](ctx context.Context, cache CacheInterface[T], key string) *T {
+	if isCacheActive(ctx) {
 		eg, ok := cache.Get(key)
 		if ok {
 			return &eg
@@ -43,8 +66,9 @@ func GetCacheItem[T any](cache CacheInterface[T], key string) *T {
 	return nil
 }
 
-func GetCache[T any](cache CacheInterface[T]) *[]T {
-	if tfexporter_state.IsExporterActive() {
+// GetCache and GetCacheSize are only used during an export, as outside of an export the cache may not hold every object
+func GetCache[T any](ctx context.Context, cache CacheInterface[T]) *[]T {
+	if tfexporter_state.IsExporterActiveContext(ctx) {
 		items := cache.GetAll()
 		if items != nil && len(items) > 0 {
 			return &items
@@ -54,8 +78,8 @@ func GetCache[T any](cache CacheInterface[T]) *[]T {
 	return nil
 }
 
-func GetCacheSize[T any](cache CacheInterface[T]) int {
-	if tfexporter_state.IsExporterActive() {
+func GetCacheSize[T any](ctx context.Context, cache CacheInterface[T]) int {
+	if tfexporter_state.IsExporterActiveContext(ctx) {
 		return cache.GetSize()
 	}
 
@@ -1,43 +1,124 @@
 package resource_cache
 
 import (
+	"context"
+	"terraform-provider-genesyscloud/genesyscloud/persistent_cache"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 	"testing"
+	"time"
 )
 
+type cachedItem struct {
+	Name string `json:"name"`
+}
+
 func TestUnitWithoutExporterState(t *testing.T) {
 	cache := NewResourceCache[int]()
 	// Test SetCache
-	SetCache(cache, "key1", 10)
+	SetCache(context.Background(), cache, "key1", 10)
 
 	// Test GetCacheItem
-	valPtr := GetCacheItem(cache, "key1")
+	valPtr := GetCacheItem(context.Background(), cache, "key1")
 	if valPtr != nil {
 		t.Errorf("Expected Nil Value for key 'key1', got %v", valPtr)
 	}
 
 	// Test GetCacheItem for non-existent key
-	valPtr = GetCacheItem(cache, "nonexistent")
+	valPtr = GetCacheItem(context.Background(), cache, "nonexistent")
 	if valPtr != nil {
 		t.Errorf("Expected nil value from the Cache")
 	}
+
+	// The cache is only used by reads whose context is marked as an export read
+	ctx := tfexporter_state.WithExporterState(context.Background())
+	SetCache(ctx, cache, "key2", 20)
+	if valPtr = GetCacheItem(ctx, cache, "key2"); valPtr == nil || *valPtr != 20 {
+		t.Errorf("Expected value %d for key 'key2', got %v", 20, valPtr)
+	}
+	if valPtr = GetCacheItem(context.Background(), cache, "key2"); valPtr != nil {
+		t.Errorf("Expected nil value outside of an export read, got %v", valPtr)
+	}
 }
 
 func TestUnitSetCacheAndGetCache(t *testing.T) {
 	tfexporter_state.ActivateExporterState()
 	cache := NewResourceCache[int]()
 	// Test SetCache
-	SetCache(cache, "key1", 10)
+	SetCache(context.Background(), cache, "key1", 10)
 
 	// Test GetCacheItem
-	valPtr := GetCacheItem(cache, "key1")
+	valPtr := GetCacheItem(context.Background(), cache, "key1")
 	if *valPtr != 10 {
 		t.Errorf("Expected value %d for key 'key1', got %v", 10, valPtr)
 	}
 
 	// Test GetCacheItem for non-existent key
-	valPtr = GetCacheItem(cache, "nonexistent")
+	valPtr = GetCacheItem(context.Background(), cache, "nonexistent")
 	if &valPtr == nil {
 		t.Errorf("Expected key 'nonexistent' to not exist in the cache")
 	}
 }
+
+func TestUnitPersistentCache(t *testing.T) {
+	if err := persistent_cache.Enable(t.TempDir(), "org-id", time.Hour); err != nil {
+		t.Fatalf("Failed to enable the persistent cache: %v", err)
+	}
+	defer persistent_cache.Disable()
+
+	SetCache(context.Background(), NewResourceCache[cachedItem](), "persisted", cachedItem{Name: "Persisted"})
+
+	// A new cache has nothing in memory, so the value must be read from disk
+	cache := NewResourceCache[cachedItem]()
+	valPtr := GetCacheItem(context.Background(), cache, "persisted")
+	if valPtr == nil || valPtr.Name != "Persisted" {
+		t.Fatalf("Expected value 'Persisted' for key 'persisted', got %v", valPtr)
+	}
+	if size := cache.GetSize(); size != 0 {
+		t.Errorf("Expected values read from disk to not be added to memory, got size %d", size)
+	}
+
+	persistent_cache.InvalidateResource("genesyscloud_test", "persisted")
+	if valPtr = GetCacheItem(context.Background(), NewResourceCache[cachedItem](), "persisted"); valPtr != nil {
+		t.Errorf("Expected invalidated key 'persisted' to not be in the cache, got %v", valPtr)
+	}
+}
+
+func TestUnitPersistentCacheExpiry(t *testing.T) {
+	if err := persistent_cache.Enable(t.TempDir(), "org-id", time.Millisecond); err != nil {
+		t.Fatalf("Failed to enable the persistent cache: %v", err)
+	}
+	defer persistent_cache.Disable()
+
+	SetCache(context.Background(), NewResourceCache[cachedItem](), "expired", cachedItem{Name: "Expired"})
+	time.Sleep(5 * time.Millisecond)
+
+	if valPtr := GetCacheItem(context.Background(), NewResourceCache[cachedItem](), "expired"); valPtr != nil {
+		t.Errorf("Expected expired key 'expired' to not be in the cache, got %v", valPtr)
+	}
+}
+
+func TestUnitGetCacheItemOrHydrate(t *testing.T) {
+	tfexporter_state.ActivateExporterState()
+	cache := NewResourceCache[cachedItem]()
+
+	hydrateCalls := 0
+	hydrate := func(ctx context.Context) error {
+		hydrateCalls++
+		SetCache(ctx, cache, "item1", cachedItem{Name: "Item 1"})
+		SetCache(ctx, cache, "item2", cachedItem{Name: "Item 2"})
+		return nil
+	}
+
+	valPtr := GetCacheItemOrHydrate(context.Background(), cache, "item2", hydrate)
+	if valPtr == nil || valPtr.Name != "Item 2" {
+		t.Fatalf("Expected value 'Item 2' for key 'item2', got %v", valPtr)
+	}
+
+	// The cache is only hydrated once, so a key that was not listed falls back to the API
+	if valPtr = GetCacheItemOrHydrate(context.Background(), cache, "nonexistent", hydrate); valPtr != nil {
+		t.Errorf("Expected key 'nonexistent' to not exist in the cache, got %v", valPtr)
+	}
+	if hydrateCalls != 1 {
+		t.Errorf("Expected the cache to be hydrated once, got %d", hydrateCalls)
+	}
+}
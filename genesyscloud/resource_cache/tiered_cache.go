@@ -0,0 +1,52 @@
+package resource_cache
+
+import "terraform-provider-genesyscloud/genesyscloud/persistent_cache"
+
+/*
+tieredCache is the cache returned by NewResourceCache. Values are always kept in memory and, when the persistent cache has
+been enabled in the provider config, are also written to disk so that later runs can read them back.
+GetAll and GetSize only look at memory because the disk can hold a partial list of objects from earlier runs, so values
+read from disk are not copied into memory either.
+*/
+type tieredCache[T any] struct {
+	memory *inMemoryCache[T]
+	disk   *diskCache[T]
+}
+
+func (c *tieredCache[T]) Set(key string, value T) {
+	c.memory.Set(key, value)
+	if persistent_cache.IsEnabled() {
+		c.disk.Set(key, value)
+	}
+}
+
+func (c *tieredCache[T]) Delete(key string) {
+	c.memory.Delete(key)
+	if persistent_cache.IsEnabled() {
+		c.disk.Delete(key)
+	}
+}
+
+// Get looks in memory first and then on disk. Keys invalidated by a create, update or delete are never served.
+func (c *tieredCache[T]) Get(key string) (T, bool) {
+	if persistent_cache.IsInvalidated(c.disk.namespace, key) {
+		var value T
+		return value, false
+	}
+	if value, ok := c.memory.Get(key); ok {
+		return value, true
+	}
+	if !persistent_cache.IsEnabled() {
+		var value T
+		return value, false
+	}
+	return c.disk.Get(key)
+}
+
+func (c *tieredCache[T]) GetAll() []T {
+	return c.memory.GetAll()
+}
+
+func (c *tieredCache[T]) GetSize() int {
+	return c.memory.GetSize()
+}
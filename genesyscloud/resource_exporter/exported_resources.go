@@ -0,0 +1,103 @@
+package resource_exporter
+
+import (
+	"sort"
+)
+
+// ExportedResources finds the resources of one export by name or by ID. Only the exporters of the export are searched,
+// so resources read by another export, drift report or dependency graph in the same provider process are never found.
+type ExportedResources struct {
+	exporters    map[string]*ResourceExporter
+	types        []string
+	isDataSource func(resourceType string, label string) bool
+}
+
+// ExportedResource is a resource of an export
+type ExportedResource struct {
+	Type  string
+	Label string
+
+	// IsDataSource is true if the resource is exported as a data source
+	IsDataSource bool
+}
+
+// Address returns the address used to reference the resource in the export, e.g. data.genesyscloud_routing_queue.Sales
+func (r ExportedResource) Address() string {
+	if r.IsDataSource {
+		return "data." + r.Type + "." + r.Label
+	}
+	return r.Type + "." + r.Label
+}
+
+// NewExportedResources returns the lookup of the resources of an export. isDataSource reports whether a resource of the
+// export is exported as a data source.
+func NewExportedResources(exporters map[string]*ResourceExporter, isDataSource func(resourceType string, label string) bool) *ExportedResources {
+	types := make([]string, 0, len(exporters))
+	for resourceType := range exporters {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+
+	return &ExportedResources{
+		exporters:    exporters,
+		types:        types,
+		isDataSource: isDataSource,
+	}
+}
+
+// ByName returns the exported resource of a type with the given name. It is used to replace names embedded in exported
+// files with references to the exported resource. Names that were made unique with a hash when they were sanitized are
+// not found.
+func (e *ExportedResources) ByName(resourceType string, name string) (ExportedResource, bool) {
+	if e == nil || name == "" {
+		return ExportedResource{}, false
+	}
+	exporter := e.exporters[resourceType]
+	if exporter == nil {
+		return ExportedResource{}, false
+	}
+
+	label := NewSanitizerProvider().S.SanitizeResourceName(name)
+	exporter.mutex.RLock()
+	defer exporter.mutex.RUnlock()
+	for _, meta := range exporter.SanitizedResourceMap {
+		if meta.Name == label {
+			return e.exportedResource(resourceType, label), true
+		}
+	}
+	return ExportedResource{}, false
+}
+
+// ById returns the exported resource with the given ID. Some types share the IDs of the objects they extend (e.g.
+// genesyscloud_user and genesyscloud_user_roles), so the types are searched in a fixed order: the given resourceTypes
+// in order, or else every type of the export alphabetically, which finds a type before the types extending its name.
+func (e *ExportedResources) ById(id string, resourceTypes ...string) (ExportedResource, bool) {
+	if e == nil || id == "" {
+		return ExportedResource{}, false
+	}
+	if len(resourceTypes) == 0 {
+		resourceTypes = e.types
+	}
+
+	for _, resourceType := range resourceTypes {
+		exporter := e.exporters[resourceType]
+		if exporter == nil {
+			continue
+		}
+		exporter.mutex.RLock()
+		meta := exporter.SanitizedResourceMap[id]
+		exporter.mutex.RUnlock()
+		if meta != nil {
+			return e.exportedResource(resourceType, meta.Name), true
+		}
+	}
+	return ExportedResource{}, false
+}
+
+func (e *ExportedResources) exportedResource(resourceType string, label string) ExportedResource {
+	resource := ExportedResource{Type: resourceType, Label: label}
+	if e.isDataSource != nil {
+		resource.IsDataSource = e.isDataSource(resourceType, label)
+	}
+	return resource
+}
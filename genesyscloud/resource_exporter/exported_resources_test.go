@@ -0,0 +1,44 @@
+package resource_exporter
+
+import (
+	"testing"
+)
+
+func TestUnitExportedResources(t *testing.T) {
+	userId := "5b9e8e8a-6c3c-4b7e-9d47-1f0a7c8e2a01"
+	exporters := map[string]*ResourceExporter{
+		"genesyscloud_user_roles":    {SanitizedResourceMap: ResourceIDMetaMap{userId: {Name: "jane_roles"}}},
+		"genesyscloud_user":          {SanitizedResourceMap: ResourceIDMetaMap{userId: {Name: "jane"}}},
+		"genesyscloud_auth_division": {SanitizedResourceMap: ResourceIDMetaMap{"division-1": {Name: "Home"}}},
+	}
+	isDataSource := func(resourceType string, label string) bool {
+		return resourceType == "genesyscloud_auth_division"
+	}
+	exported := NewExportedResources(exporters, isDataSource)
+
+	// Types sharing an ID are always searched in the same order
+	for i := 0; i < 10; i++ {
+		resource, ok := exported.ById(userId)
+		if !ok || resource.Address() != "genesyscloud_user.jane" {
+			t.Fatalf("Expected genesyscloud_user.jane, got %v", resource.Address())
+		}
+	}
+	if resource, ok := exported.ById(userId, "genesyscloud_user_roles"); !ok || resource.Label != "jane_roles" {
+		t.Errorf("Expected the user roles when the type is given, got %v", resource)
+	}
+
+	// Resources exported as data sources are referenced as data sources
+	resource, ok := exported.ByName("genesyscloud_auth_division", "Home")
+	if !ok || resource.Address() != "data.genesyscloud_auth_division.Home" {
+		t.Errorf("Expected data.genesyscloud_auth_division.Home, got %v", resource.Address())
+	}
+
+	// Types that are not part of the export are never found
+	if _, ok := exported.ByName("genesyscloud_routing_queue", "Sales"); ok {
+		t.Error("Expected no resource of a type outside of the export")
+	}
+	var noExport *ExportedResources
+	if _, ok := noExport.ById(userId); ok {
+		t.Error("Expected no resource without an export")
+	}
+}
@@ -25,6 +25,10 @@ type ResourceMeta struct {
 
 	// Prefix to add to the ID when reading state
 	IdPrefix string
+
+	// Version or modified date of the object as returned by the list API. Incremental exports
+	// skip re-reading objects whose version has not changed. Leave empty if the API does not provide one.
+	Version string
 }
 
 // ResourceIDMetaMap is a map of IDs to ResourceMeta
@@ -51,6 +55,9 @@ type ResourceInfo struct {
 	Type         string
 	CtyType      cty.Type
 	ResourceType string
+
+	// Exported finds the other resources of the export the resource belongs to
+	Exported *ExportedResources
 }
 
 // RefAttrCustomResolver allows the definition of a custom resolver for an exporter.
@@ -74,6 +81,10 @@ type CustomFileWriterSettings struct {
 	// be written to genesyscloud_tf_export.directory/audio/
 	// The logic for retrieving and writing data to this dir should be defined in RetrieveAndWriteFilesFunc
 	SubDirectory string
+
+	// Optional boolean attribute of the export that must be true for RetrieveAndWriteFilesFunc to be invoked.
+	// For example, flow YAML files are only written when export_flows_as_yaml is set
+	EnabledByAttribute string
 }
 
 type JsonEncodeRefAttr struct {
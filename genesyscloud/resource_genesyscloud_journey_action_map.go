@@ -485,7 +485,7 @@ func updateJourneyActionMap(ctx context.Context, d *schema.ResourceData, meta in
 	patchActionMap := buildSdkPatchActionMap(d)
 
 	log.Printf("Updating journey action map %s", d.Id())
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current journey action map version
 		actionMap, resp, getErr := journeyApi.GetJourneyActionmap(d.Id())
 		if getErr != nil {
@@ -302,7 +302,7 @@ func readEvaluationForm(ctx context.Context, d *schema.ResourceData, meta interf
 
 		// During an export, Retrieve a list of any published versions of the evaluation form
 		// If there are published versions, published will be set to true
-		if tfexporter_state.IsExporterActive() {
+		if tfexporter_state.IsExporterActiveContext(ctx) {
 			publishedVersions, resp, err := qualityAPI.GetQualityFormsEvaluationsBulkContexts([]string{*evaluationForm.ContextId})
 			if err != nil {
 				if util.IsStatus404(resp) {
@@ -128,7 +128,7 @@ func getAllResponseAssetsFn(ctx context.Context, p *responsemanagementResponseas
 	}
 
 	for _, asset := range allResponseAssets {
-		rc.SetCache(p.assetCache, *asset.Id, asset)
+		rc.SetCache(ctx, p.assetCache, *asset.Id, asset)
 	}
 
 	return &allResponseAssets, response, nil
@@ -154,7 +154,11 @@ func updateRespManagementRespAssetFn(ctx context.Context, p *responsemanagementR
 
 // getRespManagementRespAssetByIdFn is an implementation of the function to get a Genesys Cloud responsemanagement responseasset by Id
 func getRespManagementRespAssetByIdFn(ctx context.Context, p *responsemanagementResponseassetProxy, id string) (*platformclientv2.Responseasset, *platformclientv2.APIResponse, error) {
-	asset := rc.GetCacheItem(p.assetCache, id)
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllResponseAssetsFn(ctx, p)
+		return err
+	}
+	asset := rc.GetCacheItemOrHydrate(ctx, p.assetCache, id, hydrate)
 	if asset != nil {
 		return asset, nil, nil
 	}
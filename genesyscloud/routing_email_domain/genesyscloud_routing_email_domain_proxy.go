@@ -117,7 +117,7 @@ func getAllRoutingEmailDomainsFn(ctx context.Context, p *routingEmailDomainProxy
 	}
 
 	for _, domain := range allDomains {
-		rc.SetCache(p.routingEmailDomainCache, *domain.Id, domain)
+		rc.SetCache(ctx, p.routingEmailDomainCache, *domain.Id, domain)
 	}
 	return &allDomains, response, nil
 }
@@ -127,7 +127,11 @@ func createRoutingEmailDomainFn(ctx context.Context, p *routingEmailDomainProxy,
 }
 
 func getRoutingEmailDomainByIdFn(ctx context.Context, p *routingEmailDomainProxy, id string) (*platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
-	if domain := rc.GetCacheItem(p.routingEmailDomainCache, id); domain != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllRoutingEmailDomainsFn(ctx, p)
+		return err
+	}
+	if domain := rc.GetCacheItemOrHydrate(ctx, p.routingEmailDomainCache, id, hydrate); domain != nil {
 		return domain, nil, nil
 	}
 	return p.routingApi.GetRoutingEmailDomain(id)
@@ -109,7 +109,7 @@ func getAllRoutingLanguagesFn(ctx context.Context, p *routingLanguageProxy, name
 	}
 
 	for _, language := range allLanguages {
-		rc.SetCache(p.routingLanguageCache, *language.Id, language)
+		rc.SetCache(ctx, p.routingLanguageCache, *language.Id, language)
 	}
 
 	return &allLanguages, response, nil
@@ -122,7 +122,11 @@ func createRoutingLanguageFn(ctx context.Context, p *routingLanguageProxy, routi
 
 // getRoutingLanguageByIdFn is an implementation of the function to get a Genesys Cloud routing language by Id
 func getRoutingLanguageByIdFn(ctx context.Context, p *routingLanguageProxy, id string) (*platformclientv2.Language, *platformclientv2.APIResponse, error) {
-	if language := rc.GetCacheItem(p.routingLanguageCache, id); language != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllRoutingLanguagesFn(ctx, p, "")
+		return err
+	}
+	if language := rc.GetCacheItemOrHydrate(ctx, p.routingLanguageCache, id, hydrate); language != nil {
 		return language, nil, nil
 	}
 	return p.routingApi.GetRoutingLanguage(id)
@@ -59,6 +59,9 @@ type RoutingQueueProxy struct {
 func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *RoutingQueueProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
 	wrapupCodeCache := rc.NewResourceCache[platformclientv2.Wrapupcode]()
+	// Queues hold the IDs of their skills and the wrapup codes are read per queue
+	rc.InvalidateOnChange(routingQueueCache, "genesyscloud_routing_skill")
+	rc.InvalidateOnChange(wrapupCodeCache, "genesyscloud_routing_wrapupcode")
 
 	return &RoutingQueueProxy{
 		clientConfig: clientConfig,
@@ -148,9 +151,9 @@ func GetAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy, name strin
 
 	// Check if the routing queue cache is populated with all the data, if it is, return that instead
 	// If the size of the cache is the same as the total number of queues, the cache is up-to-date
-	if rc.GetCacheSize(p.RoutingQueueCache) == *queues.Total && rc.GetCacheSize(p.RoutingQueueCache) != 0 {
-		return rc.GetCache(p.RoutingQueueCache), nil, nil
-	} else if rc.GetCacheSize(p.RoutingQueueCache) != *queues.Total && rc.GetCacheSize(p.RoutingQueueCache) != 0 {
+	if rc.GetCacheSize(ctx, p.RoutingQueueCache) == *queues.Total && rc.GetCacheSize(ctx, p.RoutingQueueCache) != 0 {
+		return rc.GetCache(ctx, p.RoutingQueueCache), nil, nil
+	} else if rc.GetCacheSize(ctx, p.RoutingQueueCache) != *queues.Total && rc.GetCacheSize(ctx, p.RoutingQueueCache) != 0 {
 		// The cache is populated but not with the right data, clear the cache so it can be re populated
 		p.RoutingQueueCache = rc.NewResourceCache[platformclientv2.Queue]()
 	}
@@ -175,7 +178,7 @@ func GetAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy, name strin
 	}
 
 	for _, queue := range allQueues {
-		rc.SetCache(p.RoutingQueueCache, *queue.Id, queue)
+		rc.SetCache(ctx, p.RoutingQueueCache, *queue.Id, queue)
 	}
 
 	return &allQueues, resp, nil
@@ -188,7 +191,11 @@ func createRoutingQueueFn(ctx context.Context, p *RoutingQueueProxy, createReq *
 // getRoutingQueueByIdFn is the implementation for retrieving a routing queues in Genesys Cloud
 func getRoutingQueueByIdFn(ctx context.Context, p *RoutingQueueProxy, queueId string, checkCache bool) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
 	if checkCache {
-		queue := rc.GetCacheItem(p.RoutingQueueCache, queueId)
+		hydrate := func(ctx context.Context) error {
+			_, _, err := GetAllRoutingQueuesFn(ctx, p, "")
+			return err
+		}
+		queue := rc.GetCacheItemOrHydrate(ctx, p.RoutingQueueCache, queueId, hydrate)
 		if queue != nil {
 			return queue, nil, nil
 		}
@@ -224,7 +231,7 @@ func deleteRoutingQueueFn(ctx context.Context, p *RoutingQueueProxy, queueID str
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.RoutingQueueCache, queueID)
+	rc.DeleteCacheItem(ctx, p.RoutingQueueCache, queueID)
 	return resp, nil
 }
 
@@ -238,9 +245,9 @@ func getAllRoutingQueueWrapupCodesFn(ctx context.Context, p *RoutingQueueProxy,
 	}
 
 	if wrapupcodes.Total != nil {
-		if rc.GetCacheSize(p.wrapupCodeCache) == *wrapupcodes.Total && rc.GetCacheSize(p.wrapupCodeCache) != 0 {
-			return rc.GetCache(p.wrapupCodeCache), nil, nil
-		} else if rc.GetCacheSize(p.wrapupCodeCache) != *wrapupcodes.Total && rc.GetCacheSize(p.wrapupCodeCache) != 0 {
+		if rc.GetCacheSize(ctx, p.wrapupCodeCache) == *wrapupcodes.Total && rc.GetCacheSize(ctx, p.wrapupCodeCache) != 0 {
+			return rc.GetCache(ctx, p.wrapupCodeCache), nil, nil
+		} else if rc.GetCacheSize(ctx, p.wrapupCodeCache) != *wrapupcodes.Total && rc.GetCacheSize(ctx, p.wrapupCodeCache) != 0 {
 			// The cache is populated but not with the right data, clear the cache so it can be re populated
 			p.wrapupCodeCache = rc.NewResourceCache[platformclientv2.Wrapupcode]()
 		}
@@ -267,7 +274,7 @@ func getAllRoutingQueueWrapupCodesFn(ctx context.Context, p *RoutingQueueProxy,
 
 	// Cache the routing wrapupcodes resource into the p.routingWrapupcodesCache for later use
 	for _, wrapupcode := range allWrapupcodes {
-		rc.SetCache(p.wrapupCodeCache, *wrapupcode.Id, wrapupcode)
+		rc.SetCache(ctx, p.wrapupCodeCache, *wrapupcode.Id, wrapupcode)
 	}
 
 	return &allWrapupcodes, apiResponse, nil
@@ -44,6 +44,8 @@ func getAllRoutingQueues(ctx context.Context, clientConfig *platformclientv2.Con
 	}
 
 	for _, queue := range *queues {
+		// No version is set as the modified date does not change when members or wrapup codes change, so
+		// incremental exports always re-read queues
 		resources[*queue.Id] = &resourceExporter.ResourceMeta{Name: *queue.Name}
 	}
 
@@ -74,7 +74,7 @@ func getRoutingQueueConditionRoutingFn(ctx context.Context, p *routingQueueCondi
 		err   error
 	)
 
-	queue = rc.GetCacheItem(p.routingQueueProxy.RoutingQueueCache, queueId)
+	queue = rc.GetCacheItem(ctx, p.routingQueueProxy.RoutingQueueCache, queueId)
 	if queue == nil {
 		queue, resp, err = p.getRoutingQueueById(ctx, queueId)
 		if err != nil {
@@ -64,7 +64,7 @@ func getRoutingQueueOutboundEmailAddressFn(ctx context.Context, p *routingQueueO
 		err   error
 	)
 
-	queue = rc.GetCacheItem(p.routingQueueProxy.RoutingQueueCache, queueId)
+	queue = rc.GetCacheItem(ctx, p.routingQueueProxy.RoutingQueueCache, queueId)
 	if queue == nil {
 		queue, resp, err = p.routingApi.GetRoutingQueue(queueId)
 		if err != nil {
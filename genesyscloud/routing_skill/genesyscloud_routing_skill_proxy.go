@@ -98,7 +98,7 @@ func getAllRoutingSkillsFn(ctx context.Context, p *routingSkillProxy, name strin
 	}
 
 	for _, skill := range allRoutingSkills {
-		rc.SetCache(p.routingSkillCache, *skill.Id, skill)
+		rc.SetCache(ctx, p.routingSkillCache, *skill.Id, skill)
 	}
 
 	return &allRoutingSkills, resp, nil
@@ -109,7 +109,11 @@ func createRoutingSkillFn(ctx context.Context, p *routingSkillProxy, routingSkil
 }
 
 func getRoutingSkillByIdFn(ctx context.Context, p *routingSkillProxy, id string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
-	if skill := rc.GetCacheItem(p.routingSkillCache, id); skill != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllRoutingSkillsFn(ctx, p, "")
+		return err
+	}
+	if skill := rc.GetCacheItemOrHydrate(ctx, p.routingSkillCache, id, hydrate); skill != nil {
 		return skill, nil, nil
 	}
 	return p.routingApi.GetRoutingSkill(id)
@@ -142,6 +146,6 @@ func deleteRoutingSkillFn(ctx context.Context, p *routingSkillProxy, id string)
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.routingSkillCache, id)
+	rc.DeleteCacheItem(ctx, p.routingSkillCache, id)
 	return nil, nil
 }
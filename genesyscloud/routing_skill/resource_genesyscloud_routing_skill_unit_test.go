@@ -0,0 +1,52 @@
+package routing_skill
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/mockapi"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Tests against the in-memory mock API **/
+func TestUnitRoutingSkillGetAllPaging(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	for i := 0; i < 120; i++ {
+		server.Seed("/api/v2/routing/skills", map[string]interface{}{"name": fmt.Sprintf("skill %d", i), "state": "active"})
+	}
+	deletedId := server.Seed("/api/v2/routing/skills", map[string]interface{}{"name": "deleted skill", "state": "deleted"})
+
+	resources, diagErr := GetAllRoutingSkills(context.Background(), server.NewConfiguration())
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Len(t, resources, 120)
+	assert.NotContains(t, resources, deletedId)
+	assert.Equal(t, 2, server.RequestCount("GET", "/api/v2/routing/skills"))
+}
+
+func TestUnitRoutingSkillCreateReadDelete(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: server.NewConfiguration()}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingSkill().Schema, map[string]interface{}{"name": "Mock Skill"})
+
+	diagErr := createRoutingSkill(ctx, d, gcloud)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, "Mock Skill", d.Get("name").(string))
+
+	skill, ok := server.Get("/api/v2/routing/skills", d.Id())
+	assert.True(t, ok)
+	assert.Equal(t, "Mock Skill", skill["name"])
+
+	diagErr = deleteRoutingSkill(ctx, d, gcloud)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, 0, server.Count("/api/v2/routing/skills"))
+}
@@ -90,7 +90,7 @@ func updateRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("Updating Routing Utilization")
 
 	// Retrying on 409s because if a label is created immediately before the utilization update, it can lead to a conflict while the utilization is being updated to handle the new label.
-	diagErr := util.RetryWhen(util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		_, resp, err := proxy.updateRoutingUtilization(ctx, &platformclientv2.Utilizationrequest{
 			Utilization:       BuildSdkMediaUtilizations(d),
 			LabelUtilizations: BuildSdkLabelUtilizations(d.Get("label_utilizations").([]interface{})),
@@ -77,7 +77,7 @@ func (p *routingUtilizationLabelProxy) deleteRoutingUtilizationLabel(ctx context
 	return p.deleteRoutingUtilizationLabelAttr(ctx, p, id, forceDelete)
 }
 
-func getAllRoutingUtilizationLabelsFn(_ context.Context, p *routingUtilizationLabelProxy, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+func getAllRoutingUtilizationLabelsFn(ctx context.Context, p *routingUtilizationLabelProxy, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
 	var allUtilizationLabels []platformclientv2.Utilizationlabel
 	const pageSize = 100
 
@@ -104,7 +104,7 @@ func getAllRoutingUtilizationLabelsFn(_ context.Context, p *routingUtilizationLa
 	}
 
 	for _, label := range allUtilizationLabels {
-		rc.SetCache(p.routingCache, *label.Id, label)
+		rc.SetCache(ctx, p.routingCache, *label.Id, label)
 	}
 
 	return &allUtilizationLabels, resp, nil
@@ -114,8 +114,12 @@ func createRoutingUtilizationLabelFn(_ context.Context, p *routingUtilizationLab
 	return p.routingApi.PostRoutingUtilizationLabels(*req)
 }
 
-func getRoutingUtilizationLabelFn(_ context.Context, p *routingUtilizationLabelProxy, id string) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
-	if label := rc.GetCacheItem(p.routingCache, id); label != nil {
+func getRoutingUtilizationLabelFn(ctx context.Context, p *routingUtilizationLabelProxy, id string) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllRoutingUtilizationLabelsFn(ctx, p, "")
+		return err
+	}
+	if label := rc.GetCacheItemOrHydrate(ctx, p.routingCache, id, hydrate); label != nil {
 		return label, nil, nil
 	}
 	return p.routingApi.GetRoutingUtilizationLabel(id)
@@ -101,7 +101,11 @@ func (p *routingWrapupcodeProxy) getRoutingWrapupcodeIdByName(ctx context.Contex
 
 // getRoutingWrapupcodeById returns a single Genesys Cloud routing wrapupcodes by Id
 func (p *routingWrapupcodeProxy) getRoutingWrapupcodeById(ctx context.Context, id string) (routingWrapupcode *platformclientv2.Wrapupcode, response *platformclientv2.APIResponse, err error) {
-	if wrapupcode := rc.GetCacheItem(p.routingWrapupcodesCache, id); wrapupcode != nil { // Get the wrapupcode from the cache, if not there in the cache then call p.getRoutingWrapupcodeByIdAttr()
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllRoutingWrapupcode(ctx)
+		return err
+	}
+	if wrapupcode := rc.GetCacheItemOrHydrate(ctx, p.routingWrapupcodesCache, id, hydrate); wrapupcode != nil { // Get the wrapupcode from the cache, if not there in the cache then call p.getRoutingWrapupcodeByIdAttr()
 		return wrapupcode, nil, nil
 	}
 	return p.getRoutingWrapupcodeByIdAttr(ctx, p, id)
@@ -138,7 +142,7 @@ func deleteRoutingWrapupcodeFn(ctx context.Context, p *routingWrapupcodeProxy, i
 	if err != nil {
 		return resp, err
 	}
-	rc.DeleteCacheItem(p.routingWrapupcodesCache, id)
+	rc.DeleteCacheItem(ctx, p.routingWrapupcodesCache, id)
 	return nil, nil
 }
 
@@ -173,7 +177,7 @@ func getAllRoutingWrapupcodeFn(ctx context.Context, p *routingWrapupcodeProxy) (
 
 	// Cache the routing wrapupcodes resource into the p.routingWrapupcodesCache for later use
 	for _, wrapupcode := range allWrapupcodes {
-		rc.SetCache(p.routingWrapupcodesCache, *wrapupcode.Id, wrapupcode)
+		rc.SetCache(ctx, p.routingWrapupcodesCache, *wrapupcode.Id, wrapupcode)
 	}
 
 	return &allWrapupcodes, apiResponse, nil
@@ -22,8 +22,8 @@ The genesyscloud_scripts_proxy.go file contains all of the logic associated with
 */
 var internalProxy *scriptsProxy
 
-type createScriptFunc func(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}, p *scriptsProxy) (scriptId string, err error)
-type updateScriptFunc func(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}, p *scriptsProxy) (id string, err error)
+type createScriptFunc func(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate, p *scriptsProxy) (scriptId string, err error)
+type updateScriptFunc func(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate, p *scriptsProxy) (id string, err error)
 type getAllPublishedScriptsFunc func(ctx context.Context, p *scriptsProxy) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error)
 type publishScriptFunc func(ctx context.Context, p *scriptsProxy, scriptId string) (*platformclientv2.APIResponse, error)
 type getScriptsByNameFunc func(ctx context.Context, p *scriptsProxy, scriptName string) ([]platformclientv2.Script, *platformclientv2.APIResponse, error)
@@ -91,13 +91,13 @@ func newScriptsProxy(clientConfig *platformclientv2.Configuration) *scriptsProxy
 }
 
 // createScript creates a Genesys Cloud Script
-func (p *scriptsProxy) createScript(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}) (string, error) {
-	return p.createScriptAttr(ctx, filePath, scriptName, substitutions, p)
+func (p *scriptsProxy) createScript(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate) (string, error) {
+	return p.createScriptAttr(ctx, filePath, scriptName, substitutions, fileTemplate, p)
 }
 
 // updateScript updates a Genesys Cloud Script
-func (p *scriptsProxy) updateScript(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}) (string, error) {
-	return p.updateScriptAttr(ctx, filePath, scriptName, scriptId, substitutions, p)
+func (p *scriptsProxy) updateScript(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate) (string, error) {
+	return p.updateScriptAttr(ctx, filePath, scriptName, scriptId, substitutions, fileTemplate, p)
 }
 
 func (p *scriptsProxy) getAllPublishedScripts(ctx context.Context) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error) {
@@ -150,7 +150,7 @@ func publishScriptFn(_ context.Context, p *scriptsProxy, scriptId string) (*plat
 }
 
 // getAllPublishedScriptsFn returns all published scripts within a Genesys Cloud instance
-func getAllPublishedScriptsFn(_ context.Context, p *scriptsProxy) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error) {
+func getAllPublishedScriptsFn(ctx context.Context, p *scriptsProxy) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error) {
 	var allPublishedScripts []platformclientv2.Script
 	var response *platformclientv2.APIResponse
 	pageSize := 50
@@ -190,7 +190,7 @@ func getAllPublishedScriptsFn(_ context.Context, p *scriptsProxy) (*[]platformcl
 	}
 
 	for _, script := range allPublishedScripts {
-		rc.SetCache(p.scriptCache, *script.Id, script)
+		rc.SetCache(ctx, p.scriptCache, *script.Id, script)
 	}
 
 	return &allPublishedScripts, response, nil
@@ -260,7 +260,7 @@ func (p *scriptsProxy) createScriptFormData(filePath, scriptName, scriptId strin
 
 // uploadScriptFile uploads a script file to S3
 // For creates, scriptId should be an empty string
-func (p *scriptsProxy) uploadScriptFile(filePath, scriptName, scriptId string, substitutions map[string]interface{}) ([]byte, error) {
+func (p *scriptsProxy) uploadScriptFile(filePath, scriptName, scriptId string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate) ([]byte, error) {
 	formData, err := p.createScriptFormData(filePath, scriptName, scriptId)
 	if err != nil {
 		return nil, err
@@ -270,6 +270,7 @@ func (p *scriptsProxy) uploadScriptFile(filePath, scriptName, scriptId string, s
 	headers["Authorization"] = "Bearer " + p.accessToken
 
 	s3Uploader := files.NewS3Uploader(nil, formData, substitutions, headers, "POST", p.basePath+"/uploads/v2/scripter")
+	s3Uploader.SetTemplate(fileTemplate)
 	resp, err := s3Uploader.Upload()
 	return resp, err
 }
@@ -351,14 +352,14 @@ func scriptWasUploadedSuccessfullyFn(_ context.Context, p *scriptsProxy, uploadI
 }
 
 // getScriptExportUrlFn retrieves the export URL for a targeted script
-func getScriptExportUrlFn(_ context.Context, p *scriptsProxy, scriptId string) (string, *platformclientv2.APIResponse, error) {
+func getScriptExportUrlFn(ctx context.Context, p *scriptsProxy, scriptId string) (string, *platformclientv2.APIResponse, error) {
 	var (
 		body platformclientv2.Exportscriptrequest
 	)
 
 	// Sets the VersionId on the request so that the Published Version of the script is exported and not the editable version
 	// See DEVTOOLING-777
-	scriptCache := rc.GetCacheItem(p.scriptCache, scriptId)
+	scriptCache := rc.GetCacheItem(ctx, p.scriptCache, scriptId)
 	body.VersionId = scriptCache.VersionId
 
 	data, resp, err := p.scriptsApi.PostScriptExport(scriptId, body)
@@ -398,8 +399,12 @@ func deleteScriptFn(_ context.Context, p *scriptsProxy, scriptId string) error {
 }
 
 // getScriptByIdFn retrieves a script by Id
-func getScriptByIdFn(_ context.Context, p *scriptsProxy, scriptId string) (script *platformclientv2.Script, resp *platformclientv2.APIResponse, err error) {
-	if script := rc.GetCacheItem(p.scriptCache, scriptId); script != nil {
+func getScriptByIdFn(ctx context.Context, p *scriptsProxy, scriptId string) (script *platformclientv2.Script, resp *platformclientv2.APIResponse, err error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllPublishedScriptsFn(ctx, p)
+		return err
+	}
+	if script := rc.GetCacheItemOrHydrate(ctx, p.scriptCache, scriptId, hydrate); script != nil {
 		return script, nil, nil
 	}
 
@@ -442,7 +447,7 @@ func getPublishedScriptsByNameFn(_ context.Context, p *scriptsProxy, name string
 }
 
 // createScriptFn is an implementation function for creating a Genesys Cloud Script
-func createScriptFn(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}, p *scriptsProxy) (string, error) {
+func createScriptFn(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate, p *scriptsProxy) (string, error) {
 	exists, err := scriptExistsWithName(ctx, p, scriptName)
 	if err != nil {
 		return "", err
@@ -452,7 +457,7 @@ func createScriptFn(ctx context.Context, filePath, scriptName string, substituti
 		return "", fmt.Errorf("script with name '%s' already exists. Please provide a unique name", scriptName)
 	}
 
-	resp, err := p.uploadScriptFile(filePath, scriptName, "", substitutions)
+	resp, err := p.uploadScriptFile(filePath, scriptName, "", substitutions, fileTemplate)
 	if err != nil {
 		return "", err
 	}
@@ -479,8 +484,8 @@ func createScriptFn(ctx context.Context, filePath, scriptName string, substituti
 }
 
 // updateScriptFn is an implementation function for updating a Genesys Cloud Script
-func updateScriptFn(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}, p *scriptsProxy) (string, error) {
-	resp, err := p.uploadScriptFile(filePath, scriptName, scriptId, substitutions)
+func updateScriptFn(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}, fileTemplate *files.FileTemplate, p *scriptsProxy) (string, error) {
+	resp, err := p.uploadScriptFile(filePath, scriptName, scriptId, substitutions, fileTemplate)
 	if err != nil {
 		return "", err
 	}
@@ -10,6 +10,7 @@ import (
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
@@ -43,9 +44,13 @@ func createScript(ctx context.Context, d *schema.ResourceData, meta interface{})
 	filePath := d.Get("filepath").(string)
 	scriptName := d.Get("script_name").(string)
 	substitutions := d.Get("substitutions").(map[string]interface{})
+	fileTemplate, err := files.GetFileTemplate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("Creating script %s", scriptName)
-	scriptId, err := scriptsProxy.createScript(ctx, filePath, scriptName, substitutions)
+	scriptId, err := scriptsProxy.createScript(ctx, filePath, scriptName, substitutions, fileTemplate)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,10 +68,14 @@ func updateScript(ctx context.Context, d *schema.ResourceData, meta interface{})
 	filePath := d.Get("filepath").(string)
 	scriptName := d.Get("script_name").(string)
 	substitutions := d.Get("substitutions").(map[string]interface{})
+	fileTemplate, err := files.GetFileTemplate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("Updating script '%s' %s", scriptName, d.Id())
 
-	scriptId, err := scriptsProxy.updateScript(ctx, filePath, scriptName, d.Id(), substitutions)
+	scriptId, err := scriptsProxy.updateScript(ctx, filePath, scriptName, d.Id(), substitutions, fileTemplate)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -2,9 +2,11 @@ package scripts
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 )
 
@@ -44,6 +46,7 @@ func ResourceScript() *schema.Resource {
 		ReadContext:   provider.ReadWithPooledClient(readScript),
 		UpdateContext: provider.UpdateWithPooledClient(updateScript),
 		DeleteContext: provider.DeleteWithPooledClient(deleteScript),
+		CustomizeDiff: files.CustomizeTemplateValuesDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -71,6 +74,24 @@ func ResourceScript() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"template_values": {
+				Description: `JSON object of values used to render the script file as a Go template, for example jsonencode({ queues = [...] }). Values can be nested objects and lists and are referenced as {{ .key }}.
+The helpers queueRef and scheduleGroupRef render the literal name/ID pair of a reference, promptRef renders a Prompt.<name> expression and toJson renders any value inline.
+Referencing a value that is not set fails the plan with an error naming the key. Cannot be used with 'substitutions'.`,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"substitutions"},
+			},
+			"template_delimiters": {
+				Description:  "The left and right delimiters of the template actions in the file, for example [\"[[\", \"]]\"]. Defaults to {{ and }}. Use other delimiters when the file contains a literal {{ or }}.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     2,
+				MaxItems:     2,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				RequiredWith: []string{"template_values"},
+			},
 		},
 	}
 }
@@ -148,7 +148,7 @@ func getTaskManagementWorkbinIdByNameFn(ctx context.Context, p *taskManagementWo
 
 // getTaskManagementWorkbinByIdFn is an implementation of the function to get a Genesys Cloud task management workbin by Id
 func getTaskManagementWorkbinByIdFn(ctx context.Context, p *taskManagementWorkbinProxy, id string) (taskManagementWorkbin *platformclientv2.Workbin, resp *platformclientv2.APIResponse, err error) {
-	workbin := rc.GetCacheItem(p.workbinCache, id)
+	workbin := rc.GetCacheItem(ctx, p.workbinCache, id)
 	if workbin != nil {
 		return workbin, nil, nil
 	}
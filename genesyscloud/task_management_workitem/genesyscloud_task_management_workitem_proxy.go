@@ -230,7 +230,7 @@ func getTaskManagementWorkitemIdByNameFn(ctx context.Context, p *taskManagementW
 
 // getTaskManagementWorkitemByIdFn is an implementation of the function to get a Genesys Cloud task management workitem by Id
 func getTaskManagementWorkitemByIdFn(ctx context.Context, p *taskManagementWorkitemProxy, id string) (taskManagementWorkitem *platformclientv2.Workitem, resp *platformclientv2.APIResponse, err error) {
-	workitem := rc.GetCacheItem(p.workitemCache, id)
+	workitem := rc.GetCacheItem(ctx, p.workitemCache, id)
 	if workitem != nil {
 		return workitem, nil, nil
 	}
@@ -158,7 +158,7 @@ func getTaskManagementWorkitemSchemasByNameFn(ctx context.Context, p *taskManage
 
 // getTaskManagementWorkitemSchemaByIdFn is an implementation of the function to get a Genesys Cloud task management workitem schema by Id
 func getTaskManagementWorkitemSchemaByIdFn(ctx context.Context, p *taskManagementProxy, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error) {
-	workitemSchema := rc.GetCacheItem(p.workitemSchemaCache, id)
+	workitemSchema := rc.GetCacheItem(ctx, p.workitemSchemaCache, id)
 	if workitemSchema != nil {
 		return schema, nil, nil
 	}
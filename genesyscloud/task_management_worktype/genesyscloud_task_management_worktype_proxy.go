@@ -199,7 +199,7 @@ func getTaskManagementWorktypeByNameFn(ctx context.Context, p *TaskManagementWor
 
 // getTaskManagementWorktypeByIdFn is an implementation of the function to get a Genesys Cloud task management worktype by Id
 func getTaskManagementWorktypeByIdFn(ctx context.Context, p *TaskManagementWorktypeProxy, id string) (taskManagementWorktype *platformclientv2.Worktype, resp *platformclientv2.APIResponse, err error) {
-	worktype := rc.GetCacheItem(p.worktypeCache, id)
+	worktype := rc.GetCacheItem(ctx, p.worktypeCache, id)
 	if worktype != nil {
 		return worktype, nil, nil
 	}
@@ -57,7 +57,7 @@ func updateTeamMembers(ctx context.Context, d *schema.ResourceData, sdkConfig *p
 
 			chunkProcessor := func(membersToRemove []string) diag.Diagnostics {
 				if len(membersToRemove) > 0 {
-					if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+					if diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 						resp, err := proxy.deleteMembers(ctx, d.Id(), strings.Join(membersToRemove, ","))
 						if err != nil {
 							return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove members from team %s: %s", d.Id(), err), resp)
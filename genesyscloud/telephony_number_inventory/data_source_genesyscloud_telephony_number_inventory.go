@@ -0,0 +1,251 @@
+package telephony_number_inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ownerTypeIvr   = "ivr"
+	ownerTypeUser  = "user"
+	ownerTypeQueue = "queue"
+)
+
+type numberOwner struct {
+	ownerType string
+	id        string
+	name      string
+}
+
+type inventoryNumber struct {
+	number    string
+	didPoolId string
+	owners    []numberOwner
+}
+
+type didPoolRange struct {
+	id    string
+	start string
+	end   string
+}
+
+// contains checks if an E.164 number is in the range
+func (r didPoolRange) contains(number string) bool {
+	return util.IsNumberInDidRange(number, r.start, r.end)
+}
+
+// numberInventory is the table of numbers keyed by their E.164 format
+type numberInventory struct {
+	numbers  map[string]*inventoryNumber
+	didPools []didPoolRange
+}
+
+// addOwner adds an owner to a number. An owner that is already known through another API is only recorded once.
+func (n *numberInventory) addOwner(number string, owner numberOwner) {
+	entry := n.entry(number)
+	for _, existing := range entry.owners {
+		if existing.id == owner.id {
+			return
+		}
+	}
+	entry.owners = append(entry.owners, owner)
+}
+
+func (n *numberInventory) entry(number string) *inventoryNumber {
+	entry, ok := n.numbers[number]
+	if !ok {
+		entry = &inventoryNumber{number: number}
+		for _, pool := range n.didPools {
+			if pool.contains(number) {
+				entry.didPoolId = pool.id
+				break
+			}
+		}
+		n.numbers[number] = entry
+	}
+	return entry
+}
+
+func dataSourceTelephonyNumberInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getNumberInventoryProxy(sdkConfig)
+	utilE164 := util.NewUtilE164Service()
+
+	inventory := &numberInventory{numbers: make(map[string]*inventoryNumber)}
+
+	didPools, resp, err := proxy.getAllDidPools(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get DID pools: %s", err), resp)
+	}
+	for _, pool := range *didPools {
+		if pool.Id == nil || pool.StartPhoneNumber == nil || pool.EndPhoneNumber == nil {
+			continue
+		}
+		inventory.didPools = append(inventory.didPools, didPoolRange{
+			id:    *pool.Id,
+			start: utilE164.FormatAsCalculatedE164Number(*pool.StartPhoneNumber),
+			end:   utilE164.FormatAsCalculatedE164Number(*pool.EndPhoneNumber),
+		})
+	}
+
+	ivrs, resp, err := proxy.getAllIvrs(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get IVRs: %s", err), resp)
+	}
+	for _, ivr := range *ivrs {
+		if ivr.Id == nil || ivr.Dnis == nil {
+			continue
+		}
+		for _, dnis := range *ivr.Dnis {
+			inventory.addOwner(utilE164.FormatAsCalculatedE164Number(dnis), numberOwner{ownerType: ownerTypeIvr, id: *ivr.Id, name: stringValue(ivr.Name)})
+		}
+	}
+
+	users, resp, err := proxy.getAllUsers(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get users: %s", err), resp)
+	}
+	for _, user := range *users {
+		if user.Id == nil || user.Addresses == nil {
+			continue
+		}
+		for _, address := range *user.Addresses {
+			if address.MediaType == nil || *address.MediaType != "PHONE" || address.Address == nil {
+				continue
+			}
+			// User addresses are free text, so anything that is not a phone number is left out
+			number, diagErr := utilE164.FormatAsValidE164Number(strings.Trim(*address.Address, "()"))
+			if diagErr != nil {
+				continue
+			}
+			inventory.addOwner(number, numberOwner{ownerType: ownerTypeUser, id: *user.Id, name: stringValue(user.Name)})
+		}
+	}
+
+	queues, resp, err := proxy.getAllQueues(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get queues: %s", err), resp)
+	}
+	for _, queue := range *queues {
+		if queue.Id == nil || queue.CallingPartyNumber == nil || *queue.CallingPartyNumber == "" {
+			continue
+		}
+		inventory.addOwner(utilE164.FormatAsCalculatedE164Number(*queue.CallingPartyNumber), numberOwner{ownerType: ownerTypeQueue, id: *queue.Id, name: stringValue(queue.Name)})
+	}
+
+	// DIDs are read last so that an owner that was already found with its own API keeps its type
+	dids, resp, err := proxy.getAllDids(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get DIDs: %s", err), resp)
+	}
+	for _, did := range *dids {
+		if did.PhoneNumber == nil {
+			continue
+		}
+		entry := inventory.entry(utilE164.FormatAsCalculatedE164Number(*did.PhoneNumber))
+		if did.DidPool != nil && did.DidPool.Id != nil {
+			entry.didPoolId = *did.DidPool.Id
+		}
+		if did.Owner != nil && did.Owner.Id != nil {
+			inventory.addOwner(entry.number, numberOwner{ownerType: strings.ToLower(stringValue(did.OwnerType)), id: *did.Owner.Id, name: stringValue(did.Owner.Name)})
+		}
+	}
+
+	var diags diag.Diagnostics
+	if d.Get("include_unassigned").(bool) {
+		if !inventory.addUnassigned(d.Get("max_unassigned").(int)) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The DID pools have more than %d unassigned numbers. Only the first %d are included.", d.Get("max_unassigned").(int), d.Get("max_unassigned").(int)),
+			})
+		}
+	} else {
+		// DIDs without an owner are unassigned numbers as well
+		for number, entry := range inventory.numbers {
+			if len(entry.owners) == 0 {
+				delete(inventory.numbers, number)
+			}
+		}
+	}
+
+	numbers := flattenInventoryNumbers(inventory.numbers)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprint(numbers)))))
+	_ = d.Set("numbers", numbers)
+	return diags
+}
+
+// addUnassigned adds the numbers of the DID pool ranges that have no owner, up to max numbers.
+// It returns false when there were more unassigned numbers than that.
+func (n *numberInventory) addUnassigned(max int) bool {
+	pools := make([]didPoolRange, len(n.didPools))
+	copy(pools, n.didPools)
+	sort.Slice(pools, func(i, j int) bool { return pools[i].start < pools[j].start })
+
+	added := 0
+	for _, pool := range pools {
+		start, err := strconv.ParseUint(strings.TrimPrefix(pool.start, "+"), 10, 64)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.ParseUint(strings.TrimPrefix(pool.end, "+"), 10, 64)
+		if err != nil {
+			continue
+		}
+		for value := start; value <= end; value++ {
+			number := fmt.Sprintf("+%0*d", len(pool.start)-1, value)
+			if entry, ok := n.numbers[number]; ok && len(entry.owners) > 0 {
+				continue
+			}
+			if added == max {
+				return false
+			}
+			n.entry(number)
+			added++
+		}
+	}
+	return true
+}
+
+// flattenInventoryNumbers converts the inventory to the numbers attribute, ordered by number
+func flattenInventoryNumbers(numbers map[string]*inventoryNumber) []interface{} {
+	ordered := make([]*inventoryNumber, 0, len(numbers))
+	for _, number := range numbers {
+		ordered = append(ordered, number)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].number < ordered[j].number })
+
+	flattened := make([]interface{}, 0, len(ordered))
+	for _, number := range ordered {
+		owners := make([]interface{}, 0, len(number.owners))
+		for _, owner := range number.owners {
+			owners = append(owners, map[string]interface{}{
+				"type": owner.ownerType,
+				"id":   owner.id,
+				"name": owner.name,
+			})
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"number":      number.number,
+			"did_pool_id": number.didPoolId,
+			"assigned":    len(number.owners) > 0,
+			"owners":      owners,
+		})
+	}
+	return flattened
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
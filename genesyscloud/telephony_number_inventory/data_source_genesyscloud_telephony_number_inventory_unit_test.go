@@ -0,0 +1,115 @@
+package telephony_number_inventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildNumberInventoryTestProxy() *numberInventoryProxy {
+	ptr := platformclientv2.String
+	okResp := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+
+	proxy := &numberInventoryProxy{}
+	proxy.getAllDidPoolsAttr = func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Didpool{
+			{Id: ptr("pool-1"), StartPhoneNumber: ptr("+13175550000"), EndPhoneNumber: ptr("+13175550004")},
+		}, okResp, nil
+	}
+	proxy.getAllIvrsAttr = func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Ivr{
+			{Id: ptr("ivr-1"), Name: ptr("Main IVR"), Dnis: &[]string{"+13175550001"}},
+		}, okResp, nil
+	}
+	proxy.getAllUsersAttr = func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.User{
+			{Id: ptr("user-1"), Name: ptr("Jane Doe"), Addresses: &[]platformclientv2.Contact{
+				{MediaType: ptr("PHONE"), Address: ptr("(317) 555-0002")},
+				{MediaType: ptr("PHONE"), Address: ptr("not a number")},
+				{MediaType: ptr("SMS"), Address: ptr("+13175550003")},
+				{MediaType: ptr("PHONE"), Display: ptr("1234"), Extension: ptr("1234")},
+			}},
+		}, okResp, nil
+	}
+	proxy.getAllQueuesAttr = func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Queue{
+			{Id: ptr("queue-1"), Name: ptr("Support"), CallingPartyNumber: ptr("+13175550001")},
+			{Id: ptr("queue-2"), Name: ptr("Sales")},
+			{Id: ptr("queue-3"), Name: ptr("Outbound"), CallingPartyNumber: ptr("+442079460000")},
+		}, okResp, nil
+	}
+	proxy.getAllDidsAttr = func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Did, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Did{
+			// Owned by the IVR that was already found through its DNIS
+			{PhoneNumber: ptr("+13175550001"), DidPool: &platformclientv2.Domainentityref{Id: ptr("pool-1")}, OwnerType: ptr("IVR_CONFIG"), Owner: &platformclientv2.Domainentityref{Id: ptr("ivr-1"), Name: ptr("Main IVR")}},
+			{PhoneNumber: ptr("+13175550004"), DidPool: &platformclientv2.Domainentityref{Id: ptr("pool-1")}, OwnerType: ptr("PHONE"), Owner: &platformclientv2.Domainentityref{Id: ptr("phone-1"), Name: ptr("Lobby Phone")}},
+		}, okResp, nil
+	}
+	return proxy
+}
+
+func flattenedNumbersToStrings(numbers []interface{}) []string {
+	result := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		numberMap := number.(map[string]interface{})
+		owners := make([]string, 0)
+		for _, owner := range numberMap["owners"].([]interface{}) {
+			ownerMap := owner.(map[string]interface{})
+			owners = append(owners, fmt.Sprintf("%s:%s:%s", ownerMap["type"], ownerMap["id"], ownerMap["name"]))
+		}
+		result = append(result, fmt.Sprintf("%s|%s|%t|%s", numberMap["number"], numberMap["did_pool_id"], numberMap["assigned"], strings.Join(owners, ",")))
+	}
+	return result
+}
+
+func TestUnitDataSourceTelephonyNumberInventoryRead(t *testing.T) {
+	internalProxy = buildNumberInventoryTestProxy()
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceTelephonyNumberInventory().Schema, map[string]interface{}{})
+
+	diag := dataSourceTelephonyNumberInventoryRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, false, diag.HasError(), diag)
+	assert.Len(t, diag, 0)
+	assert.NotEqual(t, "", d.Id())
+
+	expected := []string{
+		"+13175550000|pool-1|false|",
+		"+13175550001|pool-1|true|ivr:ivr-1:Main IVR,queue:queue-1:Support",
+		"+13175550002|pool-1|true|user:user-1:Jane Doe",
+		"+13175550003|pool-1|false|",
+		"+13175550004|pool-1|true|phone:phone-1:Lobby Phone",
+		"+442079460000||true|queue:queue-3:Outbound",
+	}
+	assert.Equal(t, expected, flattenedNumbersToStrings(d.Get("numbers").([]interface{})))
+}
+
+func TestUnitDataSourceTelephonyNumberInventoryUnassigned(t *testing.T) {
+	internalProxy = buildNumberInventoryTestProxy()
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceTelephonyNumberInventory().Schema, map[string]interface{}{
+		"max_unassigned": 1,
+	})
+	diag := dataSourceTelephonyNumberInventoryRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, false, diag.HasError(), diag)
+	assert.Len(t, diag, 1)
+	assert.Len(t, d.Get("numbers").([]interface{}), 5)
+
+	d = schema.TestResourceDataRaw(t, DataSourceTelephonyNumberInventory().Schema, map[string]interface{}{
+		"include_unassigned": false,
+	})
+	diag = dataSourceTelephonyNumberInventoryRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, false, diag.HasError(), diag)
+	for _, number := range d.Get("numbers").([]interface{}) {
+		assert.Equal(t, true, number.(map[string]interface{})["assigned"])
+	}
+	assert.Len(t, d.Get("numbers").([]interface{}), 4)
+}
@@ -0,0 +1,200 @@
+package telephony_number_inventory
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_number_inventory_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+The number inventory reads from several APIs, so the proxy holds a client for each of them.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *numberInventoryProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllDidPoolsFunc func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+type getAllDidsFunc func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Did, *platformclientv2.APIResponse, error)
+type getAllIvrsFunc func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error)
+type getAllUsersFunc func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getAllQueuesFunc func(ctx context.Context, p *numberInventoryProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error)
+
+// numberInventoryProxy contains all of the methods that call genesys cloud APIs.
+type numberInventoryProxy struct {
+	clientConfig       *platformclientv2.Configuration
+	telephonyApi       *platformclientv2.TelephonyProvidersEdgeApi
+	architectApi       *platformclientv2.ArchitectApi
+	usersApi           *platformclientv2.UsersApi
+	routingApi         *platformclientv2.RoutingApi
+	getAllDidPoolsAttr getAllDidPoolsFunc
+	getAllDidsAttr     getAllDidsFunc
+	getAllIvrsAttr     getAllIvrsFunc
+	getAllUsersAttr    getAllUsersFunc
+	getAllQueuesAttr   getAllQueuesFunc
+}
+
+// newNumberInventoryProxy initializes the proxy with all data needed to communicate with Genesys Cloud
+func newNumberInventoryProxy(clientConfig *platformclientv2.Configuration) *numberInventoryProxy {
+	return &numberInventoryProxy{
+		clientConfig:       clientConfig,
+		telephonyApi:       platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+		architectApi:       platformclientv2.NewArchitectApiWithConfig(clientConfig),
+		usersApi:           platformclientv2.NewUsersApiWithConfig(clientConfig),
+		routingApi:         platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		getAllDidPoolsAttr: getAllDidPoolsFn,
+		getAllDidsAttr:     getAllDidsFn,
+		getAllIvrsAttr:     getAllIvrsFn,
+		getAllUsersAttr:    getAllUsersFn,
+		getAllQueuesAttr:   getAllQueuesFn,
+	}
+}
+
+// getNumberInventoryProxy acts as a singleton for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getNumberInventoryProxy(clientConfig *platformclientv2.Configuration) *numberInventoryProxy {
+	if internalProxy == nil {
+		internalProxy = newNumberInventoryProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllDidPools retrieves all Genesys Cloud DID pools
+func (p *numberInventoryProxy) getAllDidPools(ctx context.Context) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	return p.getAllDidPoolsAttr(ctx, p)
+}
+
+// getAllDids retrieves all Genesys Cloud DIDs
+func (p *numberInventoryProxy) getAllDids(ctx context.Context) (*[]platformclientv2.Did, *platformclientv2.APIResponse, error) {
+	return p.getAllDidsAttr(ctx, p)
+}
+
+// getAllIvrs retrieves all Genesys Cloud Architect IVRs
+func (p *numberInventoryProxy) getAllIvrs(ctx context.Context) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+	return p.getAllIvrsAttr(ctx, p)
+}
+
+// getAllUsers retrieves all active Genesys Cloud users
+func (p *numberInventoryProxy) getAllUsers(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getAllUsersAttr(ctx, p)
+}
+
+// getAllQueues retrieves all Genesys Cloud routing queues
+func (p *numberInventoryProxy) getAllQueues(ctx context.Context) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getAllQueuesAttr(ctx, p)
+}
+
+// getAllDidPoolsFn is an implementation function for retrieving all Genesys Cloud DID pools
+func getAllDidPoolsFn(_ context.Context, p *numberInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	return util.GetAllDidPools(p.telephonyApi)
+}
+
+// getAllDidsFn is an implementation function for retrieving all Genesys Cloud DIDs
+func getAllDidsFn(_ context.Context, p *numberInventoryProxy) (*[]platformclientv2.Did, *platformclientv2.APIResponse, error) {
+	var allDids []platformclientv2.Did
+	const pageSize = 100
+
+	dids, resp, err := p.telephonyApi.GetTelephonyProvidersEdgesDids(pageSize, 1, "", "", "", "", "", nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("error requesting page of dids: %v", err)
+	}
+	if dids.Entities != nil {
+		allDids = append(allDids, *dids.Entities...)
+	}
+
+	for pageNum := 2; pageNum <= *dids.PageCount; pageNum++ {
+		page, resp, err := p.telephonyApi.GetTelephonyProvidersEdgesDids(pageSize, pageNum, "", "", "", "", "", nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of dids: %v", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allDids = append(allDids, *page.Entities...)
+	}
+	return &allDids, resp, nil
+}
+
+// getAllIvrsFn is an implementation function for retrieving all Genesys Cloud Architect IVRs
+func getAllIvrsFn(_ context.Context, p *numberInventoryProxy) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+	var allIvrs []platformclientv2.Ivr
+	const pageSize = 100
+
+	ivrs, resp, err := p.architectApi.GetArchitectIvrs(1, pageSize, "", "", "", "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("error requesting page of architect ivrs: %v", err)
+	}
+	if ivrs.Entities != nil {
+		allIvrs = append(allIvrs, *ivrs.Entities...)
+	}
+
+	for pageNum := 2; pageNum <= *ivrs.PageCount; pageNum++ {
+		page, resp, err := p.architectApi.GetArchitectIvrs(pageNum, pageSize, "", "", "", "", "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of architect ivrs: %v", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allIvrs = append(allIvrs, *page.Entities...)
+	}
+	return &allIvrs, resp, nil
+}
+
+// getAllUsersFn is an implementation function for retrieving all active Genesys Cloud users
+func getAllUsersFn(_ context.Context, p *numberInventoryProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	var allUsers []platformclientv2.User
+	const pageSize = 100
+
+	users, resp, err := p.usersApi.GetUsers(pageSize, 1, nil, nil, "", nil, "", "active")
+	if err != nil {
+		return nil, resp, fmt.Errorf("error requesting page of users: %v", err)
+	}
+	if users.Entities != nil {
+		allUsers = append(allUsers, *users.Entities...)
+	}
+
+	for pageNum := 2; pageNum <= *users.PageCount; pageNum++ {
+		page, resp, err := p.usersApi.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", "active")
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of users: %v", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allUsers = append(allUsers, *page.Entities...)
+	}
+	return &allUsers, resp, nil
+}
+
+// getAllQueuesFn is an implementation function for retrieving all Genesys Cloud routing queues
+func getAllQueuesFn(_ context.Context, p *numberInventoryProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	var allQueues []platformclientv2.Queue
+	const pageSize = 100
+
+	queues, resp, err := p.routingApi.GetRoutingQueues(1, pageSize, "", "", nil, nil, nil, "", false)
+	if err != nil {
+		return nil, resp, fmt.Errorf("error requesting page of routing queues: %v", err)
+	}
+	if queues.Entities != nil {
+		allQueues = append(allQueues, *queues.Entities...)
+	}
+
+	for pageNum := 2; pageNum <= *queues.PageCount; pageNum++ {
+		page, resp, err := p.routingApi.GetRoutingQueues(pageNum, pageSize, "", "", nil, nil, nil, "", false)
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of routing queues: %v", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allQueues = append(allQueues, *page.Entities...)
+	}
+	return &allQueues, resp, nil
+}
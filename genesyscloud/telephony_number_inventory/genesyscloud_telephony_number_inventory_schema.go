@@ -0,0 +1,90 @@
+package telephony_number_inventory
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const resourceName = "genesyscloud_telephony_number_inventory"
+
+// SetRegistrar registers all resources, data sources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(resourceName, DataSourceTelephonyNumberInventory())
+}
+
+var numberOwnerResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": {
+			Description: "Type of the owner. `ivr`, `user` and `queue` for numbers used by IVRs, users and queues, otherwise the lower case owner type of the DID, e.g. `phone`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"id": {
+			Description: "ID of the owner.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the owner.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceTelephonyNumberInventory registers the genesyscloud_telephony_number_inventory data source
+func DataSourceTelephonyNumberInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the phone number inventory of a Genesys Cloud org. " +
+			"Merges the ranges of DID pools, the assigned DIDs, the DNIS of Architect IVRs, the phone addresses of active users and the calling party numbers of queues into one table of E.164 numbers with their owners.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceTelephonyNumberInventoryRead),
+		Schema: map[string]*schema.Schema{
+			"include_unassigned": {
+				Description: "Include the numbers of DID pool ranges that have no owner.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"max_unassigned": {
+				Description:  "Maximum number of unassigned numbers to include. Further unassigned numbers are left out with a warning.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"numbers": {
+				Description: "Phone numbers of the org ordered by number.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Description: "Phone number in E.164 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"did_pool_id": {
+							Description: "ID of the DID pool of the number. Empty for numbers that are not in any DID pool.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"assigned": {
+							Description: "Whether the number has an owner.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"owners": {
+							Description: "Owners of the number. A number with more than one owner is used by several configurations.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        numberOwnerResource,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -83,12 +83,16 @@ func (p *trunkbaseSettingProxy) UpdateTrunkBaseSetting(ctx context.Context, trun
 }
 
 func (p *trunkbaseSettingProxy) DeleteTrunkBaseSetting(ctx context.Context, trunkbaseSettingId string) (*platformclientv2.APIResponse, error) {
-	rc.DeleteCacheItem(p.trunkBaseCache, trunkbaseSettingId)
+	rc.DeleteCacheItem(ctx, p.trunkBaseCache, trunkbaseSettingId)
 	return p.deleteTrunkBaseSettingAttr(ctx, p, trunkbaseSettingId)
 }
 
 func getTrunkBaseSettingByIdFn(ctx context.Context, p *trunkbaseSettingProxy, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error) {
-	tb := rc.GetCacheItem(p.trunkBaseCache, trunkBaseSettingId)
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllTrunkBaseSettingsFn(ctx, p, "")
+		return err
+	}
+	tb := rc.GetCacheItemOrHydrate(ctx, p.trunkBaseCache, trunkBaseSettingId, hydrate)
 	if tb != nil {
 		return tb, nil, nil
 	}
@@ -115,7 +119,7 @@ func getAllTrunkBaseSettingsFn(ctx context.Context, p *trunkbaseSettingProxy, na
 			for _, trunkBaseSetting := range *trunkBaseSettings.Entities {
 				if trunkBaseSetting.State != nil && *trunkBaseSetting.State != "deleted" {
 					if name == "" {
-						rc.SetCache(p.trunkBaseCache, *trunkBaseSetting.Id, trunkBaseSetting)
+						rc.SetCache(ctx, p.trunkBaseCache, *trunkBaseSetting.Id, trunkBaseSetting)
 					}
 
 					trunkbaseSlice = append(trunkbaseSlice, trunkBaseSetting)
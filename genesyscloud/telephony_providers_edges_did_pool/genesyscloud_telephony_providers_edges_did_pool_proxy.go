@@ -3,6 +3,7 @@ package telephony_providers_edges_did_pool
 import (
 	"context"
 	"fmt"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
@@ -50,11 +51,13 @@ type telephonyDidPoolProxy struct {
 	deleteTelephonyDidPoolAttr                   deleteTelephonyDidPool
 	getTelephonyDidPoolIdByStartAndEndNumberAttr getTelephonyDidPoolIdByStartAndEndNumber
 	getAllTelephonyDidPoolsAttr                  getAllTelephonyDidPools
+	didPoolCache                                 rc.CacheInterface[platformclientv2.Didpool]
 }
 
 // newTelephonyProvidersEdgesDidPoolProxy initializes the proxy with all data needed to communicate with Genesys Cloud
 func newTelephonyProvidersEdgesDidPoolProxy(clientConfig *platformclientv2.Configuration) *telephonyDidPoolProxy {
 	api := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
+	didPoolCache := rc.NewResourceCache[platformclientv2.Didpool]()
 	return &telephonyDidPoolProxy{
 		clientConfig:                                 clientConfig,
 		telephonyApi:                                 api,
@@ -64,6 +67,7 @@ func newTelephonyProvidersEdgesDidPoolProxy(clientConfig *platformclientv2.Confi
 		deleteTelephonyDidPoolAttr:                   deleteTelephonyDidPoolFn,
 		getTelephonyDidPoolIdByStartAndEndNumberAttr: getTelephonyDidPoolIdByStartAndEndNumberFn,
 		getAllTelephonyDidPoolsAttr:                  getAllTelephonyDidPoolsFn,
+		didPoolCache:                                 didPoolCache,
 	}
 }
 
@@ -116,7 +120,15 @@ func createTelephonyDidPoolFn(_ context.Context, t *telephonyDidPoolProxy, didPo
 }
 
 // getTelephonyDidPoolByIdFn is an implementation function for reading a Genesys Cloud did pool by ID
-func getTelephonyDidPoolByIdFn(_ context.Context, t *telephonyDidPoolProxy, id string) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+func getTelephonyDidPoolByIdFn(ctx context.Context, t *telephonyDidPoolProxy, id string) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := getAllTelephonyDidPoolsFn(ctx, t)
+		return err
+	}
+	if didPool := rc.GetCacheItemOrHydrate(ctx, t.didPoolCache, id, hydrate); didPool != nil {
+		return didPool, nil, nil
+	}
+
 	didPool, resp, err := t.telephonyApi.GetTelephonyProvidersEdgesDidpool(id)
 	if err != nil {
 		return nil, resp, err
@@ -134,13 +146,17 @@ func updateEdgesDidPoolFn(_ context.Context, t *telephonyDidPoolProxy, id string
 }
 
 // deleteTelephonyDidPoolFn is an implementation function for deleting a Genesys Cloud did pool
-func deleteTelephonyDidPoolFn(_ context.Context, t *telephonyDidPoolProxy, id string) (*platformclientv2.APIResponse, error) {
+func deleteTelephonyDidPoolFn(ctx context.Context, t *telephonyDidPoolProxy, id string) (*platformclientv2.APIResponse, error) {
 	resp, err := t.telephonyApi.DeleteTelephonyProvidersEdgesDidpool(id)
-	return resp, err
+	if err != nil {
+		return resp, err
+	}
+	rc.DeleteCacheItem(ctx, t.didPoolCache, id)
+	return resp, nil
 }
 
 // getAllTelephonyDidPoolsFn is an implementation function for reading all Genesys Cloud did pools
-func getAllTelephonyDidPoolsFn(_ context.Context, t *telephonyDidPoolProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+func getAllTelephonyDidPoolsFn(ctx context.Context, t *telephonyDidPoolProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
 	var (
 		allDidPools []platformclientv2.Didpool
 		pageCount   int
@@ -158,10 +174,6 @@ func getAllTelephonyDidPoolsFn(_ context.Context, t *telephonyDidPoolProxy) (*[]
 		allDidPools = append(allDidPools, *didPools.Entities...)
 	}
 
-	if pageCount < 2 {
-		return &allDidPools, resp, nil
-	}
-
 	for pageNum := 2; pageNum <= pageCount; pageNum++ {
 		didPools, resp, getErr := t.telephonyApi.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", nil)
 		if getErr != nil {
@@ -174,6 +186,10 @@ func getAllTelephonyDidPoolsFn(_ context.Context, t *telephonyDidPoolProxy) (*[]
 
 		allDidPools = append(allDidPools, *didPools.Entities...)
 	}
+
+	for _, didPool := range allDidPools {
+		rc.SetCache(ctx, t.didPoolCache, *didPool.Id, didPool)
+	}
 	return &allDidPools, resp, nil
 }
 
@@ -139,7 +139,7 @@ func deleteDidPool(ctx context.Context, d *schema.ResourceData, meta interface{}
 	proxy := getTelephonyDidPoolProxy(sdkConfig)
 
 	// DEVTOOLING-317: Unable to delete DID pool with a number assigned, retrying on HTTP 409
-	diagErr := util.RetryWhen(util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting DID pool with starting number %s", startPhoneNumber)
 		resp, err := proxy.deleteTelephonyDidPool(ctx, d.Id())
 		if err != nil {
@@ -46,6 +46,7 @@ func (r *registerTestInstance) registerTestResources() {
 	defer r.resourceMapMutex.Unlock()
 
 	providerResources[resourceName] = ResourcePhone()
+	providerResources[batchResourceName] = ResourcePhoneBatch()
 	providerResources["genesyscloud_user"] = user.ResourceUser()
 	providerResources["genesyscloud_telephony_providers_edges_phonebasesettings"] = phoneBaseSettings.ResourcePhoneBaseSettings()
 	providerResources["genesyscloud_location"] = location.ResourceLocation()
@@ -48,6 +48,7 @@ type getStationOfUserFunc func(ctx context.Context, p *phoneProxy, userId string
 type unassignUserFromStationFunc func(ctx context.Context, p *phoneProxy, stationId string) (*platformclientv2.APIResponse, error)
 type assignUserToStationFunc func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
 type assignStationAsDefaultFunc func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
+type getUserIdByEmailFunc func(ctx context.Context, p *phoneProxy, email string) (userId string, resp *platformclientv2.APIResponse, err error)
 
 // phoneProxy contains all of the methods that call genesys cloud APIs.
 type phoneProxy struct {
@@ -69,6 +70,7 @@ type phoneProxy struct {
 	unassignUserFromStationAttr unassignUserFromStationFunc
 	assignUserToStationAttr     assignUserToStationFunc
 	assignStationAsDefaultAttr  assignStationAsDefaultFunc
+	getUserIdByEmailAttr        getUserIdByEmailFunc
 }
 
 // newPhoneProxy initializes the Phone proxy with all of the data needed to communicate with Genesys Cloud
@@ -97,6 +99,7 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 		unassignUserFromStationAttr: unassignUserFromStationFn,
 		assignUserToStationAttr:     assignUserToStationFn,
 		assignStationAsDefaultAttr:  assignStationAsDefaultFn,
+		getUserIdByEmailAttr:        getUserIdByEmailFn,
 	}
 }
 
@@ -121,7 +124,11 @@ func (p *phoneProxy) createPhone(ctx context.Context, phoneConfig *platformclien
 
 // getPhoneById retrieves a Genesys Cloud Phone by id
 func (p *phoneProxy) getPhoneById(ctx context.Context, phoneId string) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
-	if phone := rc.GetCacheItem(p.phoneCache, phoneId); phone != nil {
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllPhones(ctx)
+		return err
+	}
+	if phone := rc.GetCacheItemOrHydrate(ctx, p.phoneCache, phoneId, hydrate); phone != nil {
 		return phone, nil, nil
 	}
 	return p.getPhoneByIdAttr(ctx, p, phoneId)
@@ -167,6 +174,11 @@ func (p *phoneProxy) assignStationAsDefault(ctx context.Context, userId string,
 	return p.assignStationAsDefaultAttr(ctx, p, userId, stationId)
 }
 
+// getUserIdByEmail retrieves the ID of the user with an email address
+func (p *phoneProxy) getUserIdByEmail(ctx context.Context, email string) (string, *platformclientv2.APIResponse, error) {
+	return p.getUserIdByEmailAttr(ctx, p, email)
+}
+
 // getAllPhonesFn is an implementation function for retrieving all Genesys Cloud Phones
 func getAllPhonesFn(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
 	log.Printf("Entering the getAllPhonesFn method to retrieve all of the phone ids for export")
@@ -217,7 +229,7 @@ func getAllPhonesFn(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Pho
 		log.Printf("getAllPhonesFn::  Retrieved phone id %s with phone name: %s\n", *phone.Id, *phone.Name)
 
 		// Cache the phone resource into the p.phoneCache for later use
-		rc.SetCache(p.phoneCache, *phone.Id, phone)
+		rc.SetCache(ctx, p.phoneCache, *phone.Id, phone)
 	}
 
 	return &allPhones, response, nil
@@ -335,3 +347,22 @@ func assignUserToStationFn(ctx context.Context, p *phoneProxy, userId string, st
 func assignStationAsDefaultFn(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
 	return p.usersApi.PutUserStationDefaultstationStationId(userId, stationId)
 }
+
+// getUserIdByEmailFn is an implementation function for retrieving the ID of a Genesys Cloud User by email
+func getUserIdByEmailFn(ctx context.Context, p *phoneProxy, email string) (string, *platformclientv2.APIResponse, error) {
+	exactSearchType := "EXACT"
+	users, resp, err := p.usersApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+		Query: &[]platformclientv2.Usersearchcriteria{{
+			VarType: &exactSearchType,
+			Fields:  &[]string{"email"},
+			Value:   &email,
+		}},
+	})
+	if err != nil {
+		return "", resp, err
+	}
+	if users.Results == nil || len(*users.Results) == 0 {
+		return "", resp, fmt.Errorf("no user found with email %s", email)
+	}
+	return *(*users.Results)[0].Id, resp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -31,6 +32,9 @@ func getAllPhones(ctx context.Context, sdkConfig *platformclientv2.Configuration
 
 	for _, phone := range *phones {
 		resources[*phone.Id] = &resourceExporter.ResourceMeta{Name: *phone.Name}
+		if phone.Version != nil {
+			resources[*phone.Id].Version = strconv.Itoa(*phone.Version)
+		}
 	}
 	return resources, nil
 }
@@ -46,7 +50,7 @@ func createPhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	log.Printf("Creating phone %s", *phoneConfig.Name)
 
-	diagErr := util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		phone, resp, err := pp.createPhone(ctx, phoneConfig)
 		if err != nil {
 			return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to create phone %s error: %s", *phoneConfig.Name, err), resp)
@@ -163,7 +167,12 @@ func deletePhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 	pp := getPhoneProxy(sdkConfig)
 
 	log.Printf("Deleting Phone")
-	resp, err := pp.deletePhone(ctx, d.Id())
+	return deletePhoneAndWait(ctx, pp, d.Id())
+}
+
+// deletePhoneAndWait deletes a phone and waits until the platform no longer returns it
+func deletePhoneAndWait(ctx context.Context, pp *phoneProxy, phoneId string) diag.Diagnostics {
+	resp, err := pp.deletePhone(ctx, phoneId)
 
 	/*
 	  Adding a small sleep because when a phone is deleted, the station associated with the phone and the site
@@ -172,26 +181,26 @@ func deletePhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 	*/
 	time.Sleep(5 * time.Second)
 	if err != nil {
-		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to delete phone %s error: %s", d.Id(), err), resp)
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to delete phone %s error: %s", phoneId, err), resp)
 	}
 
 	return util.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
-		phone, resp, err := pp.getPhoneById(ctx, d.Id())
+		phone, resp, err := pp.getPhoneById(ctx, phoneId)
 		if err != nil {
 			if util.IsStatus404(resp) {
 				// Phone deleted
-				log.Printf("Deleted Phone %s", d.Id())
+				log.Printf("Deleted Phone %s", phoneId)
 				return nil
 			}
-			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("error deleting Phone %s | error: %s", d.Id(), err), resp))
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("error deleting Phone %s | error: %s", phoneId, err), resp))
 		}
 
 		if phone.State != nil && *phone.State == "deleted" {
 			// phone deleted
-			log.Printf("Deleted Phone %s", d.Id())
+			log.Printf("Deleted Phone %s", phoneId)
 			return nil
 		}
 
-		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("phone %s still exists", d.Id()), resp))
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("phone %s still exists", phoneId), resp))
 	})
 }
@@ -0,0 +1,297 @@
+package telephony_providers_edges_phone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_telephony_providers_edges_phone_batch.go contains the core logic of the phone batch resource.
+The phones of the batch are tracked by name in the phones attribute, so the resource has a generated ID.
+*/
+
+func createPhoneBatch(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	log.Printf("Creating phone batch %s from %s", d.Id(), d.Get("filepath").(string))
+	return applyPhoneBatch(ctx, d, meta, nil, false)
+}
+
+// readPhoneBatch clears the phone ID of every phone of the batch that no longer exists, so that the next apply creates it again
+func readPhoneBatch(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	pp := getPhoneProxy(sdkConfig)
+
+	log.Printf("Reading phone batch %s", d.Id())
+	phones, resp, err := pp.getAllPhones(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(batchResourceName, fmt.Sprintf("Failed to read phones of phone batch %s | error: %s", d.Id(), err), resp)
+	}
+	existing := make(map[string]bool, len(*phones))
+	for _, phone := range *phones {
+		existing[*phone.Id] = true
+	}
+
+	entries := buildPhoneBatchEntries(d.Get("phones").([]interface{}))
+	for i, entry := range entries {
+		if entry.phoneId != "" && !existing[entry.phoneId] {
+			log.Printf("Phone %s of phone batch %s no longer exists", entry.phoneId, d.Id())
+			entries[i].phoneId = ""
+			entries[i].error = fmt.Sprintf("phone %s no longer exists", entry.phoneId)
+		}
+	}
+	_ = d.Set("phones", flattenPhoneBatchEntries(entries))
+
+	log.Printf("Read phone batch %s", d.Id())
+	return nil
+}
+
+func updatePhoneBatch(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating phone batch %s from %s", d.Id(), d.Get("filepath").(string))
+	oldPhones, _ := d.GetChange("phones")
+	previous := buildPhoneBatchEntries(oldPhones.([]interface{}))
+	return applyPhoneBatch(ctx, d, meta, previous, d.HasChanges("site_id", "phone_base_settings_id"))
+}
+
+func deletePhoneBatch(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	pp := getPhoneProxy(sdkConfig)
+
+	var tasks []phoneBatchTask
+	for _, entry := range buildPhoneBatchEntries(d.Get("phones").([]interface{})) {
+		if entry.phoneId != "" {
+			tasks = append(tasks, phoneBatchTask{operation: phoneBatchDelete, entry: entry})
+		}
+	}
+
+	log.Printf("Deleting %d phones of phone batch %s", len(tasks), d.Id())
+	results := runPhoneBatchTasks(ctx, pp, tasks, d.Get("max_concurrency").(int), phoneBatchSettings{})
+
+	var (
+		remaining []phoneBatchEntry
+		diagErr   diag.Diagnostics
+	)
+	for _, entry := range results {
+		if entry.error != "" {
+			remaining = append(remaining, entry)
+			diagErr = append(diagErr, diag.Errorf("Failed to delete phone %s of phone batch %s: %s", entry.name, d.Id(), entry.error)...)
+		}
+	}
+	if diagErr != nil {
+		_ = d.Set("phones", flattenPhoneBatchEntries(remaining))
+		return diagErr
+	}
+
+	log.Printf("Deleted phone batch %s", d.Id())
+	return nil
+}
+
+// applyPhoneBatch makes the phones of the batch match the CSV file. Errors of single rows are returned as warnings.
+func applyPhoneBatch(ctx context.Context, d *schema.ResourceData, meta interface{}, previous []phoneBatchEntry, updateAll bool) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	pp := getPhoneProxy(sdkConfig)
+	filePath := d.Get("filepath").(string)
+
+	rows, err := readPhoneBatchFile(filePath, d.Get("name_prefix").(string))
+	if err != nil {
+		return util.BuildDiagnosticError(batchResourceName, fmt.Sprintf("Invalid phone batch file %s", filePath), err)
+	}
+
+	settings := phoneBatchSettings{
+		siteId:              d.Get("site_id").(string),
+		phoneBaseSettingsId: d.Get("phone_base_settings_id").(string),
+	}
+	phoneBase, resp, err := pp.getPhoneBaseSetting(ctx, settings.phoneBaseSettingsId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(batchResourceName, fmt.Sprintf("Failed to read phone base settings %s | error: %s", settings.phoneBaseSettingsId, err), resp)
+	}
+	if phoneBase.PhoneMetaBase != nil && phoneBase.PhoneMetaBase.Id != nil {
+		settings.phoneMetaBaseId = *phoneBase.PhoneMetaBase.Id
+	}
+	if phoneBase.Lines != nil && len(*phoneBase.Lines) > 0 {
+		settings.defaultLineBaseSettingsId = *(*phoneBase.Lines)[0].Id
+	}
+
+	tasks := planPhoneBatch(rows, previous, updateAll)
+	results := runPhoneBatchTasks(ctx, pp, tasks, d.Get("max_concurrency").(int), settings)
+
+	var (
+		entries []phoneBatchEntry
+		diags   diag.Diagnostics
+	)
+	for i, entry := range results {
+		if entry.error != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Phone %s of phone batch %s was not applied", entry.name, d.Id()),
+				Detail:   entry.error,
+			})
+		}
+		// Deleted phones leave the batch, unless the delete failed
+		if tasks[i].operation == phoneBatchDelete && entry.error == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	_ = d.Set("phones", flattenPhoneBatchEntries(entries))
+
+	log.Printf("Applied phone batch %s: %d rows, %d with errors", d.Id(), len(rows), len(diags))
+	return diags
+}
+
+// runPhoneBatchTasks runs the tasks on up to maxConcurrency workers and returns the resulting entry of each task.
+// Every delete finishes before the first create or update starts, so that a row can take the hardware ID, user or
+// extension of a phone removed from the file.
+func runPhoneBatchTasks(ctx context.Context, pp *phoneProxy, tasks []phoneBatchTask, maxConcurrency int, settings phoneBatchSettings) []phoneBatchEntry {
+	results := make([]phoneBatchEntry, len(tasks))
+	var deletes, changes []int
+	for i, task := range tasks {
+		switch task.operation {
+		case phoneBatchNone:
+			results[i] = task.entry
+		case phoneBatchDelete:
+			deletes = append(deletes, i)
+		default:
+			changes = append(changes, i)
+		}
+	}
+
+	runPhoneBatchTaskGroup(ctx, pp, tasks, deletes, results, maxConcurrency, settings)
+	runPhoneBatchTaskGroup(ctx, pp, tasks, changes, results, maxConcurrency, settings)
+	return results
+}
+
+// runPhoneBatchTaskGroup runs the tasks at the given indexes on up to maxConcurrency workers and waits for all of them.
+// Every worker but the first borrows an idle client of the SDK client pool, so that the workers do not all share the
+// rate limit of one token, and gives it back as soon as no tasks are left for it.
+func runPhoneBatchTaskGroup(ctx context.Context, pp *phoneProxy, tasks []phoneBatchTask, indexes []int, results []phoneBatchEntry, maxConcurrency int, settings phoneBatchSettings) {
+	pending := make(chan int, len(indexes))
+	for _, i := range indexes {
+		pending <- i
+	}
+	close(pending)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(maxConcurrency, len(indexes)); worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			workerProxy := pp
+			if worker > 0 {
+				clients := provider.TryAcquireClients(ctx, 1, applyPhoneBatch)
+				defer provider.ReleaseClients(clients)
+				if len(clients) > 0 {
+					workerProxy = newPhoneProxy(clients[0])
+				}
+			}
+			for i := range pending {
+				results[i] = runPhoneBatchTask(ctx, workerProxy, tasks[i], settings)
+			}
+		}(worker)
+	}
+	wg.Wait()
+}
+
+// runPhoneBatchTask creates, updates or deletes the phone of a task. Failures are recorded in the error of the entry.
+func runPhoneBatchTask(ctx context.Context, pp *phoneProxy, task phoneBatchTask, settings phoneBatchSettings) phoneBatchEntry {
+	entry := task.entry
+	entry.error = ""
+
+	if task.operation == phoneBatchDelete {
+		log.Printf("Deleting phone %s of phone batch", entry.phoneId)
+		if diagErr := deletePhoneAndWait(ctx, pp, entry.phoneId); diagErr != nil {
+			entry.error = diagErrorMessage(diagErr)
+		}
+		return entry
+	}
+
+	userId := ""
+	if entry.userEmail != "" {
+		id, _, err := pp.getUserIdByEmail(ctx, entry.userEmail)
+		if err != nil {
+			entry.error = fmt.Sprintf("failed to find user %s: %s", entry.userEmail, err)
+			return entry
+		}
+		userId = id
+	}
+
+	assignUser := userId != ""
+	if task.operation == phoneBatchCreate {
+		log.Printf("Creating phone %s of phone batch", entry.name)
+		phoneConfig := buildSdkPhoneFromBatchEntry(entry, settings, userId, "")
+		diagErr := util.RetryWhen(ctx, util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			phone, resp, err := pp.createPhone(ctx, phoneConfig)
+			if err != nil {
+				return resp, util.BuildAPIDiagnosticError(batchResourceName, fmt.Sprintf("Failed to create phone %s error: %s", entry.name, err), resp)
+			}
+			entry.phoneId = *phone.Id
+			return nil, nil
+		})
+		if diagErr != nil {
+			entry.error = diagErrorMessage(diagErr)
+			return entry
+		}
+	} else {
+		log.Printf("Updating phone %s of phone batch", entry.phoneId)
+		lineId, err := getLineIdByPhoneId(ctx, pp, entry.phoneId)
+		if err != nil {
+			log.Printf("Failed to retrieve line ID for phone %s: %v", entry.phoneId, err)
+		}
+		phoneConfig := buildSdkPhoneFromBatchEntry(entry, settings, userId, lineId)
+		if _, resp, err := pp.updatePhone(ctx, entry.phoneId, phoneConfig); err != nil {
+			entry.error = diagErrorMessage(util.BuildAPIDiagnosticError(batchResourceName, fmt.Sprintf("Failed to update phone %s error: %s", entry.name, err), resp))
+			return entry
+		}
+		// The user only needs to be assigned again if it changed or a previous attempt failed
+		assignUser = assignUser && (entry.userEmail != task.previous.userEmail || task.previous.error != "")
+	}
+
+	if assignUser {
+		if diagErr := assignUserToWebRtcPhone(ctx, pp, userId); diagErr != nil {
+			entry.error = diagErrorMessage(diagErr)
+		}
+	}
+	return entry
+}
+
+// diagErrorMessage returns the summary of the first error, which is all that is kept in the error of a row
+func diagErrorMessage(diagErr diag.Diagnostics) string {
+	for _, d := range diagErr {
+		if d.Severity == diag.Error {
+			return d.Summary
+		}
+	}
+	return fmt.Sprintf("%v", diagErr)
+}
+
+// customizePhoneBatchDiff plans an update when the batch has work left over from an earlier apply, such as a row whose
+// phone could not be created or a phone that was deleted outside of Terraform. Rows that are invalid in the file do
+// not cause a diff on their own.
+func customizePhoneBatchDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChanges("site_id", "phone_base_settings_id", "filepath", "file_content_hash", "name_prefix") {
+		return diff.SetNewComputed("phones")
+	}
+
+	rows, err := readPhoneBatchFile(diff.Get("filepath").(string), diff.Get("name_prefix").(string))
+	if err != nil {
+		// The apply reports the error
+		return nil
+	}
+	for _, task := range planPhoneBatch(rows, buildPhoneBatchEntries(diff.Get("phones").([]interface{})), false) {
+		if task.operation != phoneBatchNone {
+			return diff.SetNewComputed("phones")
+		}
+	}
+	return nil
+}
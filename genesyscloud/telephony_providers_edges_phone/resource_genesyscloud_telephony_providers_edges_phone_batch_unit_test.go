@@ -0,0 +1,228 @@
+package telephony_providers_edges_phone
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitPhoneBatchParseCsv(t *testing.T) {
+	csvContent := "hardware_id,user_email,extension,line_base_settings_id\n" +
+		"00:11:22:33:44:55,,1001,\n" +
+		",jane@example.com,,lbs-2\n" +
+		",,1003,\n" +
+		"00:11:22:33:44:55,,1004,\n" +
+		",not-an-email,,\n"
+	entries, err := parsePhoneBatchCsv(strings.NewReader(csvContent), "HQ - ")
+	assert.Nil(t, err)
+	assert.Equal(t, []phoneBatchEntry{
+		{name: "HQ - 00:11:22:33:44:55", hardwareId: "00:11:22:33:44:55", extension: "1001"},
+		{name: "HQ - jane@example.com", userEmail: "jane@example.com", lineBaseSettingsId: "lbs-2"},
+		{name: "row 3", extension: "1003", error: "row 3 has neither a hardware_id nor a user_email"},
+		{name: "HQ - 00:11:22:33:44:55", hardwareId: "00:11:22:33:44:55", extension: "1004", error: "row 4: phone name 'HQ - 00:11:22:33:44:55' is already used by row 1"},
+		{name: "HQ - not-an-email", userEmail: "not-an-email", error: "row 5: user_email 'not-an-email' is not an email address"},
+	}, entries)
+
+	entries, err = parsePhoneBatchCsv(strings.NewReader("\ufeffname,hardware_id\nLobby,AA\n"), "HQ - ")
+	assert.Nil(t, err)
+	assert.Equal(t, []phoneBatchEntry{{name: "Lobby", hardwareId: "AA"}}, entries)
+
+	// Rows may leave off the trailing columns
+	entries, err = parsePhoneBatchCsv(strings.NewReader("name,hardware_id,extension\nLobby,AA\n"), "")
+	assert.Nil(t, err)
+	assert.Equal(t, []phoneBatchEntry{{name: "Lobby", hardwareId: "AA"}}, entries)
+
+	_, err = parsePhoneBatchCsv(strings.NewReader("mac,user_email\nAA,jane@example.com\n"), "")
+	assert.ErrorContains(t, err, "column 'mac' is not one of")
+}
+
+func TestUnitPhoneBatchPlan(t *testing.T) {
+	rows := []phoneBatchEntry{
+		{name: "unchanged", hardwareId: "AA"},
+		{name: "changed", hardwareId: "BB", extension: "1002"},
+		{name: "failed", hardwareId: "CC"},
+		{name: "new", hardwareId: "DD"},
+		{name: "invalid", error: "row 5 is invalid"},
+	}
+	previous := []phoneBatchEntry{
+		{name: "unchanged", hardwareId: "AA", phoneId: "phone-1"},
+		{name: "changed", hardwareId: "BB", extension: "1001", phoneId: "phone-2"},
+		{name: "failed", hardwareId: "CC", phoneId: "phone-3", error: "failed to assign user"},
+		{name: "invalid", hardwareId: "EE", phoneId: "phone-5"},
+		{name: "removed", hardwareId: "FF", phoneId: "phone-6"},
+		{name: "never created", hardwareId: "GG", error: "failed to create"},
+	}
+
+	summary := func(tasks []phoneBatchTask) []string {
+		result := make([]string, 0, len(tasks))
+		for _, task := range tasks {
+			result = append(result, fmt.Sprintf("%d %s %s", task.operation, task.entry.name, task.entry.phoneId))
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{
+		// Removed phones come first, as they are deleted before the other changes
+		fmt.Sprintf("%d removed phone-6", phoneBatchDelete),
+		fmt.Sprintf("%d unchanged phone-1", phoneBatchNone),
+		fmt.Sprintf("%d changed phone-2", phoneBatchUpdate),
+		fmt.Sprintf("%d failed phone-3", phoneBatchUpdate),
+		fmt.Sprintf("%d new ", phoneBatchCreate),
+		// An invalid row keeps its phone
+		fmt.Sprintf("%d invalid phone-5", phoneBatchNone),
+	}, summary(planPhoneBatch(rows, previous, false)))
+
+	tasks := planPhoneBatch(rows, previous, true)
+	assert.Equal(t, phoneBatchUpdate, tasks[1].operation)
+	assert.Equal(t, "phone-1", tasks[1].previous.phoneId)
+}
+
+func TestUnitPhoneBatchDeletesFirst(t *testing.T) {
+	var (
+		mutex       sync.Mutex
+		deleted     = make(map[string]bool)
+		deletesDone []bool
+	)
+
+	pp := &phoneProxy{}
+	pp.deletePhoneAttr = func(ctx context.Context, p *phoneProxy, phoneId string) (*platformclientv2.APIResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		deleted[phoneId] = true
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	pp.getPhoneByIdAttr = func(ctx context.Context, p *phoneProxy, phoneId string) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("phone %s not found", phoneId)
+	}
+	pp.createPhoneAttr = func(ctx context.Context, p *phoneProxy, phoneConfig *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		deletesDone = append(deletesDone, deleted["phone-old-1"] && deleted["phone-old-2"])
+		id := "phone-" + *phoneConfig.Name
+		phoneConfig.Id = &id
+		return phoneConfig, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	tasks := planPhoneBatch([]phoneBatchEntry{
+		{name: "new-1", hardwareId: "AA"},
+		{name: "new-2", hardwareId: "BB"},
+		{name: "new-3", hardwareId: "CC"},
+	}, []phoneBatchEntry{
+		{name: "old-1", hardwareId: "AA", phoneId: "phone-old-1"},
+		{name: "old-2", hardwareId: "BB", phoneId: "phone-old-2"},
+	}, false)
+
+	results := runPhoneBatchTasks(context.Background(), pp, tasks, 5, phoneBatchSettings{})
+	assert.Equal(t, []bool{true, true, true}, deletesDone, "every delete should finish before the first create")
+	for _, entry := range results {
+		assert.Equal(t, "", entry.error)
+	}
+}
+
+func TestUnitPhoneBatchApply(t *testing.T) {
+	var (
+		mutex         sync.Mutex
+		createdPhones = make(map[string]*platformclientv2.Phone)
+		assignedUsers []string
+	)
+
+	pp := &phoneProxy{}
+	pp.getPhoneBaseSettingAttr = func(ctx context.Context, p *phoneProxy, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Phonebase{
+			PhoneMetaBase: &platformclientv2.Domainentityref{Id: platformclientv2.String("meta-base")},
+			Lines:         &[]platformclientv2.Linebase{{Id: platformclientv2.String("lbs-default")}},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	pp.getUserIdByEmailAttr = func(ctx context.Context, p *phoneProxy, email string) (string, *platformclientv2.APIResponse, error) {
+		if email == "jane@example.com" {
+			return "user-jane", &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		}
+		return "", &platformclientv2.APIResponse{StatusCode: http.StatusOK}, fmt.Errorf("no user found with email %s", email)
+	}
+	pp.createPhoneAttr = func(ctx context.Context, p *phoneProxy, phoneConfig *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		if *phoneConfig.Name == "Broken" {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("invalid hardware ID")
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		id := "phone-" + *phoneConfig.Name
+		phoneConfig.Id = &id
+		createdPhones[id] = phoneConfig
+		return phoneConfig, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	pp.getStationOfUserAttr = func(ctx context.Context, p *phoneProxy, userId string) (*platformclientv2.Station, bool, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Station{Id: platformclientv2.String("station-" + userId), Status: platformclientv2.String("AVAILABLE")}, false, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	pp.assignUserToStationAttr = func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		assignedUsers = append(assignedUsers, userId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	pp.assignStationAsDefaultAttr = func(ctx context.Context, p *phoneProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = pp
+	defer func() { internalProxy = nil }()
+
+	csvPath := filepath.Join(t.TempDir(), "phones.csv")
+	csvContent := "name,hardware_id,user_email,extension,line_base_settings_id\n" +
+		"Lobby,00:11:22:33:44:55,,+13175550100,\n" +
+		"Jane,,jane@example.com,,lbs-webrtc\n" +
+		"Unknown,,nobody@example.com,,\n" +
+		"Broken,zz,,,\n" +
+		"Empty,,,,\n"
+	if err := os.WriteFile(csvPath, []byte(csvContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourcePhoneBatch().Schema, map[string]interface{}{
+		"site_id":                "site-1",
+		"phone_base_settings_id": "pbs-1",
+		"filepath":               csvPath,
+		"file_content_hash":      "hash",
+		"max_concurrency":        3,
+	})
+
+	diags := createPhoneBatch(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Equal(t, false, diags.HasError(), diags)
+	assert.Len(t, diags, 3)
+	assert.NotEqual(t, "", d.Id())
+
+	entries := buildPhoneBatchEntries(d.Get("phones").([]interface{}))
+	actual := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		actual = append(actual, entry.name+"|"+entry.phoneId+"|"+entry.error)
+	}
+	assert.Equal(t, []string{
+		"Lobby|phone-Lobby|",
+		"Jane|phone-Jane|",
+		"Unknown||failed to find user nobody@example.com: no user found with email nobody@example.com",
+		"Broken||Failed to create phone Broken error: invalid hardware ID",
+		"row 5||row 5 has neither a hardware_id nor a user_email",
+	}, actual)
+
+	lobby := createdPhones["phone-Lobby"]
+	assert.Equal(t, "site-1", *lobby.Site.Id)
+	assert.Equal(t, "meta-base", *lobby.PhoneMetaBase.Id)
+	assert.Equal(t, "lbs-default", *lobby.LineBaseSettings.Id)
+	assert.Contains(t, *lobby.Properties, "phone_hardwareId")
+	assert.Contains(t, *lobby.Properties, "phone_standalone")
+	assert.Nil(t, lobby.WebRtcUser)
+
+	jane := createdPhones["phone-Jane"]
+	assert.Equal(t, "lbs-webrtc", *jane.LineBaseSettings.Id)
+	assert.Equal(t, "user-jane", *jane.WebRtcUser.Id)
+	assert.Nil(t, jane.Properties)
+	assert.Equal(t, []string{"user-jane"}, assignedUsers)
+}
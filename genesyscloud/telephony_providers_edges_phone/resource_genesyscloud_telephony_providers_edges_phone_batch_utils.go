@@ -0,0 +1,281 @@
+package telephony_providers_edges_phone
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_telephony_providers_edges_phone_batch_utils.go file contains the helpers that read the CSV
+file of a phone batch, plan the changes to its phones and build the phone of each row.
+*/
+
+var phoneBatchColumns = []string{"name", "hardware_id", "user_email", "line_base_settings_id", "extension"}
+
+// phoneBatchEntry is a row of the CSV file, or a phone of the batch in the state. Rows are matched to phones by name.
+type phoneBatchEntry struct {
+	name               string
+	hardwareId         string
+	userEmail          string
+	lineBaseSettingsId string
+	extension          string
+	phoneId            string
+	error              string
+}
+
+// sameConfig checks if two entries describe the same phone
+func (e phoneBatchEntry) sameConfig(other phoneBatchEntry) bool {
+	return e.hardwareId == other.hardwareId && e.userEmail == other.userEmail &&
+		e.lineBaseSettingsId == other.lineBaseSettingsId && e.extension == other.extension
+}
+
+type phoneBatchOperation int
+
+const (
+	phoneBatchNone phoneBatchOperation = iota
+	phoneBatchCreate
+	phoneBatchUpdate
+	phoneBatchDelete
+)
+
+type phoneBatchTask struct {
+	operation phoneBatchOperation
+	entry     phoneBatchEntry
+	// previous is the entry of the phone in the state, for updates
+	previous phoneBatchEntry
+}
+
+// phoneBatchSettings holds the settings shared by every phone of the batch
+type phoneBatchSettings struct {
+	siteId                    string
+	phoneBaseSettingsId       string
+	defaultLineBaseSettingsId string
+	phoneMetaBaseId           string
+}
+
+// readPhoneBatchFile reads the rows of a phone batch CSV file from a local path or URL
+func readPhoneBatchFile(path string, namePrefix string) ([]phoneBatchEntry, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	return parsePhoneBatchCsv(reader, namePrefix)
+}
+
+// parsePhoneBatchCsv reads a CSV file where the first row holds the column names. An invalid column fails the whole
+// file, while an invalid row only sets the error of that row.
+func parsePhoneBatchCsv(reader io.Reader, namePrefix string) ([]phoneBatchEntry, error) {
+	csvReader, header, err := files.NewCsvReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, nil
+	}
+	// Rows may leave off the trailing columns
+	csvReader.FieldsPerRecord = -1
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if !lists.ItemInSlice(header[i], phoneBatchColumns) {
+			return nil, fmt.Errorf("column '%s' is not one of %s", header[i], strings.Join(phoneBatchColumns, ", "))
+		}
+	}
+
+	var entries []phoneBatchEntry
+	rowsByName := make(map[string]int)
+	for rowNum := 1; ; rowNum++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV row %d: %v", rowNum, err)
+		}
+
+		values := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				values[column] = strings.TrimSpace(record[i])
+			}
+		}
+		entry := phoneBatchEntry{
+			name:               values["name"],
+			hardwareId:         values["hardware_id"],
+			userEmail:          values["user_email"],
+			lineBaseSettingsId: values["line_base_settings_id"],
+			extension:          values["extension"],
+		}
+		if entry.name == "" {
+			if entry.hardwareId != "" {
+				entry.name = namePrefix + entry.hardwareId
+			} else if entry.userEmail != "" {
+				entry.name = namePrefix + entry.userEmail
+			}
+		}
+
+		switch {
+		case entry.hardwareId == "" && entry.userEmail == "":
+			entry.name = fmt.Sprintf("row %d", rowNum)
+			entry.error = fmt.Sprintf("row %d has neither a hardware_id nor a user_email", rowNum)
+		case entry.userEmail != "" && !strings.Contains(entry.userEmail, "@"):
+			entry.error = fmt.Sprintf("row %d: user_email '%s' is not an email address", rowNum, entry.userEmail)
+		case rowsByName[entry.name] != 0:
+			entry.error = fmt.Sprintf("row %d: phone name '%s' is already used by row %d", rowNum, entry.name, rowsByName[entry.name])
+		default:
+			rowsByName[entry.name] = rowNum
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// planPhoneBatch compares the rows of the file with the phones of the batch in the state. Phones whose row is unchanged
+// are left alone unless updateAll is set, while phones whose last change failed are always tried again.
+func planPhoneBatch(rows []phoneBatchEntry, previous []phoneBatchEntry, updateAll bool) []phoneBatchTask {
+	previousByName := make(map[string]phoneBatchEntry, len(previous))
+	for _, entry := range previous {
+		if entry.phoneId != "" {
+			previousByName[entry.name] = entry
+		}
+	}
+
+	inFile := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		if _, exists := previousByName[row.name]; exists {
+			inFile[row.name] = true
+		}
+	}
+
+	// Phones removed from the file are planned first, as they are deleted before any phone is created or updated
+	var tasks []phoneBatchTask
+	for _, entry := range previous {
+		if entry.phoneId != "" && !inFile[entry.name] {
+			entry.error = ""
+			tasks = append(tasks, phoneBatchTask{operation: phoneBatchDelete, entry: entry})
+		}
+	}
+
+	kept := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		prev, exists := previousByName[row.name]
+		if row.error != "" {
+			// A bad row leaves the phone it names as it is
+			if exists && !kept[row.name] {
+				row.phoneId = prev.phoneId
+				kept[row.name] = true
+			}
+			tasks = append(tasks, phoneBatchTask{operation: phoneBatchNone, entry: row})
+			continue
+		}
+		kept[row.name] = true
+		if !exists {
+			tasks = append(tasks, phoneBatchTask{operation: phoneBatchCreate, entry: row})
+			continue
+		}
+		row.phoneId = prev.phoneId
+		if updateAll || prev.error != "" || !row.sameConfig(prev) {
+			tasks = append(tasks, phoneBatchTask{operation: phoneBatchUpdate, entry: row, previous: prev})
+			continue
+		}
+		tasks = append(tasks, phoneBatchTask{operation: phoneBatchNone, entry: row})
+	}
+	return tasks
+}
+
+// buildSdkPhoneFromBatchEntry builds the phone of a row. The line keeps lineId when the phone already exists.
+func buildSdkPhoneFromBatchEntry(entry phoneBatchEntry, settings phoneBatchSettings, userId string, lineId string) *platformclientv2.Phone {
+	lineBaseSettingsId := entry.lineBaseSettingsId
+	if lineBaseSettingsId == "" {
+		lineBaseSettingsId = settings.defaultLineBaseSettingsId
+	}
+	lineBaseSettings := &platformclientv2.Domainentityref{Id: &lineBaseSettingsId}
+
+	phone := &platformclientv2.Phone{
+		Name:              platformclientv2.String(entry.name),
+		State:             platformclientv2.String("active"),
+		Site:              &platformclientv2.Domainentityref{Id: &settings.siteId},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: &settings.phoneBaseSettingsId},
+		PhoneMetaBase:     &platformclientv2.Domainentityref{Id: &settings.phoneMetaBaseId},
+		LineBaseSettings:  lineBaseSettings,
+	}
+
+	properties := map[string]interface{}{}
+	if entry.hardwareId != "" {
+		properties["phone_hardwareId"] = map[string]interface{}{
+			"value": &map[string]interface{}{
+				"instance": entry.hardwareId,
+			},
+		}
+	}
+
+	if entry.extension != "" {
+		phone.Lines = createStandalonePhoneLines([]interface{}{entry.extension}, &[]platformclientv2.Line{}, lineBaseSettings)
+		properties["phone_standalone"] = map[string]interface{}{
+			"value": &map[string]interface{}{
+				"instance": true,
+			},
+		}
+	} else {
+		line := platformclientv2.Line{
+			Name:             platformclientv2.String("line_" + lineBaseSettingsId + util.GetUniqueString()),
+			LineBaseSettings: lineBaseSettings,
+		}
+		if lineId != "" {
+			line.Id = &lineId
+		}
+		phone.Lines = &[]platformclientv2.Line{line}
+	}
+
+	if len(properties) > 0 {
+		phone.Properties = &properties
+	}
+	if userId != "" {
+		phone.WebRtcUser = &platformclientv2.Domainentityref{Id: &userId}
+	}
+	return phone
+}
+
+func flattenPhoneBatchEntries(entries []phoneBatchEntry) []interface{} {
+	flattened := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		flattened = append(flattened, map[string]interface{}{
+			"name":                  entry.name,
+			"hardware_id":           entry.hardwareId,
+			"user_email":            entry.userEmail,
+			"line_base_settings_id": entry.lineBaseSettingsId,
+			"extension":             entry.extension,
+			"phone_id":              entry.phoneId,
+			"error":                 entry.error,
+		})
+	}
+	return flattened
+}
+
+func buildPhoneBatchEntries(phones []interface{}) []phoneBatchEntry {
+	entries := make([]phoneBatchEntry, 0, len(phones))
+	for _, phone := range phones {
+		phoneMap, ok := phone.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entries = append(entries, phoneBatchEntry{
+			name:               phoneMap["name"].(string),
+			hardwareId:         phoneMap["hardware_id"].(string),
+			userEmail:          phoneMap["user_email"].(string),
+			lineBaseSettingsId: phoneMap["line_base_settings_id"].(string),
+			extension:          phoneMap["extension"].(string),
+			phoneId:            phoneMap["phone_id"].(string),
+			error:              phoneMap["error"].(string),
+		})
+	}
+	return entries
+}
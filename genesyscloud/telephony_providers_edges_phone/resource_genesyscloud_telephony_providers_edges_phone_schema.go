@@ -15,17 +15,21 @@ import (
 resource_genesyscloud_telephony_providers_edges_phone_schema.go should hold four types of functions within it:
 
 1.  The registration code that registers the Datasource, Resource and Exporter for the package.
-2.  The resource schema definitions for the telephony_providers_edges_phone resource.
+2.  The resource schema definitions for the telephony_providers_edges_phone and telephony_providers_edges_phone_batch resources.
 3.  The datasource schema definitions for the telephony_providers_edges_phone datasource.
 4.  The resource exporter configuration for the telephony_providers_edges_phone exporter.
 */
-const resourceName = "genesyscloud_telephony_providers_edges_phone"
+const (
+	resourceName      = "genesyscloud_telephony_providers_edges_phone"
+	batchResourceName = "genesyscloud_telephony_providers_edges_phone_batch"
+)
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourcePhone())
 	l.RegisterResource(resourceName, ResourcePhone())
 	l.RegisterExporter(resourceName, PhoneExporter())
+	l.RegisterResource(batchResourceName, ResourcePhoneBatch())
 }
 
 // ResourcePhone registers the genesyscloud_telephony_providers_edges_phone resource with Terraform
@@ -180,6 +184,103 @@ func ResourcePhone() *schema.Resource {
 	}
 }
 
+// ResourcePhoneBatch registers the genesyscloud_telephony_providers_edges_phone_batch resource with Terraform
+func ResourcePhoneBatch() *schema.Resource {
+	batchPhone := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the phone.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hardware_id": {
+				Description: "MAC address or hardware ID of the phone.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_email": {
+				Description: "Email of the Web RTC user of the phone.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"line_base_settings_id": {
+				Description: "Line Base Settings ID of the line of the phone, if it was set in the file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"extension": {
+				Description: "Extension or DID of the line of the phone.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"phone_id": {
+				Description: "ID of the phone. Empty if the phone could not be created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"error": {
+				Description: "Error of the last change to the phone. Rows with an error are tried again on the next apply.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: `Genesys Cloud Phone Batch. Manages a set of phones of a site from a CSV file with one phone per row.
+Phones are created, updated and deleted concurrently. A row that fails is reported as a warning and in its error attribute instead of failing the apply, and it is tried again on the next apply.`,
+
+		CreateContext: provider.CreateWithPooledClient(createPhoneBatch),
+		ReadContext:   provider.ReadWithPooledClient(readPhoneBatch),
+		UpdateContext: provider.UpdateWithPooledClient(updatePhoneBatch),
+		DeleteContext: provider.DeleteWithPooledClient(deletePhoneBatch),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description: "The site ID associated to the phones.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"phone_base_settings_id": {
+				Description: "Phone Base Settings ID of the phones.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"filepath": {
+				Description: `Path or URL of the CSV file of phones. The header row names the columns, which are "hardware_id", "user_email", "line_base_settings_id", "extension" and the optional "name".
+Every row needs a hardware_id or a user_email. Rows are matched to phones by name, which defaults to the name_prefix followed by the hardware_id, or the user_email if there is no hardware_id. The line base settings default to the first line of the phone base settings. Every phone of the batch that is not in the file is deleted.`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the CSV file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name_prefix": {
+				Description: "Prefix of the default phone names.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"max_concurrency": {
+				Description:  "Maximum number of phones changed at the same time. Idle clients of the SDK client pool are used in addition to the client of the resource, so higher values need a larger token_pool_size to take effect.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"phones": {
+				Description: "The phones of the batch in the order of the file. Phones that could not be deleted follow the rows of the file.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        batchPhone,
+			},
+		},
+		CustomizeDiff: customizePhoneBatchDiff,
+	}
+}
+
 // PhoneExporter returns the resourceExporter object used to hold the genesyscloud_telephony_providers_edges_phone exporter's config
 func PhoneExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
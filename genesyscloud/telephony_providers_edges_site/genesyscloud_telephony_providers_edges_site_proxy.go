@@ -245,9 +245,9 @@ func getAllSitesFn(ctx context.Context, p *SiteProxy, managed bool) (*[]platform
 
 	// Check if the site cache is populated with all the data, if it is, return that instead
 	// If the size of the cache is the same as the total number of queues, the cache is up-to-date
-	if rc.GetCacheSize(siteCache) == *sites.Total && rc.GetCacheSize(siteCache) != 0 {
-		return rc.GetCache(siteCache), nil, nil
-	} else if rc.GetCacheSize(siteCache) != *sites.Total && rc.GetCacheSize(siteCache) != 0 {
+	if rc.GetCacheSize(ctx, siteCache) == *sites.Total && rc.GetCacheSize(ctx, siteCache) != 0 {
+		return rc.GetCache(ctx, siteCache), nil, nil
+	} else if rc.GetCacheSize(ctx, siteCache) != *sites.Total && rc.GetCacheSize(ctx, siteCache) != 0 {
 		// The cache is populated but not with the right data, clear the cache so it can be re populated
 		siteCache = rc.NewResourceCache[platformclientv2.Site]()
 	}
@@ -271,7 +271,7 @@ func getAllSitesFn(ctx context.Context, p *SiteProxy, managed bool) (*[]platform
 
 	// Populate the site cache (unmanaged site cache or managed site cache)
 	for _, site := range allSites {
-		rc.SetCache(siteCache, *site.Id, site)
+		rc.SetCache(ctx, siteCache, *site.Id, site)
 	}
 
 	return &allSites, resp, nil
@@ -301,12 +301,18 @@ func getSiteByIdFn(ctx context.Context, p *SiteProxy, siteId string) (*platformc
 	var site *platformclientv2.Site
 
 	// Query managed site cache for the site
-	site = rc.GetCacheItem(p.managedSiteCache, siteId)
+	site = rc.GetCacheItemOrHydrate(ctx, p.managedSiteCache, siteId, func(ctx context.Context) error {
+		_, _, err := getAllSitesFn(ctx, p, true)
+		return err
+	})
 	if site != nil {
 		return site, nil, nil
 	} else {
 		// Query unmanaged sites cache if not in managed site cache
-		site = rc.GetCacheItem(p.unmanagedSiteCache, siteId)
+		site = rc.GetCacheItemOrHydrate(ctx, p.unmanagedSiteCache, siteId, func(ctx context.Context) error {
+			_, _, err := getAllSitesFn(ctx, p, false)
+			return err
+		})
 		if site != nil {
 			return site, nil, nil
 		}
@@ -34,6 +34,8 @@ func getAllSites(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 		return nil, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get unmanaged sites error: %s", err), resp)
 	}
 	for _, unmanagedSite := range *unmanagedSites {
+		// No version is set as the site version does not change when number plans or outbound routes change, so
+		// incremental exports always re-read sites
 		resources[*unmanagedSite.Id] = &resourceExporter.ResourceMeta{Name: *unmanagedSite.Name}
 	}
 
@@ -46,7 +48,7 @@ func getAllSites(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 		resources[*managedSite.Id] = &resourceExporter.ResourceMeta{Name: *managedSite.Name}
 		// When exporting managed sites, they must automatically be exported as data source
 		// Managed sites are added to the ExportAsData []string in resource_exporter
-		if tfexporter_state.IsExporterActive() {
+		if tfexporter_state.IsExporterActiveContext(ctx) {
 			resourceExporter.AddDataSourceItems(resourceName, *managedSite.Name)
 		}
 	}
@@ -264,7 +266,7 @@ func updateSite(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		site.SecondarySites = util.BuildSdkDomainEntityRefArr(d, "secondary_sites")
 	}
 
-	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current site version
 		currentSite, resp, err := sp.getSiteById(ctx, d.Id())
 		if err != nil {
@@ -317,7 +319,7 @@ func deleteSite(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	// A site linked to a trunk will not be able to be deleted until that trunk is deleted. Retrying here to make sure it is cleared properly.
 	log.Printf("Deleting site %s", d.Id())
-	diagErr := util.RetryWhen(util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	diagErr := util.RetryWhen(ctx, util.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		log.Printf("Deleting site %s", d.Id())
 		resp, err := sp.deleteSite(ctx, d.Id())
 		if err != nil {
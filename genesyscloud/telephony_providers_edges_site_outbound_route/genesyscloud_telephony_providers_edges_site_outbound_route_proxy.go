@@ -128,9 +128,9 @@ func getAllSiteOutboundRoutesFn(ctx context.Context, p *siteOutboundRouteProxy,
 
 	// Check if the site cache is populated with all the data, if it is, return that instead
 	// If the size of the cache is the same as the total number of sites, the cache is up-to-date
-	if rc.GetCacheSize(p.siteOutboundRouteCache) == *outboundRoutes.Total && rc.GetCacheSize(p.siteOutboundRouteCache) != 0 {
-		return rc.GetCache(p.siteOutboundRouteCache), nil, nil
-	} else if rc.GetCacheSize(p.siteOutboundRouteCache) != *outboundRoutes.Total && rc.GetCacheSize(p.siteOutboundRouteCache) != 0 {
+	if rc.GetCacheSize(ctx, p.siteOutboundRouteCache) == *outboundRoutes.Total && rc.GetCacheSize(ctx, p.siteOutboundRouteCache) != 0 {
+		return rc.GetCache(ctx, p.siteOutboundRouteCache), nil, nil
+	} else if rc.GetCacheSize(ctx, p.siteOutboundRouteCache) != *outboundRoutes.Total && rc.GetCacheSize(ctx, p.siteOutboundRouteCache) != 0 {
 		// The cache is populated but not with the right data, clear the cache so it can be re populated
 		p.siteOutboundRouteCache = rc.NewResourceCache[platformclientv2.Outboundroutebase]()
 	}
@@ -149,7 +149,7 @@ func getAllSiteOutboundRoutesFn(ctx context.Context, p *siteOutboundRouteProxy,
 
 	// Populate the site cache
 	for _, outboundRoute := range allOutboundRoutes {
-		rc.SetCache(p.siteOutboundRouteCache, *outboundRoute.Id, outboundRoute)
+		rc.SetCache(ctx, p.siteOutboundRouteCache, *outboundRoute.Id, outboundRoute)
 	}
 
 	return &allOutboundRoutes, resp, nil
@@ -159,7 +159,7 @@ func getAllSiteOutboundRoutesFn(ctx context.Context, p *siteOutboundRouteProxy,
 // getSiteOutboundRouteByIdFn is an implementation function for getting an outbound route for a Genesys Cloud Site
 func getSiteOutboundRouteByIdFn(ctx context.Context, p *siteOutboundRouteProxy, siteId string, outboundRouteId string) (*platformclientv2.Outboundroutebase, *platformclientv2.APIResponse, error) {
 	// Check if site's outbound route exist in cache
-	route := rc.GetCacheItem(p.siteOutboundRouteCache, outboundRouteId)
+	route := rc.GetCacheItem(ctx, p.siteOutboundRouteCache, outboundRouteId)
 	if route != nil {
 		return route, nil, nil
 	}
@@ -169,7 +169,7 @@ func getSiteOutboundRouteByIdFn(ctx context.Context, p *siteOutboundRouteProxy,
 		return nil, resp, err
 	}
 
-	rc.SetCache(p.siteOutboundRouteCache, outboundRouteId, *outboundRoute)
+	rc.SetCache(ctx, p.siteOutboundRouteCache, outboundRouteId, *outboundRoute)
 
 	return outboundRoute, resp, nil
 }
@@ -218,6 +218,6 @@ func deleteSiteOutboundRouteFn(ctx context.Context, p *siteOutboundRouteProxy, s
 		return resp, err
 	}
 
-	rc.DeleteCacheItem(p.siteOutboundRouteCache, outboundRouteId)
+	rc.DeleteCacheItem(ctx, p.siteOutboundRouteCache, outboundRouteId)
 	return resp, nil
 }
@@ -0,0 +1,123 @@
+package tfexporter
+
+import (
+	"context"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	rRegistrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDependencyGraph() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source that resolves the dependencies of a set of resources in the same way as a genesyscloud_tf_export with enable_dependency_resolution, and returns the dependency graph without writing an export.
+		Edges point from a resource to the resources it depends on, so the resources affected by deleting a resource are those with a path to it.`,
+		ReadWithoutTimeout: readDependencyGraph,
+		Schema: map[string]*schema.Schema{
+			"include_filter_resources": {
+				Description: "Include only resources that match either a resource type or a resource type::regular expression. Their dependencies are resolved and included in the graph.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validators.ValidateSubStringInSlice(resourceExporter.GetAvailableExporterTypes()),
+				},
+			},
+			"log_permission_errors": {
+				Description: "Log permission/product issues rather than fail.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"dot": {
+				Description: "The dependency graph in the Graphviz DOT language. Resources are colored by type and cycles are highlighted in red.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"json": {
+				Description: "The dependency graph as JSON with the list of `nodes`, the `adjacency` list keyed by resource address and the `cycles` found.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cycles": {
+				Description: "The cycles in the graph. Each is the comma separated list of the addresses of the resources in the cycle.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func readDependencyGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if providerResources == nil {
+		providerResources, providerDataSources = rRegistrar.GetResources()
+	}
+
+	gre := &GenesysCloudResourceExporter{
+		exportAsHCL:         true,
+		logPermissionErrors: d.Get("log_permission_errors").(bool),
+		exportComputed:      true,
+		addDependsOn:        true,
+		filterType:          IncludeResources,
+		ignoreCyclicDeps:    true,
+		version:             meta.(*provider.ProviderMeta).Version,
+		provider:            provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
+		skipFileWriters:     true,
+		d:                   d,
+		ctx:                 tfexporter_state.WithExporterState(ctx),
+		meta:                meta,
+	}
+	gre.setupDataSource()
+	configureExporterType(ctx, d, gre, IncludeResources)
+
+	id := strings.Join(*gre.filterList, ",")
+	if diagErr := gre.resolveDependencies(); diagErr != nil {
+		return diagErr
+	}
+
+	graph := buildDependencyGraph(gre.resources, gre.dependsList)
+	graphJson, err := graph.toJson()
+	if err != nil {
+		return diag.Errorf("Failed to build dependency graph: %v", err)
+	}
+	cycles := make([]string, 0, len(graph.Cycles))
+	for _, cycle := range graph.Cycles {
+		cycles = append(cycles, strings.Join(cycle, ","))
+	}
+
+	log.Printf("Dependency graph has %d resources and %d cycles", len(graph.Nodes), len(cycles))
+	d.SetId(id)
+	_ = d.Set("dot", graph.toDot())
+	_ = d.Set("json", graphJson)
+	_ = d.Set("cycles", cycles)
+	return nil
+}
+
+// resolveDependencies runs the steps of an export that read the org and resolve the dependencies of the resources,
+// without writing any output
+func (g *GenesysCloudResourceExporter) resolveDependencies() diag.Diagnostics {
+	if diagErr := g.retrieveExporters(); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := g.retrieveSanitizedResourceMaps(); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := g.retrieveGenesysCloudObjectInstances(); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := g.buildAndExportDependsOnResourcesForFlows(); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := g.buildResourceConfigMap(); diagErr != nil {
+		return diagErr
+	}
+	return g.buildAndExportDependentResources()
+}
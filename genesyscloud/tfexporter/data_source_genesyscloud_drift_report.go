@@ -0,0 +1,167 @@
+package tfexporter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	rRegistrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDriftReport() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source that compares the live configuration of the org with a directory of config previously exported by genesyscloud_tf_export.
+		Resources are matched by their exported address. The report lists changed attributes, resources that exist in the org but not in code, and resources in code that no longer exist in the org.`,
+		ReadWithoutTimeout: readDriftReport,
+		Schema: map[string]*schema.Schema{
+			"directory": {
+				Description: "Directory containing the previously exported HCL or JSON config.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"include_filter_resources": {
+				Description: "Include only resources that match either a resource type or a resource type::regular expression. Defaults to all exportable types.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validators.ValidateSubStringInSlice(resourceExporter.GetAvailableExporterTypes()),
+				},
+			},
+			"format": {
+				Description:  fmt.Sprintf("Format of the report. Valid values: %s, %s.", driftReportFormatJSON, driftReportFormatMarkdown),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      driftReportFormatJSON,
+				ValidateFunc: validation.StringInSlice([]string{driftReportFormatJSON, driftReportFormatMarkdown}, false),
+			},
+			"export_computed": {
+				Description: "Compare attributes that are marked as being Computed. Must match the export_computed setting of the export.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"log_permission_errors": {
+				Description: "Log permission/product issues rather than fail.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"report": {
+				Description: "The drift report.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"has_drift": {
+				Description: "True if any resource differs between the exported config and the org.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readDriftReport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The exporter state is not activated as it is process wide and would affect every later read in this provider process.
+	// The exporter reads the org in export mode through the context of its reads instead, so both sides match.
+	directory := d.Get("directory").(string)
+	code, variables, isJSON, diagErr := readExportedConfig(directory)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Files written by custom exporters (e.g. prompt audio) go to a temporary directory so the exported config is not modified
+	scratchDir, err := os.MkdirTemp("", "genesyscloud_drift_report")
+	if err != nil {
+		return diag.Errorf("Failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(scratchDir)
+
+	if providerResources == nil {
+		providerResources, providerDataSources = rRegistrar.GetResources()
+	}
+
+	// Unresolved IDs are kept in the config when the export included a state file or import blocks
+	exportedWithState := fileExists(filepath.Join(directory, defaultTfStateFile)) || fileExists(filepath.Join(directory, defaultTfHCLImportsFile)) || fileExists(filepath.Join(directory, defaultTfJSONImportsFile))
+
+	gre := &GenesysCloudResourceExporter{
+		exportAsHCL:         !isJSON,
+		logPermissionErrors: d.Get("log_permission_errors").(bool),
+		exportComputed:      d.Get("export_computed").(bool),
+		filterType:          IncludeResources,
+		includeStateFile:    exportedWithState,
+		ignoreCyclicDeps:    true,
+		version:             meta.(*provider.ProviderMeta).Version,
+		provider:            provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
+		exportDirPath:       scratchDir,
+		d:                   d,
+		ctx:                 ctx,
+		meta:                meta,
+	}
+	gre.setupDataSource()
+	configureExporterType(ctx, d, gre, IncludeResources)
+
+	if diagErr := gre.retrieveLiveConfig(); diagErr != nil {
+		return diagErr
+	}
+
+	org, err := flattenLiveConfig(gre.resourceTypesMaps, gre.dataSourceTypesMaps, isJSON)
+	if err != nil {
+		return diag.Errorf("Failed to flatten live config: %v", err)
+	}
+
+	// Only compare the resource types that were read from the org. Data sources the export added for dependencies, such as
+	// the home division, are kept when the org side has them too.
+	for address := range code {
+		resType, _, _ := strings.Cut(strings.TrimPrefix(address, "data."), ".")
+		if _, ok := (*gre.exporters)[resType]; !ok {
+			if _, inOrg := org[address]; !inOrg {
+				delete(code, address)
+			}
+		}
+	}
+
+	// Both sides use the same variables, e.g. for unresolved attributes, so they are resolved the same way
+	variables.resolve(code)
+	variables.resolve(org)
+
+	report := compareConfigs(code, org)
+	reportStr, err := report.format(d.Get("format").(string))
+	if err != nil {
+		return diag.Errorf("Failed to format drift report: %v", err)
+	}
+
+	log.Printf("Drift report for %s: %d changed, %d not in code, %d not in the org", directory, len(report.Changed), len(report.Unmanaged), len(report.Missing))
+	d.SetId(directory)
+	_ = d.Set("report", reportStr)
+	_ = d.Set("has_drift", report.hasDrift())
+	return nil
+}
+
+// retrieveLiveConfig runs the steps of an export that read the org and build the config, without writing any output
+func (g *GenesysCloudResourceExporter) retrieveLiveConfig() diag.Diagnostics {
+	if diagErr := g.retrieveExporters(); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := g.retrieveSanitizedResourceMaps(); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := g.retrieveGenesysCloudObjectInstances(); diagErr != nil {
+		return diagErr
+	}
+	return g.buildResourceConfigMap()
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
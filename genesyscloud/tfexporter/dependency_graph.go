@@ -0,0 +1,213 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+)
+
+/*
+The dependency graph is built from the dependencies the exporter resolves when enable_dependency_resolution is on. Each
+resource is a node addressed as <type>.<label>, and each edge points from a resource to a resource it depends on, so
+deleting a resource affects every node with a path to it. Cycles are found from the edges themselves, as the resources in
+a strongly connected component with more than one node (or with an edge to itself).
+*/
+
+const (
+	dependencyGraphDotFile  = "dependency_graph.dot"
+	dependencyGraphJsonFile = "dependency_graph.json"
+)
+
+// Colors of the nodes in the DOT graph. Each resource type is given one based on a hash of its name.
+var dependencyGraphColors = []string{
+	"#8dd3c7", "#ffffb3", "#bebada", "#80b1d3", "#fdb462", "#b3de69",
+	"#fccde5", "#d9d9d9", "#bc80bd", "#ccebc5", "#ffed6f", "#a6cee3",
+}
+
+type dependencyGraphNode struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	Id      string `json:"id"`
+	Cyclic  bool   `json:"cyclic"`
+}
+
+type dependencyGraph struct {
+	Nodes     []*dependencyGraphNode `json:"nodes"`
+	Adjacency map[string][]string    `json:"adjacency"`
+	Cycles    [][]string             `json:"cycles"`
+}
+
+// buildDependencyGraph builds the graph of the exported resources from the depends list of the exporter, which maps
+// the ID of a resource to the "<type>.<id>" of each resource it depends on. Nodes are keyed by "<type>.<id>", as
+// resources of different types can share an ID (e.g. genesyscloud_user and genesyscloud_user_roles).
+func buildDependencyGraph(resources []resourceExporter.ResourceInfo, dependsList map[string][]string) *dependencyGraph {
+	graph := &dependencyGraph{Adjacency: make(map[string][]string), Cycles: make([][]string, 0)}
+	nodesByKey := make(map[string]*dependencyGraphNode)
+	exportedById := make(map[string][]*dependencyGraphNode)
+
+	addNode := func(resType, id, label string) *dependencyGraphNode {
+		key := resType + "." + id
+		if node, ok := nodesByKey[key]; ok {
+			return node
+		}
+		node := &dependencyGraphNode{Address: resType + "." + label, Type: resType, Id: id}
+		nodesByKey[key] = node
+		graph.Nodes = append(graph.Nodes, node)
+		graph.Adjacency[node.Address] = make([]string, 0)
+		return node
+	}
+
+	for _, resource := range resources {
+		if resource.State != nil {
+			node := addNode(resource.Type, resource.State.ID, resource.Name)
+			exportedById[node.Id] = append(exportedById[node.Id], node)
+		}
+	}
+
+	// The depends list is keyed by ID alone, so its dependencies apply to every exported resource with the ID, as they do
+	// for depends_on. Dependencies that were not exported are still shown, addressed by their ID.
+	ids := make([]string, 0, len(dependsList))
+	for id := range dependsList {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		for _, from := range exportedById[id] {
+			for _, dependency := range dependsList[id] {
+				depType, depId, found := strings.Cut(dependency, ".")
+				if !found {
+					continue
+				}
+				to := addNode(depType, depId, depId)
+				if !util.StringExists(to.Address, graph.Adjacency[from.Address]) {
+					graph.Adjacency[from.Address] = append(graph.Adjacency[from.Address], to.Address)
+				}
+			}
+		}
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].Address < graph.Nodes[j].Address })
+	for address := range graph.Adjacency {
+		sort.Strings(graph.Adjacency[address])
+	}
+	graph.markCycles()
+	return graph
+}
+
+// markCycles finds the strongly connected components of the graph with Tarjan's algorithm and records each one that
+// forms a cycle
+func (g *dependencyGraph) markCycles() {
+	index := 0
+	indices := make(map[string]int)
+	lowLinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	stack := make([]string, 0)
+	nodes := make(map[string]*dependencyGraphNode)
+	for _, node := range g.Nodes {
+		nodes[node.Address] = node
+	}
+
+	var connect func(address string)
+	connect = func(address string) {
+		indices[address] = index
+		lowLinks[address] = index
+		index++
+		stack = append(stack, address)
+		onStack[address] = true
+
+		for _, next := range g.Adjacency[address] {
+			if _, visited := indices[next]; !visited {
+				connect(next)
+				lowLinks[address] = min(lowLinks[address], lowLinks[next])
+			} else if onStack[next] {
+				lowLinks[address] = min(lowLinks[address], indices[next])
+			}
+		}
+
+		if lowLinks[address] != indices[address] {
+			return
+		}
+		component := make([]string, 0)
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == address {
+				break
+			}
+		}
+		if len(component) > 1 || util.StringExists(address, g.Adjacency[address]) {
+			sort.Strings(component)
+			for _, member := range component {
+				nodes[member].Cyclic = true
+			}
+			g.Cycles = append(g.Cycles, component)
+		}
+	}
+
+	for _, node := range g.Nodes {
+		if _, visited := indices[node.Address]; !visited {
+			connect(node.Address)
+		}
+	}
+	sort.Slice(g.Cycles, func(i, j int) bool { return g.Cycles[i][0] < g.Cycles[j][0] })
+}
+
+// isCyclicEdge reports whether an edge is part of a cycle, which is when both ends are in the same cycle
+func (g *dependencyGraph) isCyclicEdge(from, to string) bool {
+	for _, cycle := range g.Cycles {
+		if util.StringExists(from, cycle) && util.StringExists(to, cycle) {
+			return true
+		}
+	}
+	return false
+}
+
+// toDot renders the graph in the Graphviz DOT language. Nodes are filled with the color of their resource type, and
+// the nodes and edges of cycles are drawn in red.
+func (g *dependencyGraph) toDot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph genesyscloud {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+
+	for _, node := range g.Nodes {
+		attrs := fmt.Sprintf("fillcolor=%q, tooltip=%q", dependencyGraphColor(node.Type), node.Id)
+		if node.Cyclic {
+			attrs += ", color=\"red\", penwidth=2"
+		}
+		sb.WriteString(fmt.Sprintf("  %q [%s];\n", node.Address, attrs))
+	}
+	for _, node := range g.Nodes {
+		for _, to := range g.Adjacency[node.Address] {
+			if g.isCyclicEdge(node.Address, to) {
+				sb.WriteString(fmt.Sprintf("  %q -> %q [color=\"red\", penwidth=2];\n", node.Address, to))
+			} else {
+				sb.WriteString(fmt.Sprintf("  %q -> %q;\n", node.Address, to))
+			}
+		}
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// toJson renders the graph as a list of nodes and an adjacency list keyed by resource address
+func (g *dependencyGraph) toJson() (string, error) {
+	graphJson, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(graphJson), nil
+}
+
+func dependencyGraphColor(resType string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(resType))
+	return dependencyGraphColors[h.Sum32()%uint32(len(dependencyGraphColors))]
+}
@@ -0,0 +1,447 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains all of the logic used to compare the live configuration of an org with the config of a previous export.
+Both sides are flattened into a map of attribute paths to normalized values so that HCL and JSON exports can be compared the same way.
+*/
+
+const (
+	driftReportFormatJSON     = "json"
+	driftReportFormatMarkdown = "markdown"
+)
+
+// Attributes that depend on the export settings rather than the configuration of an object
+var driftIgnoredAttributes = []string{"depends_on"}
+
+// "${var.name}" in an attribute value
+var driftVariableRegex = regexp.MustCompile(`\$\{var\.([A-Za-z0-9_-]+)\}`)
+
+// flattenedResources maps a resource address to its flattened attributes
+type flattenedResources map[string]map[string]string
+
+// DriftReport lists the differences between the exported config and the live org
+type DriftReport struct {
+	Changed   []ResourceDrift `json:"changed"`
+	Unmanaged []string        `json:"unmanaged"`
+	Missing   []string        `json:"missing"`
+}
+
+// ResourceDrift lists the attributes of a resource that differ between the exported config and the live org
+type ResourceDrift struct {
+	Address    string           `json:"address"`
+	Attributes []AttributeDrift `json:"attributes"`
+}
+
+// AttributeDrift is a single attribute difference. A nil value means the attribute is not set on that side.
+type AttributeDrift struct {
+	Attribute string  `json:"attribute"`
+	Code      *string `json:"code"`
+	Org       *string `json:"org"`
+}
+
+func (r *DriftReport) hasDrift() bool {
+	return len(r.Changed) > 0 || len(r.Unmanaged) > 0 || len(r.Missing) > 0
+}
+
+// exportedVariables holds what the variables of an export resolve to. Values are taken from the tfvars files that terraform
+// loads automatically and references are the resource attributes that child modules receive through module outputs.
+type exportedVariables struct {
+	values     map[string]string
+	references map[string]string
+}
+
+// readExportedConfig reads the resources, data sources and variables of all .tf, .tf.json and auto loaded tfvars files in a
+// directory and its sub directories
+func readExportedConfig(dirPath string) (flattenedResources, *exportedVariables, bool, diag.Diagnostics) {
+	resources := make(flattenedResources)
+	variables := &exportedVariables{values: make(map[string]string), references: make(map[string]string)}
+	isJSON := false
+
+	err := filepath.WalkDir(dirPath, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), ".") && path != dirPath {
+			return filepath.SkipDir
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		isRootFile := filepath.Dir(path) == filepath.Clean(dirPath)
+		switch {
+		case strings.HasSuffix(path, "."+resourceJSONFileExt):
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			found, err := flattenJSONConfig(data, resources)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s: %v", path, err)
+			}
+			isJSON = isJSON || found
+		case strings.HasSuffix(path, "."+resourceHCLFileExt):
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if err := flattenHCLConfig(data, path, resources, variables); err != nil {
+				return fmt.Errorf("failed to parse %s: %v", path, err)
+			}
+		case isRootFile && isAutoLoadedTfVarsFile(entry.Name()):
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if err := variables.readTfVars(data, path); err != nil {
+				return fmt.Errorf("failed to parse %s: %v", path, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, false, diag.Errorf("Failed to read exported config in %s: %v", dirPath, err)
+	}
+	return resources, variables, isJSON, nil
+}
+
+func isAutoLoadedTfVarsFile(name string) bool {
+	return name == defaultTfVarsFile || name == defaultTfVarsFile+".json" || strings.HasSuffix(name, ".auto.tfvars") || strings.HasSuffix(name, ".auto.tfvars.json")
+}
+
+// readTfVars adds the values of a .tfvars or .tfvars.json file in the same normalized form as attribute values
+func (v *exportedVariables) readTfVars(data []byte, filename string) error {
+	if strings.HasSuffix(filename, ".json") {
+		var values map[string]interface{}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		for name, value := range values {
+			encoded, _ := json.Marshal(value)
+			v.values[name] = string(encoded)
+		}
+		return nil
+	}
+
+	file, diags := hclsyntax.ParseConfig(data, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return fmt.Errorf("unexpected body type %T", file.Body)
+	}
+	for name, attr := range body.Attributes {
+		v.values[name] = normalizedExpression(attr.Expr, data)
+	}
+	return nil
+}
+
+// resolve replaces variables in attribute values. A value that is only a variable with a tfvars value is replaced with the
+// value and variables that are bound to module outputs are replaced with the referenced resource attribute.
+func (v *exportedVariables) resolve(resources flattenedResources) {
+	if v == nil {
+		return
+	}
+	for _, attributes := range resources {
+		for path, value := range attributes {
+			if match := driftVariableRegex.FindStringSubmatch(value); match != nil && value == `"`+match[0]+`"` {
+				if literal, ok := v.values[match[1]]; ok {
+					attributes[path] = literal
+					continue
+				}
+			}
+			attributes[path] = driftVariableRegex.ReplaceAllStringFunc(value, func(reference string) string {
+				if target, ok := v.references[driftVariableRegex.FindStringSubmatch(reference)[1]]; ok {
+					return "${" + target + "}"
+				}
+				return reference
+			})
+		}
+	}
+}
+
+// flattenJSONConfig adds the resources and data sources of a .tf.json file and reports whether there were any
+func flattenJSONConfig(data []byte, resources flattenedResources) (bool, error) {
+	var config struct {
+		Resource map[string]map[string]map[string]interface{} `json:"resource"`
+		Data     map[string]map[string]map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return false, err
+	}
+
+	add := func(prefix string, blocks map[string]map[string]map[string]interface{}) {
+		for resType, resourcesOfType := range blocks {
+			for resName, attributes := range resourcesOfType {
+				flattened := make(map[string]string)
+				flattenJSONValue("", attributes, flattened)
+				resources[prefix+resType+"."+resName] = flattened
+			}
+		}
+	}
+	add("", config.Resource)
+	add("data.", config.Data)
+	return len(config.Resource) > 0 || len(config.Data) > 0, nil
+}
+
+func flattenJSONValue(path string, value interface{}, flattened map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			flattenJSONValue(joinAttributePath(path, key), nested, flattened)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			flattenJSONValue(joinAttributePath(path, strconv.Itoa(i)), nested, flattened)
+		}
+		if len(v) == 0 {
+			flattened[path] = "[]"
+		}
+	case nil:
+	default:
+		encoded, _ := json.Marshal(v)
+		flattened[path] = string(encoded)
+	}
+}
+
+// flattenHCLConfig adds the resources and data sources of a .tf file. Attribute values are compared by their whitespace
+// normalized expression. The module outputs of the file are added to variables when it is not nil.
+func flattenHCLConfig(data []byte, filename string, resources flattenedResources, variables *exportedVariables) error {
+	file, diags := hclsyntax.ParseConfig(data, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return fmt.Errorf("unexpected body type %T", file.Body)
+	}
+	for _, block := range body.Blocks {
+		switch {
+		case block.Type == "resource" && len(block.Labels) == 2:
+			flattened := make(map[string]string)
+			flattenHCLBody("", block.Body, data, flattened)
+			resources[block.Labels[0]+"."+block.Labels[1]] = flattened
+		case block.Type == "data" && len(block.Labels) == 2:
+			flattened := make(map[string]string)
+			flattenHCLBody("", block.Body, data, flattened)
+			resources["data."+block.Labels[0]+"."+block.Labels[1]] = flattened
+		case block.Type == "output" && len(block.Labels) == 1 && variables != nil:
+			// Outputs are named after the variable that receives them in the referencing module
+			if value, ok := block.Body.Attributes["value"]; ok {
+				variables.references[block.Labels[0]] = normalizedExpression(value.Expr, data)
+			}
+		}
+	}
+	return nil
+}
+
+func flattenHCLBody(path string, body *hclsyntax.Body, src []byte, flattened map[string]string) {
+	for name, attr := range body.Attributes {
+		flattened[joinAttributePath(path, name)] = normalizedExpression(attr.Expr, src)
+	}
+
+	blockIndexes := make(map[string]int)
+	for _, block := range body.Blocks {
+		index := blockIndexes[block.Type]
+		blockIndexes[block.Type]++
+		flattenHCLBody(joinAttributePath(path, fmt.Sprintf("%s.%d", block.Type, index)), block.Body, src, flattened)
+	}
+}
+
+func normalizedExpression(expr hclsyntax.Expression, src []byte) string {
+	return strings.Join(strings.Fields(string(expr.Range().SliceBytes(src))), " ")
+}
+
+func joinAttributePath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// flattenLiveConfig renders the live config the same way it is exported and flattens it
+func flattenLiveConfig(resourceTypesMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, asJSON bool) (flattenedResources, error) {
+	resources := make(flattenedResources)
+	add := func(typesMaps map[string]resourceJSONMaps, isDataSource bool) error {
+		blockType := "resource"
+		if isDataSource {
+			blockType = "data"
+		}
+		for resType, resourcesOfType := range typesMaps {
+			for resName, config := range resourcesOfType {
+				address := resType + "." + resName
+				if asJSON {
+					data, err := json.Marshal(map[string]interface{}{
+						blockType: map[string]interface{}{resType: map[string]interface{}{resName: config}},
+					})
+					if err != nil {
+						return err
+					}
+					if _, err := flattenJSONConfig(postProcessJsonBytes(data), resources); err != nil {
+						return fmt.Errorf("failed to flatten %s: %v", address, err)
+					}
+					continue
+				}
+
+				block := postProcessHclBytes(instanceStateToHCLBlock(resType, resName, config, isDataSource))
+				if err := flattenHCLConfig(block, address, resources, nil); err != nil {
+					return fmt.Errorf("failed to flatten %s: %v", address, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := add(resourceTypesMaps, false); err != nil {
+		return nil, err
+	}
+	if err := add(dataSourceTypesMaps, true); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// compareConfigs builds the drift report between the exported config (code) and the live org config (org)
+func compareConfigs(code flattenedResources, org flattenedResources) *DriftReport {
+	report := &DriftReport{
+		Changed:   make([]ResourceDrift, 0),
+		Unmanaged: make([]string, 0),
+		Missing:   make([]string, 0),
+	}
+
+	for _, address := range sortedKeys(org) {
+		codeAttributes, ok := code[address]
+		if !ok && isReplacedWithDataSource(address, code) {
+			continue
+		}
+		if !ok {
+			report.Unmanaged = append(report.Unmanaged, address)
+			continue
+		}
+		if attributes := compareAttributes(codeAttributes, org[address]); len(attributes) > 0 {
+			report.Changed = append(report.Changed, ResourceDrift{Address: address, Attributes: attributes})
+		}
+	}
+	for _, address := range sortedKeys(code) {
+		if _, ok := org[address]; !ok && !isReplacedWithDataSource(address, org) {
+			report.Missing = append(report.Missing, address)
+		}
+	}
+	return report
+}
+
+// isReplacedWithDataSource checks if the resource at an address is a data source on the other side or the other way round,
+// as happens when an export replaces resources with data sources. Their attributes are not compared.
+func isReplacedWithDataSource(address string, other flattenedResources) bool {
+	if resAddress, isData := strings.CutPrefix(address, "data."); isData {
+		_, ok := other[resAddress]
+		return ok
+	}
+	_, ok := other["data."+address]
+	return ok
+}
+
+func compareAttributes(code map[string]string, org map[string]string) []AttributeDrift {
+	paths := make(map[string]bool)
+	for path := range code {
+		paths[path] = true
+	}
+	for path := range org {
+		paths[path] = true
+	}
+
+	attributes := make([]AttributeDrift, 0)
+	for _, path := range sortedKeys(paths) {
+		if isDriftIgnoredAttribute(path) {
+			continue
+		}
+		codeValue, inCode := code[path]
+		orgValue, inOrg := org[path]
+		if inCode && inOrg && codeValue == orgValue {
+			continue
+		}
+
+		drift := AttributeDrift{Attribute: path}
+		if inCode {
+			drift.Code = &codeValue
+		}
+		if inOrg {
+			drift.Org = &orgValue
+		}
+		attributes = append(attributes, drift)
+	}
+	return attributes
+}
+
+func isDriftIgnoredAttribute(path string) bool {
+	for _, attr := range driftIgnoredAttributes {
+		if path == attr || strings.HasPrefix(path, attr+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *DriftReport) format(format string) (string, error) {
+	if format == driftReportFormatMarkdown {
+		return r.toMarkdown(), nil
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (r *DriftReport) toMarkdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Drift report\n\n")
+
+	sb.WriteString(fmt.Sprintf("## Changed resources (%d)\n\n", len(r.Changed)))
+	for _, resource := range r.Changed {
+		sb.WriteString(fmt.Sprintf("### `%s`\n\n", resource.Address))
+		sb.WriteString("| Attribute | Code | Org |\n|---|---|---|\n")
+		for _, attr := range resource.Attributes {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", escapeMarkdownCell(attr.Attribute), markdownValue(attr.Code), markdownValue(attr.Org)))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("## Resources not in code (%d)\n\n", len(r.Unmanaged)))
+	for _, address := range r.Unmanaged {
+		sb.WriteString(fmt.Sprintf("- `%s`\n", address))
+	}
+
+	sb.WriteString(fmt.Sprintf("\n## Resources not in the org (%d)\n\n", len(r.Missing)))
+	for _, address := range r.Missing {
+		sb.WriteString(fmt.Sprintf("- `%s`\n", address))
+	}
+	return sb.String()
+}
+
+func markdownValue(value *string) string {
+	if value == nil {
+		return "_not set_"
+	}
+	return "`" + escapeMarkdownCell(*value) + "`"
+}
+
+func escapeMarkdownCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
@@ -0,0 +1,362 @@
+package tfexporter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	dependentconsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+A closure export starts from a list of seed resources and exports them together with the resources they need and the
+resources that use them. Dependencies are found from the RefAttrs of each exporter and from the resources a flow
+consumes. Dependents are found from Architect dependency tracking and by looking for resources whose RefAttrs reference
+the resource.
+
+Dependents are only followed from the seeds and from other dependents, so that exporting a queue exports the flows that
+transfer to it and everything those flows need, but not every queue that shares a member with it. Resources that match
+replace_with_datasource are exported as data sources and are not walked any further.
+*/
+
+const (
+	closureDirectionBoth         = "both"
+	closureDirectionDependencies = "dependencies"
+	closureDirectionDependents   = "dependents"
+)
+
+// Matches the collection indexes in the key of a flattened state attribute, e.g. the .0 in members.0.user_id
+var stateIndexRegex = regexp.MustCompile(`\.\d+(\.|$)`)
+
+type closureNode struct {
+	ResourceType string
+	Id           string
+}
+
+func (n closureNode) filter() string {
+	return n.ResourceType + "::" + n.Id
+}
+
+// closureItem is a resource to walk. Dependents are only walked when followDependents is set.
+type closureItem struct {
+	node             closureNode
+	followDependents bool
+}
+
+type closureEdges struct {
+	dependencies []closureNode
+	dependents   []closureNode
+}
+
+// closureExpandFunc returns the dependencies and dependents of each resource in a level of the walk
+type closureExpandFunc func(level []closureItem) (map[closureNode]closureEdges, diag.Diagnostics)
+
+// walkExportClosure walks breadth first from the seeds and returns every resource reached in at most maxDepth steps
+func walkExportClosure(seeds []closureNode, maxDepth int, expand closureExpandFunc) ([]closureNode, diag.Diagnostics) {
+	// Whether each resource found has had its dependents walked
+	visited := make(map[closureNode]bool)
+	level := make([]closureItem, 0, len(seeds))
+	for _, seed := range seeds {
+		if _, ok := visited[seed]; !ok {
+			visited[seed] = true
+			level = append(level, closureItem{node: seed, followDependents: true})
+		}
+	}
+
+	for depth := 0; depth < maxDepth && len(level) > 0; depth++ {
+		edges, diagErr := expand(level)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+
+		next := make([]closureItem, 0)
+		for _, item := range level {
+			for _, dependency := range edges[item.node].dependencies {
+				if _, ok := visited[dependency]; !ok {
+					visited[dependency] = false
+					next = append(next, closureItem{node: dependency})
+				}
+			}
+			if !item.followDependents {
+				continue
+			}
+			for _, dependent := range edges[item.node].dependents {
+				// A resource first reached as a dependency is walked again to follow its dependents
+				if followed, ok := visited[dependent]; !ok || !followed {
+					visited[dependent] = true
+					next = append(next, closureItem{node: dependent, followDependents: true})
+				}
+			}
+		}
+		level = next
+	}
+
+	closure := make([]closureNode, 0, len(visited))
+	for node := range visited {
+		closure = append(closure, node)
+	}
+	sort.Slice(closure, func(i, j int) bool { return closure[i].filter() < closure[j].filter() })
+	return closure, nil
+}
+
+// buildExportClosure walks from the seed resources and replaces the filter list with every resource in the closure
+func (g *GenesysCloudResourceExporter) buildExportClosure() diag.Diagnostics {
+	seeds := make([]closureNode, 0, len(*g.filterList))
+	for _, seed := range *g.filterList {
+		resType, id, found := strings.Cut(seed, "::")
+		if !found || id == "" {
+			return diag.Errorf("closure seed '%s' must be in the form <resource type>::<id>", seed)
+		}
+		seeds = append(seeds, closureNode{ResourceType: resType, Id: id})
+	}
+
+	direction := g.d.Get("closure_direction").(string)
+	maxDepth := g.d.Get("closure_depth").(int)
+	isSeed := make(map[closureNode]bool)
+	for _, seed := range seeds {
+		isSeed[seed] = true
+	}
+	// The resources of each type, loaded once to find resources whose RefAttrs reference a dependent
+	referencingResources := make(map[string][]resourceExporter.ResourceInfo)
+
+	expand := func(level []closureItem) (map[closureNode]closureEdges, diag.Diagnostics) {
+		nodes := make([]closureNode, 0, len(level))
+		followDependents := make(map[closureNode]bool)
+		for _, item := range level {
+			nodes = append(nodes, item.node)
+			followDependents[item.node] = item.followDependents && direction != closureDirectionDependencies
+		}
+
+		resources, diagErr := g.loadClosureResources(nodes)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+
+		edges := make(map[closureNode]closureEdges)
+		for _, resource := range resources {
+			node := closureNode{ResourceType: resource.Type, Id: resource.State.ID}
+			if !isSeed[node] && g.isDataSource(resource.Type, resource.Name) {
+				log.Printf("Not walking %s %s as it is replaced with a data source", resource.Type, resource.Name)
+				continue
+			}
+
+			var nodeEdges closureEdges
+			if direction != closureDirectionDependents {
+				nodeEdges.dependencies, diagErr = g.closureDependencies(resource)
+				if diagErr != nil {
+					return nil, diagErr
+				}
+			}
+			if followDependents[node] {
+				nodeEdges.dependents, diagErr = g.closureDependents(resource, referencingResources)
+				if diagErr != nil {
+					return nil, diagErr
+				}
+			}
+			edges[node] = nodeEdges
+		}
+		return edges, nil
+	}
+
+	closure, diagErr := walkExportClosure(seeds, maxDepth, expand)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	filterList := make([]string, 0, len(closure))
+	for _, node := range closure {
+		filterList = append(filterList, node.filter())
+	}
+	log.Printf("Closure of %d seed resources has %d resources", len(seeds), len(filterList))
+	g.filterList = &filterList
+	g.resources = nil
+	return nil
+}
+
+// loadClosureResources reads the state of resources. A node with no ID loads every resource of its type.
+func (g *GenesysCloudResourceExporter) loadClosureResources(nodes []closureNode) ([]resourceExporter.ResourceInfo, diag.Diagnostics) {
+	filterList := make([]string, 0, len(nodes))
+	idFilters := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Id == "" {
+			filterList = append(filterList, node.ResourceType)
+			continue
+		}
+		filterList = append(filterList, node.filter())
+		idFilters = append(idFilters, node.filter())
+	}
+
+	g.filterList = &filterList
+	g.resources = nil
+	if diagErr := g.retrieveExporters(); diagErr != nil {
+		return nil, diagErr
+	}
+	if diagErr := g.buildSanitizedResourceMaps(*g.exporters, idFilters, g.logPermissionErrors); diagErr != nil {
+		return nil, diagErr
+	}
+	if diagErr := g.retrieveGenesysCloudObjectInstances(); diagErr != nil {
+		return nil, diagErr
+	}
+	return g.copyResource(), nil
+}
+
+// closureDependencies returns the resources referenced by the RefAttrs of a resource and, for a flow, the resources
+// its published version consumes
+func (g *GenesysCloudResourceExporter) closureDependencies(resource resourceExporter.ResourceInfo) ([]closureNode, diag.Diagnostics) {
+	exporter := resourceExporter.GetResourceExporters()[resource.Type]
+	dependencies := make([]closureNode, 0)
+	if exporter != nil {
+		dependencies = append(dependencies, referencedResources(resource.State, exporter.RefAttrs)...)
+	}
+
+	if resource.Type == "genesyscloud_flow" {
+		proxy := dependentconsumers.GetDependentConsumerProxy(nil)
+		consumed, _, diagErr := proxy.GetAllWithPooledClient(func(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+			proxy = dependentconsumers.GetDependentConsumerProxy(clientConfig)
+			resources, dependsMap, err := proxy.GetDependentConsumers(ctx, resource)
+			if err != nil {
+				return nil, nil, diag.Errorf("Failed to retrieve dependencies of flow %s: %s", resource.State.ID, err)
+			}
+			return resources, dependsMap, nil
+		})
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		dependencies = append(dependencies, dependencyTrackingNodes(consumed, resource.State.ID)...)
+	}
+	return dependencies, nil
+}
+
+// closureDependents returns the resources that consume a resource according to Architect dependency tracking and the
+// resources whose RefAttrs reference it
+func (g *GenesysCloudResourceExporter) closureDependents(resource resourceExporter.ResourceInfo, referencingResources map[string][]resourceExporter.ResourceInfo) ([]closureNode, diag.Diagnostics) {
+	dependents := make([]closureNode, 0)
+
+	proxy := dependentconsumers.GetDependentConsumerProxy(nil)
+	consuming, _, diagErr := proxy.GetAllWithPooledClient(func(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+		proxy = dependentconsumers.GetDependentConsumerProxy(clientConfig)
+		resources, err := proxy.GetConsumingResources(ctx, resource.Type, resource.State.ID, resource.State.Attributes["type"])
+		if err != nil {
+			return nil, nil, diag.Errorf("Failed to retrieve resources consuming %s %s: %s", resource.Type, resource.State.ID, err)
+		}
+		return resources, nil, nil
+	})
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	dependents = append(dependents, dependencyTrackingNodes(consuming, resource.State.ID)...)
+
+	exporters := resourceExporter.GetResourceExporters()
+	for _, refType := range referencingResourceTypes(resource.Type, exporters) {
+		if _, loaded := referencingResources[refType]; !loaded {
+			resources, diagErr := g.loadClosureResources([]closureNode{{ResourceType: refType}})
+			if diagErr != nil {
+				return nil, diagErr
+			}
+			referencingResources[refType] = resources
+		}
+		for _, referencing := range referencingResources[refType] {
+			for _, referenced := range referencedResources(referencing.State, exporters[refType].RefAttrs) {
+				if referenced.Id == resource.State.ID && referenced.ResourceType == resource.Type {
+					dependents = append(dependents, closureNode{ResourceType: refType, Id: referencing.State.ID})
+				}
+			}
+		}
+	}
+	return dependents, nil
+}
+
+// referencingResourceTypes returns the resource types with a RefAttr that references the resource type
+func referencingResourceTypes(resType string, exporters map[string]*resourceExporter.ResourceExporter) []string {
+	refTypes := make([]string, 0)
+	for exporterType, exporter := range exporters {
+		for _, refSettings := range exporter.RefAttrs {
+			if refSettings.RefType == resType {
+				refTypes = append(refTypes, exporterType)
+				break
+			}
+		}
+	}
+	sort.Strings(refTypes)
+	return refTypes
+}
+
+// referencedResources returns the resources referenced by the RefAttrs of a resource's state
+func referencedResources(state *terraform.InstanceState, refAttrs map[string]*resourceExporter.RefAttrSettings) []closureNode {
+	nodes := make([]closureNode, 0)
+	if state == nil {
+		return nodes
+	}
+
+	keys := make([]string, 0, len(state.Attributes))
+	for key := range state.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		// Skip the counts of lists, sets and maps
+		if strings.HasSuffix(key, ".#") || strings.HasSuffix(key, ".%") {
+			continue
+		}
+		refSettings, ok := refAttrs[stateIndexRegex.ReplaceAllString(key, "$1")]
+		if !ok {
+			continue
+		}
+		value := state.Attributes[key]
+		if value == "" || lists.ItemInSlice(value, refSettings.AltValues) {
+			continue
+		}
+		node := closureNode{ResourceType: refSettings.RefType, Id: value}
+		if !containsClosureNode(nodes, node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// dependencyTrackingNodes converts the resources returned by the dependent consumers proxy, which are named
+// <type>::::<id>, to closure nodes
+func dependencyTrackingNodes(resources resourceExporter.ResourceIDMetaMap, excludeId string) []closureNode {
+	nodes := make([]closureNode, 0, len(resources))
+	for id, meta := range resources {
+		resType, _, _ := strings.Cut(meta.Name, "::::")
+		if id != excludeId && resType != "" {
+			nodes = append(nodes, closureNode{ResourceType: resType, Id: id})
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].filter() < nodes[j].filter() })
+	return nodes
+}
+
+func containsClosureNode(nodes []closureNode, node closureNode) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+func validateClosureSeed(i interface{}, k string) (warnings []string, errors []error) {
+	seed, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	resType, id, found := strings.Cut(seed, "::")
+	if !found || id == "" {
+		return nil, []error{fmt.Errorf("%s must be in the form <resource type>::<id>, got: %s", k, seed)}
+	}
+	if !lists.ItemInSlice(resType, resourceExporter.GetAvailableExporterTypes()) {
+		return nil, []error{fmt.Errorf("%s is not an exportable resource type", resType)}
+	}
+	return nil, nil
+}
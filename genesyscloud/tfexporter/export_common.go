@@ -6,11 +6,11 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"strconv"
 	"strings"
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +25,8 @@ const (
 	defaultTfJSONVariablesFile = "variables.tf.json"
 	defaultTfVarsFile          = "terraform.tfvars"
 	defaultTfStateFile         = "terraform.tfstate"
+	defaultTfHCLImportsFile    = "imports.tf"
+	defaultTfJSONImportsFile   = "imports.tf.json"
 )
 
 // Common Exporter interface to abstract away whether we are using HCL or JSON as our exporter
@@ -37,6 +39,7 @@ const (
 	LegacyInclude ExporterFilterType = iota
 	IncludeResources
 	ExcludeResources
+	ClosureResources
 )
 
 func IncludeFilterByResourceType(exports map[string]*resourceExporter.ResourceExporter, filter []string) map[string]*resourceExporter.ResourceExporter {
@@ -239,39 +242,10 @@ func sanitizeE164Number(number string) string {
 	}
 	return number
 }
-func sanitizeRrule(input string) string {
-	attributeRegex := map[string]*regexp.Regexp{
-		"INTERVAL":   regexp.MustCompile(`INTERVAL=([1-9][0-9]*|0?[1-9][0-9]*);`),
-		"BYMONTH":    regexp.MustCompile(`BYMONTH=(0?[1-9]|1[0-2]);`),
-		"BYMONTHDAY": regexp.MustCompile(`BYMONTHDAY=(0?[1-9]|[1-2][0-9]|3[0-1])$`),
-	}
-
-	// Iterate over attributes and modify the input string
-	for attributeName, regex := range attributeRegex {
-		input = regex.ReplaceAllStringFunc(input, func(match string) string {
-			return removeTrailingZeros(match, attributeName)
-		})
-	}
-	return input
-}
 
-func removeTrailingZeros(match, attributeName string) string {
-	pattern := `=(\d{1,2})`
-	re := regexp.MustCompile(pattern)
-	outputText := re.ReplaceAllStringFunc(match, func(match string) string {
-		numericPart := match[1:]
-		numericPart = fmt.Sprintf("%d", parseInt(numericPart))
-		return "=" + numericPart
-	})
-	return outputText
-}
-
-func parseInt(s string) int {
-	i, err := strconv.Atoi(s)
-	if err != nil {
-		panic(err)
-	}
-	return i
+// sanitizeRrule removes the leading zeros the API returns in the numeric values of an rrule, e.g. INTERVAL=01
+func sanitizeRrule(input string) string {
+	return rrule.Normalize(input)
 }
 
 // Get a string path to the target export file
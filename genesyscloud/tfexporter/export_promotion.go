@@ -0,0 +1,155 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+Promotion replaces values that differ between orgs (emails, DIDs, edge IDs and the like) with variables, so that the same
+export can be applied to every environment. The mapping file is keyed by resource type, attribute and the value in the
+source org, and gives the value in each target environment:
+
+	{
+	  "genesyscloud_user": {
+	    "email": {
+	      "jane@dev.example.com": { "test": "jane@test.example.com", "prod": "jane@example.com" }
+	    }
+	  }
+	}
+
+Mapped attributes are written as variables in the same way as unresolvable attributes. The source value is kept in
+terraform.tfvars and the value of each environment is written to <environment>.tfvars.
+*/
+
+// Environment names are used as file names
+var promotionEnvironmentRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// promotionMapping maps the value of an attribute in the source org to its value in each environment. It is keyed by
+// resource type, attribute and source value.
+type promotionMapping map[string]map[string]map[string]map[string]string
+
+func loadPromotionMapping(path string) (promotionMapping, diag.Diagnostics) {
+	mappingBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read promotion mapping file %s: %v", path, err)
+	}
+
+	var mapping promotionMapping
+	if err := json.Unmarshal(mappingBytes, &mapping); err != nil {
+		return nil, diag.Errorf("Failed to parse promotion mapping file %s: %v", path, err)
+	}
+	for _, environment := range mapping.environments() {
+		if !promotionEnvironmentRegex.MatchString(environment) {
+			return nil, diag.Errorf("Invalid environment '%s' in promotion mapping file %s. Environment names may only contain letters, numbers, '_' and '-'", environment, path)
+		}
+	}
+	return mapping, nil
+}
+
+// targetValues returns the value of each environment for the value of an attribute in the source org
+func (p promotionMapping) targetValues(resType, attr, value string) (map[string]string, bool) {
+	targets, ok := p[resType][attr][value]
+	return targets, ok && len(targets) > 0
+}
+
+// environments returns every environment named in the mapping
+func (p promotionMapping) environments() []string {
+	environments := make([]string, 0)
+	for _, attrs := range p {
+		for _, values := range attrs {
+			for _, targets := range values {
+				for environment := range targets {
+					if !lists.ItemInSlice(environment, environments) {
+						environments = append(environments, environment)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(environments)
+	return environments
+}
+
+// promoteAttribute records a variable for an attribute whose value is mapped for promotion and returns its name
+func (g *GenesysCloudResourceExporter) promoteAttribute(resType, resName, attr, value string) (string, bool) {
+	targets, ok := g.promotionMapping.targetValues(resType, attr, value)
+	if !ok {
+		return "", false
+	}
+
+	// Attributes of nested blocks can appear more than once in a resource, so each distinct value gets its own variable
+	name := strings.ReplaceAll(attr, ".", "_")
+	for i := 2; ; i++ {
+		info := unresolvableAttributeInfo{ResourceType: resType, ResourceName: resName, Name: name}
+		key := createUnresolvedAttrKey(info)
+		sourceValue, exists := g.promotedValues[key]
+		if exists && sourceValue == value {
+			return key, true
+		}
+		if !exists {
+			g.promotedValues[key] = value
+			info.Schema = &schema.Schema{
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("%s value for resource %s of type %s, mapped per environment for promotion", attr, resName, resType),
+			}
+			info.SourceValue = &value
+			info.EnvironmentValues = targets
+			g.promotedAttrs = append(g.promotedAttrs, info)
+			return key, true
+		}
+		name = fmt.Sprintf("%s_%d", strings.ReplaceAll(attr, ".", "_"), i)
+	}
+}
+
+// writePromotionTfVars writes a tfvars file with the mapped values of each environment
+func (g *GenesysCloudResourceExporter) writePromotionTfVars() diag.Diagnostics {
+	for _, environment := range g.promotionMapping.environments() {
+		tfVars := make(map[string]interface{})
+		for _, attr := range g.unresolvedAttrs {
+			if attr.EnvironmentValues == nil {
+				continue
+			}
+			if value, ok := attr.EnvironmentValues[environment]; ok {
+				tfVars[createUnresolvedAttrKey(attr)] = value
+			} else {
+				log.Printf("No %s value mapped for %s. The source value in %s is used.", environment, createUnresolvedAttrKey(attr), defaultTfVarsFile)
+			}
+		}
+
+		tfVarsStr := fmt.Sprintf("// This file has been autogenerated from the promotion mapping file. Use it with -var-file=%s.tfvars to apply the export to the %s environment."+
+			"\n// Variables that are not set here take their value from %s\n\n%s", environment, environment, defaultTfVarsFile, generateTfVarsContent(tfVars))
+		path := filepath.Join(g.exportDirPath, environment+".tfvars")
+		log.Printf("Writing %s promotion tfvars file to %s", environment, path)
+		if diagErr := files.WriteToFile([]byte(tfVarsStr), path); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+// unresolvedAttrVarValue returns the value written to terraform.tfvars for a variable, which is the value in the source
+// org for an attribute mapped for promotion
+func unresolvedAttrVarValue(attr unresolvableAttributeInfo) interface{} {
+	if attr.SourceValue != nil {
+		return *attr.SourceValue
+	}
+	return determineVarValue(attr.Schema)
+}
+
+// isReferenceExpression checks if an attribute value was resolved to a reference to another exported resource
+func isReferenceExpression(value interface{}) bool {
+	strValue, ok := value.(string)
+	return ok && strings.HasPrefix(strValue, "${")
+}
@@ -0,0 +1,200 @@
+package tfexporter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+When a PGP public key is configured, every configurable attribute marked Sensitive in a resource schema is exported as a
+variable. The values of those variables are not written to terraform.tfvars, but to a PGP encrypted tfvars file that can
+be committed along with the rest of the export and decrypted when the configuration is applied, e.g.
+
+	gpg --decrypt secrets.tfvars.asc > secrets.auto.tfvars
+*/
+
+const secretsTfVarsFile = "secrets.tfvars.asc"
+
+// readSecretsKeyRing parses the armored PGP public key that the secrets file is encrypted with
+func readSecretsKeyRing(publicKey string) (openpgp.EntityList, diag.Diagnostics) {
+	keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(publicKey))
+	if err != nil {
+		return nil, diag.Errorf("Failed to read secrets PGP public key: %v", err)
+	}
+	if len(keyRing) == 0 {
+		return nil, diag.Errorf("No keys found in secrets PGP public key")
+	}
+	return keyRing, nil
+}
+
+// exportSecretAttributes replaces the sensitive attributes of a resource's config with variables. Unresolvable
+// attributes that are sensitive are already variables, and are marked as secret so their value is not written to
+// terraform.tfvars.
+func (g *GenesysCloudResourceExporter) exportSecretAttributes(resource resourceExporter.ResourceInfo, configMap util.JsonMap, unresolved []unresolvableAttributeInfo) []unresolvableAttributeInfo {
+	res := g.provider.ResourcesMap[resource.Type]
+	if res == nil {
+		return unresolved
+	}
+
+	for _, key := range sortedKeys(res.Schema) {
+		attrSchema := res.Schema[key]
+		if !attrSchema.Sensitive || !isConfigurable(attrSchema) {
+			continue
+		}
+		info := unresolvableAttributeInfo{ResourceType: resource.Type, ResourceName: resource.Name, Name: key}
+		varKey := createUnresolvedAttrKey(info)
+
+		existing := false
+		for i := range unresolved {
+			if createUnresolvedAttrKey(unresolved[i]) == varKey {
+				unresolved[i].Secret = true
+				unresolved[i].SecretValue = stateAttributeValue(resource.State.Attributes, key, attrSchema)
+				existing = true
+			}
+		}
+		if existing {
+			continue
+		}
+
+		info.Schema = attrSchema
+		info.Secret = true
+		info.SecretValue = stateAttributeValue(resource.State.Attributes, key, attrSchema)
+		unresolved = append(unresolved, info)
+		configMap[key] = fmt.Sprintf("${var.%s}", varKey)
+	}
+
+	g.exportNestedSecretAttributes(resource, res.Schema, configMap, "", &unresolved)
+	return unresolved
+}
+
+// exportNestedSecretAttributes replaces the sensitive attributes of the blocks in a config with variables
+func (g *GenesysCloudResourceExporter) exportNestedSecretAttributes(resource resourceExporter.ResourceInfo, schemaMap map[string]*schema.Schema, configMap util.JsonMap, prefix string, unresolved *[]unresolvableAttributeInfo) {
+	for _, key := range sortedKeys(schemaMap) {
+		elem, ok := schemaMap[key].Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		blocks, ok := configMap[key].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for i, block := range blocks {
+			blockMap, ok := block.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			blockPrefix := prefix + key + "_"
+			if len(blocks) > 1 {
+				blockPrefix = fmt.Sprintf("%s%s_%d_", prefix, key, i)
+			}
+
+			for _, attr := range sortedKeys(elem.Schema) {
+				attrSchema := elem.Schema[attr]
+				if !attrSchema.Sensitive || !isConfigurable(attrSchema) {
+					continue
+				}
+				value, ok := blockMap[attr]
+				if !ok || value == nil {
+					value = determineVarValue(attrSchema)
+				}
+				info := unresolvableAttributeInfo{
+					ResourceType: resource.Type,
+					ResourceName: resource.Name,
+					Name:         blockPrefix + attr,
+					Schema:       attrSchema,
+					Secret:       true,
+					SecretValue:  value,
+				}
+				*unresolved = append(*unresolved, info)
+				blockMap[attr] = fmt.Sprintf("${var.%s}", createUnresolvedAttrKey(info))
+			}
+			g.exportNestedSecretAttributes(resource, elem.Schema, blockMap, blockPrefix, unresolved)
+		}
+	}
+}
+
+// writeSecretsTfVars writes the values of the secret variables to a tfvars file encrypted with the PGP public key
+func (g *GenesysCloudResourceExporter) writeSecretsTfVars() diag.Diagnostics {
+	tfVars := make(map[string]interface{})
+	for _, attr := range g.unresolvedAttrs {
+		if attr.Secret {
+			tfVars[createUnresolvedAttrKey(attr)] = attr.SecretValue
+		}
+	}
+	if len(tfVars) == 0 {
+		return nil
+	}
+
+	tfVarsStr := fmt.Sprintf("// This file has been autogenerated. It contains the values of the sensitive attributes of the exported resources."+
+		"\n// Values that could not be retrieved from the API are empty and should be edited as necessary\n\n%s\n", generateTfVarsContent(tfVars))
+	encrypted, err := encryptForKeyRing([]byte(tfVarsStr), g.secretsKeyRing)
+	if err != nil {
+		return diag.Errorf("Failed to encrypt secrets tfvars file: %v", err)
+	}
+
+	path := filepath.Join(g.exportDirPath, secretsTfVarsFile)
+	log.Printf("Writing %d secret variables to %s", len(tfVars), path)
+	return files.WriteToFile(encrypted, path)
+}
+
+// encryptForKeyRing encrypts data for every key in a key ring as an armored PGP message
+func encryptForKeyRing(data []byte, keyRing openpgp.EntityList) ([]byte, error) {
+	var buf bytes.Buffer
+	armorWriter, err := armor.Encode(&buf, "PGP MESSAGE", nil)
+	if err != nil {
+		return nil, err
+	}
+	plainWriter, err := openpgp.Encrypt(armorWriter, keyRing, nil, &openpgp.FileHints{IsBinary: false, FileName: "secrets.tfvars"}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(plainWriter, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	if err := plainWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err := armorWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// stateAttributeValue reads the value of a top level attribute from the flattened attributes of a resource's state
+func stateAttributeValue(attributes map[string]string, key string, attrSchema *schema.Schema) interface{} {
+	switch attrSchema.Type {
+	case schema.TypeMap:
+		values := make(map[string]interface{})
+		for attr, value := range attributes {
+			if name, found := strings.CutPrefix(attr, key+"."); found && name != "%" {
+				values[name] = value
+			}
+		}
+		return values
+	case schema.TypeList, schema.TypeSet:
+		return determineVarValue(attrSchema)
+	default:
+		if value, ok := attributes[key]; ok {
+			return value
+		}
+		return determineVarValue(attrSchema)
+	}
+}
+
+// isConfigurable checks if an attribute can be set in config, as computed only attributes cannot be
+func isConfigurable(attrSchema *schema.Schema) bool {
+	return attrSchema.Optional || attrSchema.Required
+}
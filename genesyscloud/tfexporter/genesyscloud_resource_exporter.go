@@ -19,6 +19,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	rRegistrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
@@ -26,6 +27,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/util/stringmap"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -61,6 +63,14 @@ type unresolvableAttributeInfo struct {
 	ResourceName string
 	Name         string
 	Schema       *schema.Schema
+
+	// The value in the source org and in each environment of an attribute mapped for promotion
+	SourceValue       *string
+	EnvironmentValues map[string]string
+
+	// Secret variables take their value from the encrypted secrets file rather than terraform.tfvars
+	Secret      bool
+	SecretValue interface{}
 }
 
 type GenesysCloudResourceExporter struct {
@@ -71,10 +81,17 @@ type GenesysCloudResourceExporter struct {
 	filterList             *[]string
 	exportAsHCL            bool
 	splitFilesByResource   bool
+	moduleLayout           string
+	moduleGroups           map[string]string
+	moduleAssignments      map[string]string
 	logPermissionErrors    bool
 	addDependsOn           bool
 	replaceWithDatasource  []string
 	includeStateFile       bool
+	includeImportBlocks    bool
+	gitCommit              bool
+	gitCommitSubject       string
+	resourceIds            map[string]string
 	version                string
 	provider               *schema.Provider
 	exportDirPath          string
@@ -94,6 +111,15 @@ type GenesysCloudResourceExporter struct {
 	ignoreCyclicDeps       bool
 	flowResourcesList      []string
 	exportComputed         bool
+	exportRowsAsCsv        bool
+	exportDependencyGraph  bool
+	incrementalStateFile   string
+	previousExport         *previousExport
+	promotionMapping       promotionMapping
+	promotedAttrs          []unresolvableAttributeInfo
+	promotedValues         map[string]string
+	secretsKeyRing         openpgp.EntityList
+	skipFileWriters        bool
 }
 
 func configureExporterType(ctx context.Context, d *schema.ResourceData, gre *GenesysCloudResourceExporter, filterType ExporterFilterType) {
@@ -128,6 +154,16 @@ func configureExporterType(ctx context.Context, d *schema.ResourceData, gre *Gen
 		//Setting up the resource type filter
 		gre.resourceTypeFilter = ExcludeFilterByResourceType //Setting up the resource type filter
 		gre.resourceFilter = ExcludeFilterResourceByRegex    //Setting up the resource filters
+	case ClosureResources:
+		var filter []string
+		if seeds, ok := d.GetOk("closure_seed_resources"); ok {
+			filter = lists.InterfaceListToStrings(seeds.([]interface{}))
+			gre.filterList = &filter
+		}
+
+		// The seeds and the resources in their closure are filtered by ID
+		gre.resourceTypeFilter = IncludeFilterByResourceType
+		gre.resourceFilter = FilterResourceById
 	}
 }
 
@@ -138,19 +174,31 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 	}
 
 	gre := &GenesysCloudResourceExporter{
-		exportAsHCL:          d.Get("export_as_hcl").(bool),
-		splitFilesByResource: d.Get("split_files_by_resource").(bool),
-		logPermissionErrors:  d.Get("log_permission_errors").(bool),
-		exportComputed:       d.Get("export_computed").(bool),
-		addDependsOn:         computeDependsOn(d),
-		filterType:           filterType,
-		includeStateFile:     d.Get("include_state_file").(bool),
-		ignoreCyclicDeps:     d.Get("ignore_cyclic_deps").(bool),
-		version:              meta.(*provider.ProviderMeta).Version,
-		provider:             provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
-		d:                    d,
-		ctx:                  ctx,
-		meta:                 meta,
+		exportAsHCL:           d.Get("export_as_hcl").(bool),
+		splitFilesByResource:  d.Get("split_files_by_resource").(bool),
+		moduleLayout:          d.Get("module_layout").(string),
+		moduleGroups:          getModuleGroups(d),
+		logPermissionErrors:   d.Get("log_permission_errors").(bool),
+		exportComputed:        d.Get("export_computed").(bool),
+		exportRowsAsCsv:       d.Get("export_rows_as_csv").(bool),
+		exportDependencyGraph: d.Get("export_dependency_graph").(bool),
+		addDependsOn:          computeDependsOn(d),
+		filterType:            filterType,
+		includeStateFile:      d.Get("include_state_file").(bool),
+		includeImportBlocks:   d.Get("include_import_blocks").(bool),
+		gitCommit:             d.Get("git_commit").(bool),
+		gitCommitSubject:      d.Get("git_commit_subject").(string),
+		ignoreCyclicDeps:      d.Get("ignore_cyclic_deps").(bool),
+		incrementalStateFile:  d.Get("incremental_state_file").(string),
+		version:               meta.(*provider.ProviderMeta).Version,
+		provider:              provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
+		d:                     d,
+		ctx:                   ctx,
+		meta:                  meta,
+	}
+
+	if gre.moduleLayout != "" && !gre.exportAsHCL {
+		return nil, diag.Errorf("module_layout is only supported when export_as_hcl is true")
 	}
 
 	err := gre.setUpExportDirPath()
@@ -158,6 +206,25 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 		return nil, err
 	}
 
+	if path := d.Get("promotion_mapping_file").(string); path != "" {
+		gre.promotionMapping, err = loadPromotionMapping(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if publicKey := d.Get("secrets_pgp_public_key").(string); publicKey != "" {
+		gre.secretsKeyRing, err = readSecretsKeyRing(publicKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The state file of an incremental export seeds the next run, so it is always written
+	if gre.incrementalStateFile != "" {
+		gre.includeStateFile = true
+	}
+
 	gre.setupDataSource()
 
 	//Setting up the filter
@@ -179,6 +246,14 @@ func computeDependsOn(d *schema.ResourceData) bool {
 }
 
 func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
+	// Walk from the seed resources of a closure export to find the resources to export
+	if g.filterType == ClosureResources {
+		diagErr = g.buildExportClosure()
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
 	// Step #1 Retrieve the exporters we are have registered and have been requested by the user
 	diagErr = g.retrieveExporters()
 	if diagErr != nil {
@@ -190,39 +265,51 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		return diagErr
 	}
 
-	// Step #3 Retrieve the individual genesys cloud object instances
+	// Step #3 Load the previous export when running incrementally
+	diagErr = g.loadPreviousExport()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Step #4 Retrieve the individual genesys cloud object instances
 	diagErr = g.retrieveGenesysCloudObjectInstances()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #4 export dependent resources for the flows
+	// Step #5 export dependent resources for the flows
 	diagErr = g.buildAndExportDependsOnResourcesForFlows()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #5 Convert the Genesys Cloud resources to neutral format (e.g. map of maps)
+	// Step #6 Convert the Genesys Cloud resources to neutral format (e.g. map of maps)
 	diagErr = g.buildResourceConfigMap()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #6 export dependents for other resources
+	// Step #7 export dependents for other resources
 	diagErr = g.buildAndExportDependentResources()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// Step #7 Write the terraform state file along with either the HCL or JSON
+	// Step #8 Write the terraform state file along with either the HCL or JSON
 	diagErr = g.generateOutputFiles()
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// step #8 Verify the terraform state file with Exporter Resources
+	// step #9 Verify the terraform state file with Exporter Resources
 	g.verifyTerraformState()
 
+	// Step #10 Commit the export to the git repository containing the export directory
+	diagErr = g.commitExportToGit()
+	if diagErr != nil {
+		return diagErr
+	}
+
 	return nil
 }
 
@@ -237,6 +324,27 @@ func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagno
 	return nil
 }
 
+// commitExportToGit commits the exported files with a summary of the resources changed since the previous commit
+func (g *GenesysCloudResourceExporter) commitExportToGit() diag.Diagnostics {
+	if !g.gitCommit {
+		return nil
+	}
+
+	w := NewGitCommitWriter(g.ctx, g.resourceTypesMaps, g.resourceIds, g.exportDirPath, g.gitCommitSubject)
+	return w.commitExport()
+}
+
+// loadPreviousExport reads the state file of a previous export so unchanged objects do not have to be read again
+func (g *GenesysCloudResourceExporter) loadPreviousExport() (diagErr diag.Diagnostics) {
+	if g.incrementalStateFile == "" {
+		return nil
+	}
+
+	log.Printf("Loading previous export state file %s", g.incrementalStateFile)
+	g.previousExport, diagErr = readPreviousExport(g.incrementalStateFile, g.provider.ResourcesMap)
+	return diagErr
+}
+
 func (g *GenesysCloudResourceExporter) setupDataSource() {
 	if replaceWithDatasource, ok := g.d.GetOk("replace_with_datasource"); ok {
 		dataSourceList := lists.InterfaceListToStrings(replaceWithDatasource.([]interface{}))
@@ -257,6 +365,7 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 	if g.resourceTypeFilter != nil && g.filterList != nil {
 		exports = g.resourceTypeFilter(exports, *g.filterList)
 	}
+	g.selectRowExporters(exports)
 
 	g.exporters = &exports
 
@@ -269,6 +378,29 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 	return nil
 }
 
+// Resources that manage every row of a parent object from a CSV file, mapped to the resource that manages a single row
+var rowFileResourceTypes = map[string]string{
+	"genesyscloud_architect_datatable_rows":       "genesyscloud_architect_datatable_row",
+	"genesyscloud_outbound_contact_list_contacts": "genesyscloud_outbound_contact_list_contact",
+}
+
+// selectRowExporters keeps only one of the per-row and CSV file exporters of a parent object so that its rows are not
+// exported twice. The CSV file exporter is kept when export_rows_as_csv is set.
+func (g *GenesysCloudResourceExporter) selectRowExporters(exports map[string]*resourceExporter.ResourceExporter) {
+	for fileType, rowType := range rowFileResourceTypes {
+		_, hasFileType := exports[fileType]
+		_, hasRowType := exports[rowType]
+		if !hasFileType || !hasRowType {
+			continue
+		}
+		if g.exportRowsAsCsv {
+			delete(exports, rowType)
+		} else {
+			delete(exports, fileType)
+		}
+	}
+}
+
 // Removes the ::resource_name from the resource_types list
 func formatFilter(filter []string) []string {
 	newFilter := make([]string, 0)
@@ -295,6 +427,11 @@ func (g *GenesysCloudResourceExporter) retrieveSanitizedResourceMaps() (diagErr
 		filter = lists.InterfaceListToStrings(exportableResourceTypes.([]interface{}))
 	}
 
+	// The filter of a closure export is the list of resources found from the seeds
+	if g.filterType == ClosureResources {
+		filter = *g.filterList
+	}
+
 	newFilter := make([]string, 0)
 	for _, f := range filter {
 		if strings.Contains(f, "::") {
@@ -370,6 +507,9 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	g.dataSourceTypesMaps = make(map[string]resourceJSONMaps)
 	g.resourceTypesHCLBlocks = make(map[string]resourceHCLBlock, 0)
 	g.unresolvedAttrs = make([]unresolvableAttributeInfo, 0)
+	g.promotedAttrs = make([]unresolvableAttributeInfo, 0)
+	g.promotedValues = make(map[string]string)
+	g.resourceIds = make(map[string]string)
 
 	for _, resource := range g.resources {
 		jsonResult, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
@@ -390,16 +530,23 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 		}
 
 		// Removes zero values and sets proper reference expressions
-		unresolved, _ := g.sanitizeConfigMap(resource, jsonResult, "", *g.exporters, g.includeStateFile, g.exportAsHCL, true)
-		if len(unresolved) > 0 {
-			g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
-		}
+		unresolved, _ := g.sanitizeConfigMap(resource, jsonResult, "", *g.exporters, g.includeStateFile || g.includeImportBlocks, g.exportAsHCL, true)
 
 		if isDataSource {
 			g.sanitizeDataConfigMap(jsonResult)
 		}
 
-		g.customWriteAttributes(jsonResult, resource)
+		if g.customWriteAttributes(jsonResult, resource) {
+			// Attributes set by a custom file writer no longer need a variable
+			unresolved = dropResolvedAttributes(unresolved, jsonResult)
+		}
+		if g.secretsKeyRing != nil && !isDataSource {
+			// Sensitive attributes are replaced with variables whose values are encrypted
+			unresolved = g.exportSecretAttributes(resource, jsonResult, unresolved)
+		}
+		if len(unresolved) > 0 {
+			g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
+		}
 
 		if g.exportAsHCL {
 			if _, ok := g.resourceTypesHCLBlocks[resource.Type]; !ok {
@@ -415,26 +562,59 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 			g.dataSourceTypesMaps[resource.Type][resource.Name] = jsonResult
 		} else {
 			g.resourceTypesMaps[resource.Type][resource.Name] = jsonResult
+			g.resourceIds[resource.Type+"."+resource.Name] = resource.State.ID
 		}
 
 	}
 
+	// Attributes mapped for promotion are declared as variables along with the unresolvable attributes
+	g.unresolvedAttrs = append(g.unresolvedAttrs, g.promotedAttrs...)
 	return nil
 }
 
+// customWriteAttributes invokes the custom file writer and flow resolvers of a resource. It returns true when the
+// custom file writer wrote the files of the resource.
 func (g *GenesysCloudResourceExporter) customWriteAttributes(jsonResult util.JsonMap,
-	resource resourceExporter.ResourceInfo) {
+	resource resourceExporter.ResourceInfo) bool {
 	exporters := *g.exporters
-	if resourceFilesWriterFunc := exporters[resource.Type].CustomFileWriter.RetrieveAndWriteFilesFunc; resourceFilesWriterFunc != nil {
-		exportDir, _ := getFilePath(g.d, "")
-		if err := resourceFilesWriterFunc(resource.State.ID, exportDir, exporters[resource.Type].CustomFileWriter.SubDirectory, jsonResult, g.meta, resource); err != nil {
+	wroteFiles := false
+	if resourceFilesWriterFunc := exporters[resource.Type].CustomFileWriter.RetrieveAndWriteFilesFunc; resourceFilesWriterFunc != nil && g.isFileWriterEnabled(exporters[resource.Type].CustomFileWriter) {
+		if err := resourceFilesWriterFunc(resource.State.ID, g.exportDirPath, exporters[resource.Type].CustomFileWriter.SubDirectory, jsonResult, g.meta, resource); err != nil {
 			log.Printf("An error has occurred while trying invoking the RetrieveAndWriteFilesFunc for resource type %s: %v", resource.Type, err)
+		} else {
+			wroteFiles = true
 		}
 	}
 
 	if len(exporters[resource.Type].CustomFlowResolver) > 0 {
 		g.updateInstanceStateAttributes(jsonResult, resource)
 	}
+	return wroteFiles
+}
+
+// isFileWriterEnabled checks the export attribute that a custom file writer is enabled by, if it has one. No files are
+// written when the exporter only reads the org.
+func (g *GenesysCloudResourceExporter) isFileWriterEnabled(settings resourceExporter.CustomFileWriterSettings) bool {
+	if g.skipFileWriters {
+		return false
+	}
+	if settings.EnabledByAttribute == "" {
+		return true
+	}
+	enabled, _ := g.d.Get(settings.EnabledByAttribute).(bool)
+	return enabled
+}
+
+// dropResolvedAttributes removes the unresolvable attributes whose variable reference was replaced in the config
+func dropResolvedAttributes(unresolved []unresolvableAttributeInfo, configMap util.JsonMap) []unresolvableAttributeInfo {
+	remaining := make([]unresolvableAttributeInfo, 0, len(unresolved))
+	for _, attr := range unresolved {
+		if value, ok := configMap[attr.Name].(string); ok && !strings.HasPrefix(value, "${var.") {
+			continue
+		}
+		remaining = append(remaining, attr)
+	}
+	return remaining
 }
 
 func (g *GenesysCloudResourceExporter) updateInstanceStateAttributes(jsonResult util.JsonMap, resource resourceExporter.ResourceInfo) {
@@ -472,18 +652,32 @@ func (g *GenesysCloudResourceExporter) instanceStateToMap(state *terraform.Insta
 	return jsonMap, nil
 }
 
-// generateOutputFiles is used to generate the tfStateFile and either the tf export or the json based export
+// generateOutputFiles is used to generate the tfStateFile and import blocks, and either the tf export or the json based export
 func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 	providerSource := g.sourceForVersion(g.version)
+	if g.moduleLayout != "" {
+		g.moduleAssignments = assignResourceModules(g.moduleLayout, g.moduleGroups, g.resourceTypesMaps, g.dataSourceTypesMaps)
+	}
+
 	if g.includeStateFile {
-		t := NewTFStateWriter(g.ctx, g.resources, g.d, providerSource)
+		t := NewTFStateWriter(g.ctx, g.resources, g.d, providerSource, g.moduleAssignments)
 		if err := t.writeTfState(); err != nil {
 			return err
 		}
 	}
 
+	if g.includeImportBlocks {
+		i := NewImportBlocksWriter(importBlocksFromResourceIds(g.resourceIds), g.moduleAssignments, g.exportDirPath, g.exportAsHCL)
+		if err := i.writeImportBlocks(); err != nil {
+			return err
+		}
+	}
+
 	var err diag.Diagnostics
-	if g.exportAsHCL {
+	if g.moduleLayout != "" {
+		moduleExporter := NewModuleHCLExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.moduleAssignments, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
+		err = moduleExporter.exportHCLModules()
+	} else if g.exportAsHCL {
 		hclExporter := NewHClExporter(g.resourceTypesHCLBlocks, g.unresolvedAttrs, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
 		err = hclExporter.exportHCLConfig()
 	} else {
@@ -503,6 +697,27 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		}
 	}
 
+	if g.secretsKeyRing != nil {
+		err = g.writeSecretsTfVars()
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.promotionMapping != nil {
+		err = g.writePromotionTfVars()
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.exportDependencyGraph {
+		err = g.writeDependencyGraph()
+		if err != nil {
+			return err
+		}
+	}
+
 	err = g.generateZipForExporter()
 	if err != nil {
 		return err
@@ -511,6 +726,20 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 	return nil
 }
 
+// writeDependencyGraph writes the dependency graph of the exported resources as DOT and JSON
+func (g *GenesysCloudResourceExporter) writeDependencyGraph() diag.Diagnostics {
+	graph := buildDependencyGraph(g.resources, g.dependsList)
+	graphJson, err := graph.toJson()
+	if err != nil {
+		return diag.Errorf("Failed to build dependency graph: %v", err)
+	}
+
+	if diagErr := files.WriteToFile([]byte(graph.toDot()), filepath.Join(g.exportDirPath, dependencyGraphDotFile)); diagErr != nil {
+		return diagErr
+	}
+	return files.WriteToFile([]byte(graphJson), filepath.Join(g.exportDirPath, dependencyGraphJsonFile))
+}
+
 func (g *GenesysCloudResourceExporter) generateZipForExporter() diag.Diagnostics {
 	zipFileName := "../archive_genesyscloud_tf_export" + uuid.NewString() + ".zip"
 	if compress := g.d.Get("compress").(bool); compress { //if true, compress directory name of where the export is going to occur
@@ -889,8 +1118,9 @@ func (g *GenesysCloudResourceExporter) appendResources(resourcesToAdd []resource
 func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[string]*resourceExporter.ResourceExporter, filter []string, logErrors bool) diag.Diagnostics {
 	errorChan := make(chan diag.Diagnostics)
 	wgDone := make(chan bool)
-	// Cancel remaining goroutines if an error occurs
-	ctx, cancel := context.WithCancel(context.Background())
+	// Cancel remaining goroutines if an error occurs. The resources are listed in export mode whether or not the process
+	// wide exporter state is active.
+	ctx, cancel := context.WithCancel(tfexporter_state.WithExporterState(context.Background()))
 	defer cancel()
 
 	var wg sync.WaitGroup
@@ -901,7 +1131,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 			log.Printf("Getting all resources for type %s", name)
 			exporter.FilterResource = g.resourceFilter
 
-			err := exporter.LoadSanitizedResourceMap(ctx, name, filter)
+			err := exporter.LoadSanitizedResourceMap(provider.WithResourceType(ctx, name), name, filter)
 
 			// Used in tests
 			if mockError != nil {
@@ -1026,6 +1256,7 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 	}
 
 	exportComputed := g.exportComputed
+	exported := resourceExporter.NewExportedResources(*g.exporters, g.isDataSource)
 
 	ctyType := res.CoreConfigSchema().ImpliedType()
 	var wg sync.WaitGroup
@@ -1033,8 +1264,20 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 	for id, resMeta := range exporter.SanitizedResourceMap {
 		go func(id string, resMeta *resourceExporter.ResourceMeta) {
 			defer wg.Done()
+			if instanceState := g.previousExport.unchangedState(resType, id, resMeta); instanceState != nil && !g.isDataSource(resType, resMeta.Name) {
+				log.Printf("Resource %s of type %s is unchanged since the previous export. Skipping read.", resMeta.Name, resType)
+				resourceChan <- resourceExporter.ResourceInfo{
+					State:    instanceState,
+					Name:     resMeta.Name,
+					Type:     resType,
+					CtyType:  ctyType,
+					Exported: exported,
+				}
+				return
+			}
+
 			fetchResourceState := func() error {
-				ctx, cancel := context.WithTimeout(context.Background(), time.Duration(30)*time.Minute)
+				ctx, cancel := context.WithTimeout(tfexporter_state.WithExporterState(context.Background()), time.Duration(30)*time.Minute)
 				defer cancel()
 				// This calls into the resource's ReadContext method which
 				// will block until it can acquire a pooled client config object.
@@ -1052,6 +1295,13 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 					return nil
 				}
 
+				if resMeta.Version != "" {
+					if instanceState.Meta == nil {
+						instanceState.Meta = make(map[string]interface{})
+					}
+					instanceState.Meta[exportVersionMetaKey] = resMeta.Version
+				}
+
 				resourceType := ""
 
 				if g.isDataSource(resType, resMeta.Name) {
@@ -1082,6 +1332,7 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 					Type:         resType,
 					CtyType:      ctyType,
 					ResourceType: resourceType,
+					Exported:     exported,
 				}
 
 				return nil
@@ -1331,12 +1582,20 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 
 		if attr, ok := attrInUnResolvableAttrs(key, exporter.UnResolvableAttributes); ok {
 			varReference := fmt.Sprintf("%s_%s_%s", resourceType, resourceName, key)
-			unresolvableAttrs = append(unresolvableAttrs, unresolvableAttributeInfo{
+			info := unresolvableAttributeInfo{
 				ResourceType: resourceType,
 				ResourceName: resourceName,
 				Name:         key,
 				Schema:       attr,
-			})
+			}
+			// The variable of an unresolvable attribute takes its values from the promotion mapping when it is mapped
+			if strVal, ok := val.(string); ok {
+				if targets, ok := g.promotionMapping.targetValues(resourceType, currAttr, strVal); ok {
+					info.SourceValue = &strVal
+					info.EnvironmentValues = targets
+				}
+			}
+			unresolvableAttrs = append(unresolvableAttrs, info)
 			if properties, ok := attr.Elem.(*schema.Resource); ok {
 				propertiesMap := make(map[string]interface{})
 				for k := range properties.Schema {
@@ -1346,6 +1605,11 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 			} else {
 				configMap[key] = fmt.Sprintf("${var.%s}", varReference)
 			}
+		} else if strVal, ok := val.(string); ok && !isReferenceExpression(configMap[key]) {
+			// Values that differ between environments are replaced with a variable when mapped for promotion
+			if varReference, promoted := g.promoteAttribute(resourceType, resourceName, currAttr, strVal); promoted {
+				configMap[key] = fmt.Sprintf("${var.%s}", varReference)
+			}
 		}
 
 		// The plugin SDK does not yet have a concept of "null" for unset attributes, so they are saved in state as their "zero value".
@@ -1,13 +1,24 @@
 package tfexporter
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
 
@@ -506,6 +517,60 @@ func TestUnitTfExportMergeExporters(t *testing.T) {
 	}
 }
 
+func TestUnitTfExportSelectRowExporters(t *testing.T) {
+	newExports := func() map[string]*resourceExporter.ResourceExporter {
+		return map[string]*resourceExporter.ResourceExporter{
+			"genesyscloud_architect_datatable":            {},
+			"genesyscloud_architect_datatable_row":        {},
+			"genesyscloud_architect_datatable_rows":       {},
+			"genesyscloud_outbound_contact_list_contacts": {},
+		}
+	}
+
+	// Rows are exported one block per row by default
+	exports := newExports()
+	(&GenesysCloudResourceExporter{}).selectRowExporters(exports)
+	assert.Contains(t, exports, "genesyscloud_architect_datatable_row")
+	assert.NotContains(t, exports, "genesyscloud_architect_datatable_rows")
+	// A CSV file exporter is kept when its per-row exporter was filtered out
+	assert.Contains(t, exports, "genesyscloud_outbound_contact_list_contacts")
+	assert.Contains(t, exports, "genesyscloud_architect_datatable")
+
+	exports = newExports()
+	(&GenesysCloudResourceExporter{exportRowsAsCsv: true}).selectRowExporters(exports)
+	assert.NotContains(t, exports, "genesyscloud_architect_datatable_row")
+	assert.Contains(t, exports, "genesyscloud_architect_datatable_rows")
+	assert.Contains(t, exports, "genesyscloud_outbound_contact_list_contacts")
+}
+
+func TestUnitTfExportCustomFileWriters(t *testing.T) {
+	settings := resourceExporter.CustomFileWriterSettings{EnabledByAttribute: "export_flows_as_yaml"}
+	exportSchema := ResourceTfExport().Schema
+
+	g := &GenesysCloudResourceExporter{d: schema.TestResourceDataRaw(t, exportSchema, map[string]interface{}{})}
+	assert.False(t, g.isFileWriterEnabled(settings))
+	assert.True(t, g.isFileWriterEnabled(resourceExporter.CustomFileWriterSettings{}))
+
+	g = &GenesysCloudResourceExporter{d: schema.TestResourceDataRaw(t, exportSchema, map[string]interface{}{"export_flows_as_yaml": true})}
+	assert.True(t, g.isFileWriterEnabled(settings))
+
+	// Exporters that only read the org write no files
+	g.skipFileWriters = true
+	assert.False(t, g.isFileWriterEnabled(settings))
+	assert.False(t, g.isFileWriterEnabled(resourceExporter.CustomFileWriterSettings{}))
+
+	// Only attributes that still reference their variable are kept
+	unresolved := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_flow", ResourceName: "flow", Name: "filepath"},
+		{ResourceType: "genesyscloud_flow", ResourceName: "flow", Name: "other"},
+	}
+	configMap := util.JsonMap{
+		"filepath": "flows/flow.yaml",
+		"other":    "${var.genesyscloud_flow_flow_other}",
+	}
+	assert.Equal(t, unresolved[1:], dropResolvedAttributes(unresolved, configMap))
+}
+
 func TestUnitResolveValueToDataSource(t *testing.T) {
 	var (
 		originalValueOfScriptId         = "1234"
@@ -582,6 +647,210 @@ func TestUnitResolveValueToDataSource(t *testing.T) {
 	}
 }
 
+// TestUnitTfExportIncrementalUnchangedState verifies that only objects with an unchanged version are reused from a previous export
+func TestUnitTfExportIncrementalUnchangedState(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), defaultTfStateFile)
+	tfstate := terraform.NewState()
+	tfstate.RootModule().Resources["genesyscloud_routing_skill.skill_a"] = &terraform.ResourceState{
+		Type: "genesyscloud_routing_skill",
+		Primary: &terraform.InstanceState{
+			ID:         "skill-a",
+			Attributes: map[string]string{"id": "skill-a", "name": "Skill A"},
+			Meta:       map[string]interface{}{exportVersionMetaKey: "3"},
+		},
+	}
+	tfstate.RootModule().Resources["genesyscloud_routing_skill.skill_b"] = &terraform.ResourceState{
+		Type: "genesyscloud_routing_skill",
+		Primary: &terraform.InstanceState{
+			ID:         "skill-b",
+			Attributes: map[string]string{"id": "skill-b", "name": "Skill B"},
+		},
+	}
+	data, err := json.Marshal(tfstate)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(stateFile, data, 0644))
+
+	previous, diagErr := readPreviousExport(stateFile, nil)
+	if diagErr != nil {
+		t.Fatalf("failed to read previous export: %v", diagErr)
+	}
+
+	state := previous.unchangedState("genesyscloud_routing_skill", "skill-a", &resourceExporter.ResourceMeta{Name: "Skill A", Version: "3"})
+	if assert.NotNil(t, state, "unchanged resource should be reused") {
+		assert.Equal(t, "Skill A", state.Attributes["name"])
+	}
+	assert.Nil(t, previous.unchangedState("genesyscloud_routing_skill", "skill-a", &resourceExporter.ResourceMeta{Name: "Skill A", Version: "4"}), "changed resource should be read again")
+	assert.Nil(t, previous.unchangedState("genesyscloud_routing_skill", "skill-b", &resourceExporter.ResourceMeta{Name: "Skill B", Version: "1"}), "resource without a previous version should be read again")
+	assert.Nil(t, previous.unchangedState("genesyscloud_routing_skill", "skill-c", &resourceExporter.ResourceMeta{Name: "Skill C", Version: "1"}), "new resource should be read")
+	assert.Nil(t, previous.unchangedState("genesyscloud_routing_skill", "skill-a", &resourceExporter.ResourceMeta{Name: "Skill A"}), "resource without a version should be read again")
+}
+
+func TestUnitTfExportImportBlocks(t *testing.T) {
+	imports := []importBlockInfo{
+		{ResourceType: "genesyscloud_routing_skill", ResourceName: "skill_b", Id: "skill-b"},
+		{ResourceType: "genesyscloud_routing_queue", ResourceName: "queue_a", Id: "queue-a"},
+		{ResourceType: "genesyscloud_routing_skill", ResourceName: "skill_a", Id: "skill-a"},
+	}
+
+	expectedHCL := `import {
+  to = genesyscloud_routing_queue.queue_a
+  id = "queue-a"
+}
+
+import {
+  to = genesyscloud_routing_skill.skill_a
+  id = "skill-a"
+}
+
+import {
+  to = genesyscloud_routing_skill.skill_b
+  id = "skill-b"
+}
+`
+	dirPath := t.TempDir()
+	if diagErr := NewImportBlocksWriter(imports, nil, dirPath, true).writeImportBlocks(); diagErr != nil {
+		t.Fatalf("failed to write HCL import blocks: %v", diagErr)
+	}
+	hclBytes, err := os.ReadFile(filepath.Join(dirPath, defaultTfHCLImportsFile))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHCL, strings.TrimSuffix(string(hclBytes), "\n"))
+
+	if diagErr := NewImportBlocksWriter(imports, nil, dirPath, false).writeImportBlocks(); diagErr != nil {
+		t.Fatalf("failed to write JSON import blocks: %v", diagErr)
+	}
+	jsonBytes, err := os.ReadFile(filepath.Join(dirPath, defaultTfJSONImportsFile))
+	assert.NoError(t, err)
+
+	var importsJson map[string][]map[string]string
+	assert.NoError(t, json.Unmarshal(jsonBytes, &importsJson))
+	assert.Equal(t, []map[string]string{
+		{"to": "genesyscloud_routing_queue.queue_a", "id": "queue-a"},
+		{"to": "genesyscloud_routing_skill.skill_a", "id": "skill-a"},
+		{"to": "genesyscloud_routing_skill.skill_b", "id": "skill-b"},
+	}, importsJson["import"])
+}
+
+func TestUnitTfExportModuleLayout(t *testing.T) {
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_auth_division": {
+			"sales": util.JsonMap{"name": "Sales"},
+		},
+		"genesyscloud_routing_skill": {
+			"skill_a": util.JsonMap{"name": "Skill A"},
+		},
+		"genesyscloud_routing_queue": {
+			"queue_a": util.JsonMap{
+				"name":        "Queue A",
+				"division_id": "${genesyscloud_auth_division.sales.id}",
+				"skill_ids":   []interface{}{"${genesyscloud_routing_skill.skill_a.id}"},
+				"depends_on":  []interface{}{"$dep$genesyscloud_routing_skill.skill_a$dep$"},
+			},
+		},
+	}
+	dataSourceTypesMaps := map[string]resourceJSONMaps{}
+
+	assignments := assignResourceModules(moduleLayoutDivision, nil, resourceTypesMaps, dataSourceTypesMaps)
+	assert.Equal(t, map[string]string{
+		"genesyscloud_auth_division.sales":   "sales",
+		"genesyscloud_routing_skill.skill_a": sharedModuleName,
+		"genesyscloud_routing_queue.queue_a": "sales",
+	}, assignments)
+
+	familyAssignments := assignResourceModules(moduleLayoutResourceFamily, map[string]string{
+		"genesyscloud_routing_queue": "queues",
+		"genesyscloud_auth_*":        "auth",
+	}, resourceTypesMaps, dataSourceTypesMaps)
+	assert.Equal(t, map[string]string{
+		"genesyscloud_auth_division.sales":   "auth",
+		"genesyscloud_routing_skill.skill_a": "routing",
+		"genesyscloud_routing_queue.queue_a": "queues",
+	}, familyAssignments)
+
+	dirPath := t.TempDir()
+	moduleExporter := NewModuleHCLExporter(resourceTypesMaps, dataSourceTypesMaps, nil, assignments, "mypurecloud/genesyscloud", "0.1.0", dirPath, false)
+	if diagErr := moduleExporter.exportHCLModules(); diagErr != nil {
+		t.Fatalf("failed to export modules: %v", diagErr)
+	}
+
+	salesConfig, err := os.ReadFile(filepath.Join(dirPath, modulesSubDirectory, "sales", defaultTfHCLModuleFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(salesConfig), `"${var.genesyscloud_routing_skill_skill_a_id}"`)
+	assert.Contains(t, string(salesConfig), `"${genesyscloud_auth_division.sales.id}"`)
+	assert.NotContains(t, string(salesConfig), "depends_on")
+
+	salesVariables, err := os.ReadFile(filepath.Join(dirPath, modulesSubDirectory, "sales", defaultTfHCLVariablesFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(salesVariables), `variable "genesyscloud_routing_skill_skill_a_id"`)
+
+	sharedOutputs, err := os.ReadFile(filepath.Join(dirPath, modulesSubDirectory, sharedModuleName, defaultTfHCLOutputFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(sharedOutputs), "value = genesyscloud_routing_skill.skill_a.id")
+
+	rootConfig, err := os.ReadFile(filepath.Join(dirPath, defaultTfHCLFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(rootConfig), `source                                = "./modules/sales"`)
+	assert.Contains(t, string(rootConfig), "genesyscloud_routing_skill_skill_a_id = module.shared.genesyscloud_routing_skill_skill_a_id")
+}
+
+func TestUnitTfExportGitCommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git CLI is not available")
+	}
+
+	repoDir := t.TempDir()
+	exportDir := filepath.Join(repoDir, "export")
+	assert.NoError(t, os.MkdirAll(exportDir, os.ModePerm))
+	runGit := func(args ...string) string {
+		out, err := exec.Command("git", append([]string{"-C", repoDir}, args...)...).CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v %s", args, err, out)
+		}
+		return string(out)
+	}
+	runGit("init", "--quiet")
+	runGit("config", "user.name", "Export Test")
+	runGit("config", "user.email", "export@example.com")
+
+	export := func(resourceTypesMaps map[string]resourceJSONMaps, resourceIds map[string]string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(exportDir, defaultTfJSONFile), []byte(fmt.Sprintf("%v", resourceTypesMaps)), 0644))
+		if diagErr := NewGitCommitWriter(context.Background(), resourceTypesMaps, resourceIds, exportDir, defaultGitCommitSubject).commitExport(); diagErr != nil {
+			t.Fatalf("failed to commit export: %v", diagErr)
+		}
+	}
+
+	export(map[string]resourceJSONMaps{
+		"genesyscloud_routing_skill": {
+			"skill_a": util.JsonMap{"name": "Skill A"},
+			"skill_b": util.JsonMap{"name": "Skill B"},
+		},
+	}, map[string]string{"genesyscloud_routing_skill.skill_a": "a", "genesyscloud_routing_skill.skill_b": "b"})
+	assert.Equal(t, defaultGitCommitSubject+": 2 added, 0 changed, 0 removed", strings.TrimSpace(runGit("log", "-1", "--format=%s")))
+
+	export(map[string]resourceJSONMaps{
+		"genesyscloud_routing_skill": {
+			"skill_a": util.JsonMap{"name": "Skill A Renamed"},
+			"skill_c": util.JsonMap{"name": "Skill C"},
+		},
+	}, map[string]string{"genesyscloud_routing_skill.skill_a": "a", "genesyscloud_routing_skill.skill_c": "c"})
+
+	var summary ExportChangeSummary
+	assert.NoError(t, json.Unmarshal([]byte(runGit("log", "-1", "--format=%b")), &summary))
+	assert.Equal(t, ExportChangeSummary{
+		Added:   []string{"genesyscloud_routing_skill.skill_c"},
+		Changed: []string{"genesyscloud_routing_skill.skill_a"},
+		Removed: []string{"genesyscloud_routing_skill.skill_b"},
+	}, summary)
+
+	// An unchanged export does not create a commit
+	export(map[string]resourceJSONMaps{
+		"genesyscloud_routing_skill": {
+			"skill_a": util.JsonMap{"name": "Skill A Renamed"},
+			"skill_c": util.JsonMap{"name": "Skill C"},
+		},
+	}, map[string]string{"genesyscloud_routing_skill.skill_a": "a", "genesyscloud_routing_skill.skill_c": "c"})
+	assert.Equal(t, "2", strings.TrimSpace(runGit("rev-list", "--count", "HEAD")))
+}
+
 func setupGenesysCloudResourceExporter(t *testing.T) *GenesysCloudResourceExporter {
 	exportMap := map[string]interface{}{
 		"export_as_hcl":                false,
@@ -615,3 +884,468 @@ func getMockCampaignConfig(originalValueOfScriptId string) map[string]any {
 
 	return config
 }
+
+func TestUnitDriftReportCompareConfigs(t *testing.T) {
+	exportDir := t.TempDir()
+	exported := map[string]interface{}{
+		"resource": map[string]interface{}{
+			"genesyscloud_routing_skill": map[string]interface{}{
+				"skill_a": map[string]interface{}{"name": "Skill A", "depends_on": []string{"genesyscloud_routing_skill.skill_b"}},
+				"skill_b": map[string]interface{}{"name": "Skill B"},
+				"skill_d": map[string]interface{}{"name": "Skill D"},
+			},
+		},
+	}
+	data, err := json.Marshal(exported)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(exportDir, defaultTfJSONFile), data, 0644))
+
+	code, _, isJSON, diagErr := readExportedConfig(exportDir)
+	if diagErr != nil {
+		t.Fatalf("failed to read exported config: %v", diagErr)
+	}
+	assert.True(t, isJSON)
+
+	org, err := flattenLiveConfig(map[string]resourceJSONMaps{
+		"genesyscloud_routing_skill": {
+			"skill_a": util.JsonMap{"name": "Skill A Renamed"},
+			"skill_b": util.JsonMap{"name": "Skill B"},
+			"skill_c": util.JsonMap{"name": "Skill C"},
+		},
+	}, nil, isJSON)
+	assert.NoError(t, err)
+
+	report := compareConfigs(code, org)
+	assert.True(t, report.hasDrift())
+	assert.Equal(t, []string{"genesyscloud_routing_skill.skill_c"}, report.Unmanaged)
+	assert.Equal(t, []string{"genesyscloud_routing_skill.skill_d"}, report.Missing)
+	assert.Len(t, report.Changed, 1)
+	assert.Equal(t, "genesyscloud_routing_skill.skill_a", report.Changed[0].Address)
+	assert.Len(t, report.Changed[0].Attributes, 1)
+	assert.Equal(t, "name", report.Changed[0].Attributes[0].Attribute)
+	assert.Equal(t, `"Skill A"`, *report.Changed[0].Attributes[0].Code)
+	assert.Equal(t, `"Skill A Renamed"`, *report.Changed[0].Attributes[0].Org)
+
+	markdown, err := report.format(driftReportFormatMarkdown)
+	assert.NoError(t, err)
+	assert.Contains(t, markdown, "## Resources not in code (1)")
+	assert.Contains(t, markdown, "- `genesyscloud_routing_skill.skill_c`")
+}
+
+func TestUnitDriftReportModuleLayout(t *testing.T) {
+	exportDir := t.TempDir()
+	moduleDir := filepath.Join(exportDir, modulesSubDirectory, "routing")
+	assert.NoError(t, os.MkdirAll(moduleDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(exportDir, defaultTfVarsFile), []byte(`genesyscloud_routing_queue_sales_calling_party_number = "+13175550100"`+"\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(moduleDir, defaultTfHCLModuleFile), []byte(`
+data "genesyscloud_telephony_providers_edges_site" "PureCloud_Voice_-_AWS" {
+  name = "PureCloud Voice - AWS"
+}
+
+resource "genesyscloud_routing_queue" "sales" {
+  name                 = "Sales"
+  division_id          = "${var.genesyscloud_auth_division_sales_id}"
+  calling_party_number = "${var.genesyscloud_routing_queue_sales_calling_party_number}"
+}
+`), 0644))
+	authModuleDir := filepath.Join(exportDir, modulesSubDirectory, "auth")
+	assert.NoError(t, os.MkdirAll(authModuleDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(authModuleDir, defaultTfHCLOutputFile), []byte(`
+output "genesyscloud_auth_division_sales_id" {
+  value = genesyscloud_auth_division.sales.id
+}
+`), 0644))
+
+	code, variables, isJSON, diagErr := readExportedConfig(exportDir)
+	if diagErr != nil {
+		t.Fatalf("failed to read exported config: %v", diagErr)
+	}
+	assert.False(t, isJSON)
+
+	org, err := flattenLiveConfig(map[string]resourceJSONMaps{
+		"genesyscloud_routing_queue": {
+			"sales": util.JsonMap{"name": "Sales", "division_id": "${genesyscloud_auth_division.sales.id}", "calling_party_number": "+13175550100"},
+		},
+	}, map[string]resourceJSONMaps{
+		"genesyscloud_telephony_providers_edges_site": {
+			"PureCloud_Voice_-_AWS": util.JsonMap{"name": "PureCloud Voice - AWS"},
+		},
+	}, isJSON)
+	assert.NoError(t, err)
+
+	variables.resolve(code)
+	variables.resolve(org)
+	report := compareConfigs(code, org)
+	assert.False(t, report.hasDrift(), "%+v", report)
+}
+
+func TestUnitTfExportDependencyGraph(t *testing.T) {
+	newResource := func(resType, id, name string) resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{Name: name, Type: resType, State: &terraform.InstanceState{ID: id}}
+	}
+	resources := []resourceExporter.ResourceInfo{
+		newResource("genesyscloud_flow", "flow-a", "inboundcall_A"),
+		newResource("genesyscloud_flow", "flow-b", "commonmodule_B"),
+		newResource("genesyscloud_flow", "flow-c", "commonmodule_C"),
+		newResource("genesyscloud_routing_queue", "queue-1", "Sales"),
+	}
+	dependsList := map[string][]string{
+		"flow-a": {"genesyscloud_flow.flow-b", "genesyscloud_routing_queue.queue-1", "genesyscloud_architect_user_prompt.prompt-1"},
+		"flow-b": {"genesyscloud_flow.flow-c"},
+		"flow-c": {"genesyscloud_flow.flow-b"},
+	}
+
+	graph := buildDependencyGraph(resources, dependsList)
+
+	assert.Len(t, graph.Nodes, 5)
+	assert.Equal(t, []string{"genesyscloud_architect_user_prompt.prompt-1", "genesyscloud_flow.commonmodule_B", "genesyscloud_routing_queue.Sales"},
+		graph.Adjacency["genesyscloud_flow.inboundcall_A"])
+	assert.Equal(t, [][]string{{"genesyscloud_flow.commonmodule_B", "genesyscloud_flow.commonmodule_C"}}, graph.Cycles)
+	for _, node := range graph.Nodes {
+		assert.Equal(t, strings.HasPrefix(node.Address, "genesyscloud_flow.commonmodule_"), node.Cyclic, node.Address)
+	}
+
+	dot := graph.toDot()
+	assert.True(t, strings.HasPrefix(dot, "digraph genesyscloud {"))
+	assert.Contains(t, dot, `"genesyscloud_flow.commonmodule_B" -> "genesyscloud_flow.commonmodule_C" [color="red", penwidth=2];`)
+	assert.Contains(t, dot, `"genesyscloud_flow.inboundcall_A" -> "genesyscloud_routing_queue.Sales";`)
+	assert.Contains(t, dot, fmt.Sprintf(`"genesyscloud_routing_queue.Sales" [fillcolor=%q, tooltip="queue-1"];`, dependencyGraphColor("genesyscloud_routing_queue")))
+
+	// Resources of different types that share an ID are different nodes
+	resources = append(resources,
+		newResource("genesyscloud_user", "user-1", "Jane"),
+		newResource("genesyscloud_user_roles", "user-1", "Jane"),
+	)
+	dependsList["flow-c"] = append(dependsList["flow-c"], "genesyscloud_user.user-1")
+	sharedIdGraph := buildDependencyGraph(resources, dependsList)
+	assert.Len(t, sharedIdGraph.Nodes, 7)
+	assert.Equal(t, []string{"genesyscloud_flow.commonmodule_B", "genesyscloud_user.Jane"}, sharedIdGraph.Adjacency["genesyscloud_flow.commonmodule_C"])
+	assert.Empty(t, sharedIdGraph.Adjacency["genesyscloud_user_roles.Jane"])
+
+	graphJson, err := graph.toJson()
+	assert.Nil(t, err)
+	var decoded dependencyGraph
+	assert.Nil(t, json.Unmarshal([]byte(graphJson), &decoded))
+	assert.Equal(t, graph.Adjacency, decoded.Adjacency)
+	assert.Equal(t, graph.Cycles, decoded.Cycles)
+}
+
+func TestUnitTfExportReadsInExportMode(t *testing.T) {
+	var (
+		mutex      sync.Mutex
+		exportMode []bool
+	)
+	record := func(ctx context.Context) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		exportMode = append(exportMode, tfexporter_state.IsExporterActiveContext(ctx))
+	}
+
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			record(ctx)
+			_ = d.Set("name", "one")
+			return nil
+		},
+	}
+	exporter := &resourceExporter.ResourceExporter{
+		GetResourcesFunc: func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+			record(ctx)
+			return resourceExporter.ResourceIDMetaMap{"id-1": {Name: "one"}}, nil
+		},
+	}
+	exporters := map[string]*resourceExporter.ResourceExporter{"genesyscloud_test": exporter}
+	testProvider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{"genesyscloud_test": testResource}}
+
+	// The dependency graph and drift report read the org without activating the process wide exporter state
+	if tfexporter_state.IsExporterActive() {
+		t.Skip("the process wide exporter state was activated by an earlier export")
+	}
+	g := &GenesysCloudResourceExporter{ctx: context.Background(), exporters: &exporters}
+	assert.Nil(t, g.buildSanitizedResourceMaps(exporters, nil, false))
+	resources, diagErr := g.getResourcesForType("genesyscloud_test", testProvider, exporter, nil)
+	assert.Nil(t, diagErr)
+	assert.Len(t, resources, 1)
+	assert.Equal(t, []bool{true, true}, exportMode, "the resources should be listed and read in export mode")
+}
+
+func TestUnitTfExportClosureWalk(t *testing.T) {
+	queue := closureNode{ResourceType: "genesyscloud_routing_queue", Id: "queue-1"}
+	flow := closureNode{ResourceType: "genesyscloud_flow", Id: "flow-1"}
+	prompt := closureNode{ResourceType: "genesyscloud_architect_user_prompt", Id: "prompt-1"}
+	user := closureNode{ResourceType: "genesyscloud_user", Id: "user-1"}
+	otherQueue := closureNode{ResourceType: "genesyscloud_routing_queue", Id: "queue-2"}
+	division := closureNode{ResourceType: "genesyscloud_auth_division", Id: "division-1"}
+
+	// The flow transfers to the queue, and the queue shares a member with another queue
+	graph := map[closureNode]closureEdges{
+		queue:      {dependencies: []closureNode{user, division}, dependents: []closureNode{flow}},
+		flow:       {dependencies: []closureNode{queue, prompt, division}},
+		prompt:     {},
+		user:       {dependencies: []closureNode{division}, dependents: []closureNode{otherQueue}},
+		otherQueue: {dependencies: []closureNode{user}},
+		division:   {},
+	}
+	expand := func(level []closureItem) (map[closureNode]closureEdges, diag.Diagnostics) {
+		edges := make(map[closureNode]closureEdges)
+		for _, item := range level {
+			nodeEdges := graph[item.node]
+			if !item.followDependents {
+				nodeEdges.dependents = nil
+			}
+			edges[item.node] = nodeEdges
+		}
+		return edges, nil
+	}
+
+	// Dependents of the user are not followed as it was only reached as a dependency
+	closure, diagErr := walkExportClosure([]closureNode{queue}, 3, expand)
+	assert.Nil(t, diagErr)
+	assert.ElementsMatch(t, []closureNode{queue, flow, prompt, user, division}, closure)
+
+	closure, diagErr = walkExportClosure([]closureNode{queue}, 1, expand)
+	assert.Nil(t, diagErr)
+	assert.ElementsMatch(t, []closureNode{queue, flow, user, division}, closure)
+
+	closure, diagErr = walkExportClosure([]closureNode{queue}, 0, expand)
+	assert.Nil(t, diagErr)
+	assert.ElementsMatch(t, []closureNode{queue}, closure)
+
+	// Dependents of a seed are followed even when another seed depends on it
+	closure, diagErr = walkExportClosure([]closureNode{queue, user}, 1, expand)
+	assert.Nil(t, diagErr)
+	assert.ElementsMatch(t, []closureNode{queue, flow, user, otherQueue, division}, closure)
+
+	refAttrs := map[string]*resourceExporter.RefAttrSettings{
+		"members.user_id": {RefType: "genesyscloud_user"},
+		"division_id":     {RefType: "genesyscloud_auth_division", AltValues: []string{"*"}},
+	}
+	state := &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{
+		"members.#":         "2",
+		"members.0.user_id": "user-1",
+		"members.1.user_id": "user-2",
+		"division_id":       "*",
+		"name":              "Sales",
+	}}
+	assert.Equal(t, []closureNode{
+		{ResourceType: "genesyscloud_user", Id: "user-1"},
+		{ResourceType: "genesyscloud_user", Id: "user-2"},
+	}, referencedResources(state, refAttrs))
+
+	_, errs := validateClosureSeed("genesyscloud_routing_queue::queue-1", "closure_seed_resources")
+	assert.Empty(t, errs)
+	_, errs = validateClosureSeed("genesyscloud_routing_queue", "closure_seed_resources")
+	assert.Len(t, errs, 1)
+}
+
+func TestUnitTfExportPromotionMapping(t *testing.T) {
+	testResourceType := "test_resource_type"
+	testResourceName := "test_resource_name"
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"email": {Type: schema.TypeString},
+			"name":  {Type: schema.TypeString},
+			"edge_id": {
+				Type:        schema.TypeString,
+				Description: "Edge ID",
+			},
+			"addresses": {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+	testExporter := &resourceExporter.ResourceExporter{
+		UnResolvableAttributes: map[string]*schema.Schema{"edge_id": testResource.Schema["edge_id"]},
+	}
+
+	mappingFile := filepath.Join(t.TempDir(), "mapping.json")
+	assert.Nil(t, os.WriteFile(mappingFile, []byte(`{
+		"test_resource_type": {
+			"email": { "jane@dev.example.com": { "test": "jane@test.example.com", "prod": "jane@example.com" } },
+			"edge_id": { "edge-dev": { "prod": "edge-prod" } },
+			"addresses.number": {
+				"+13175550001": { "prod": "+13175559001" },
+				"+13175550002": { "prod": "+13175559002" }
+			}
+		}
+	}`), 0644))
+	mapping, diagErr := loadPromotionMapping(mappingFile)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, []string{"prod", "test"}, mapping.environments())
+
+	exportDir := t.TempDir()
+	gre := GenesysCloudResourceExporter{
+		exportAsHCL:      true,
+		exportDirPath:    exportDir,
+		promotionMapping: mapping,
+		exporters: &map[string]*resourceExporter.ResourceExporter{
+			testResourceType: testExporter,
+		},
+		resources: []resourceExporter.ResourceInfo{
+			{
+				Name: testResourceName,
+				Type: testResourceType,
+				State: &terraform.InstanceState{
+					ID: "resource-1",
+					Attributes: map[string]string{
+						"email":              "jane@dev.example.com",
+						"name":               "Jane",
+						"edge_id":            "edge-dev",
+						"addresses.#":        "2",
+						"addresses.0.number": "+13175550001",
+						"addresses.1.number": "+13175550002",
+					},
+				},
+				CtyType: testResource.CoreConfigSchema().ImpliedType(),
+			},
+		},
+	}
+	assert.Nil(t, gre.buildResourceConfigMap())
+
+	configMap := gre.resourceTypesMaps[testResourceType][testResourceName]
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_email}", configMap["email"])
+	assert.Equal(t, "Jane", configMap["name"])
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_edge_id}", configMap["edge_id"])
+	addresses := configMap["addresses"].([]interface{})
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_addresses_number}", addresses[0].(map[string]interface{})["number"])
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_addresses_number_2}", addresses[1].(map[string]interface{})["number"])
+
+	varValues := make(map[string]interface{})
+	for _, attr := range gre.unresolvedAttrs {
+		varValues[createUnresolvedAttrKey(attr)] = unresolvedAttrVarValue(attr)
+	}
+	assert.Equal(t, map[string]interface{}{
+		"test_resource_type_test_resource_name_email":              "jane@dev.example.com",
+		"test_resource_type_test_resource_name_edge_id":            "edge-dev",
+		"test_resource_type_test_resource_name_addresses_number":   "+13175550001",
+		"test_resource_type_test_resource_name_addresses_number_2": "+13175550002",
+	}, varValues)
+
+	assert.Nil(t, gre.writePromotionTfVars())
+	prodVars, err := os.ReadFile(filepath.Join(exportDir, "prod.tfvars"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(prodVars), `test_resource_type_test_resource_name_email = "jane@example.com"`)
+	assert.Contains(t, string(prodVars), `test_resource_type_test_resource_name_edge_id = "edge-prod"`)
+	assert.Contains(t, string(prodVars), `test_resource_type_test_resource_name_addresses_number_2 = "+13175559002"`)
+	testVars, err := os.ReadFile(filepath.Join(exportDir, "test.tfvars"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(testVars), `test_resource_type_test_resource_name_email = "jane@test.example.com"`)
+	assert.NotContains(t, string(testVars), "edge_id")
+
+	assert.Contains(t, string(createHCLVariablesBlock(gre.unresolvedAttrs)), `variable "test_resource_type_test_resource_name_addresses_number_2"`)
+}
+
+func TestUnitTfExportSecretAttributes(t *testing.T) {
+	testResourceType := "test_resource_type"
+	testResourceName := "test_resource_name"
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString, Optional: true},
+			"password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"fields": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"token": {Type: schema.TypeString, Computed: true, Sensitive: true},
+			"settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url":    {Type: schema.TypeString, Optional: true},
+						"secret": {Type: schema.TypeString, Optional: true, Sensitive: true},
+					},
+				},
+			},
+		},
+	}
+	testExporter := &resourceExporter.ResourceExporter{
+		UnResolvableAttributes: map[string]*schema.Schema{"fields": testResource.Schema["fields"]},
+	}
+
+	entity, err := openpgp.NewEntity("Export", "", "export@example.com", nil)
+	assert.Nil(t, err)
+	var publicKey bytes.Buffer
+	armorWriter, err := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, entity.Serialize(armorWriter))
+	assert.Nil(t, armorWriter.Close())
+	keyRing, diagErr := readSecretsKeyRing(publicKey.String())
+	assert.Nil(t, diagErr)
+	_, diagErr = readSecretsKeyRing("not a key")
+	assert.NotNil(t, diagErr)
+
+	exportDir := t.TempDir()
+	gre := GenesysCloudResourceExporter{
+		exportAsHCL:    true,
+		exportDirPath:  exportDir,
+		secretsKeyRing: keyRing,
+		provider:       &schema.Provider{ResourcesMap: map[string]*schema.Resource{testResourceType: testResource}},
+		exporters: &map[string]*resourceExporter.ResourceExporter{
+			testResourceType: testExporter,
+		},
+		resources: []resourceExporter.ResourceInfo{
+			{
+				Name: testResourceName,
+				Type: testResourceType,
+				State: &terraform.InstanceState{
+					ID: "resource-1",
+					Attributes: map[string]string{
+						"name":              "Test",
+						"password":          "hunter2",
+						"fields.%":          "1",
+						"fields.apiKey":     "abc123",
+						"token":             "computed",
+						"settings.#":        "1",
+						"settings.0.url":    "https://example.com",
+						"settings.0.secret": "s3cret",
+					},
+				},
+				CtyType: testResource.CoreConfigSchema().ImpliedType(),
+			},
+		},
+	}
+	assert.Nil(t, gre.buildResourceConfigMap())
+
+	configMap := gre.resourceTypesMaps[testResourceType][testResourceName]
+	assert.Equal(t, "Test", configMap["name"])
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_password}", configMap["password"])
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_fields}", configMap["fields"])
+	settings := configMap["settings"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "https://example.com", settings["url"])
+	assert.Equal(t, "${var.test_resource_type_test_resource_name_settings_secret}", settings["secret"])
+
+	secretValues := make(map[string]interface{})
+	for _, attr := range gre.unresolvedAttrs {
+		assert.True(t, attr.Secret, createUnresolvedAttrKey(attr))
+		secretValues[createUnresolvedAttrKey(attr)] = attr.SecretValue
+	}
+	assert.Equal(t, map[string]interface{}{
+		"test_resource_type_test_resource_name_password":        "hunter2",
+		"test_resource_type_test_resource_name_fields":          map[string]interface{}{"apiKey": "abc123"},
+		"test_resource_type_test_resource_name_settings_secret": "s3cret",
+	}, secretValues)
+	assert.Contains(t, string(createHCLVariablesBlock(gre.unresolvedAttrs)), "sensitive = true")
+
+	assert.Nil(t, gre.writeSecretsTfVars())
+	encrypted, err := os.Open(filepath.Join(exportDir, secretsTfVarsFile))
+	assert.Nil(t, err)
+	defer encrypted.Close()
+	block, err := armor.Decode(encrypted)
+	assert.Nil(t, err)
+	message, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{entity}, nil, nil)
+	assert.Nil(t, err)
+	decrypted, err := io.ReadAll(message.UnverifiedBody)
+	assert.Nil(t, err)
+	assert.Contains(t, string(decrypted), `test_resource_type_test_resource_name_password = "hunter2"`)
+	assert.Contains(t, string(decrypted), `test_resource_type_test_resource_name_settings_secret = "s3cret"`)
+	assert.Contains(t, string(decrypted), `apiKey = "abc123"`)
+}
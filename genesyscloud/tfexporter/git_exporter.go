@@ -0,0 +1,192 @@
+package tfexporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains all of the code used to commit an export to a local git repository. A manifest of the exported resources is
+written along with the config and compared with the manifest of the previous commit to summarize the resources that were added,
+changed and removed since the last export. The summary is used as the commit message so the history of an org can be reviewed with git.
+*/
+
+const (
+	defaultExportManifestFile = "export_manifest.json"
+	defaultGitCommitSubject   = "Genesys Cloud export"
+)
+
+// exportManifest maps the address of every exported resource to its ID and a hash of its config
+type exportManifest map[string]exportManifestEntry
+
+type exportManifestEntry struct {
+	Id   string `json:"id"`
+	Hash string `json:"hash"`
+}
+
+// ExportChangeSummary is the machine-readable body of an export commit
+type ExportChangeSummary struct {
+	Added   []string `json:"added"`
+	Changed []string `json:"changed"`
+	Removed []string `json:"removed"`
+}
+
+type GitCommitWriter struct {
+	ctx               context.Context
+	resourceTypesMaps map[string]resourceJSONMaps
+	resourceIds       map[string]string
+	dirPath           string
+	subject           string
+}
+
+func NewGitCommitWriter(ctx context.Context, resourceTypesMaps map[string]resourceJSONMaps, resourceIds map[string]string, dirPath string, subject string) *GitCommitWriter {
+	gitWriter := &GitCommitWriter{
+		ctx:               ctx,
+		resourceTypesMaps: resourceTypesMaps,
+		resourceIds:       resourceIds,
+		dirPath:           dirPath,
+		subject:           subject,
+	}
+	return gitWriter
+}
+
+func (w *GitCommitWriter) commitExport() diag.Diagnostics {
+	repoRoot, err := w.git(w.dirPath, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return diag.Errorf("Export directory %s is not inside a git working tree: %v", w.dirPath, err)
+	}
+	repoRoot = strings.TrimSpace(repoRoot)
+
+	exportDir, err := filepath.EvalSymlinks(w.dirPath)
+	if err != nil {
+		return diag.Errorf("Failed to resolve export directory %s: %v", w.dirPath, err)
+	}
+	relDir, err := filepath.Rel(repoRoot, exportDir)
+	if err != nil {
+		return diag.Errorf("Failed to determine path of %s in git repository %s: %v", exportDir, repoRoot, err)
+	}
+	manifestPath := filepath.ToSlash(filepath.Join(relDir, defaultExportManifestFile))
+
+	// The manifest does not exist in the repository before the first export
+	previous := make(exportManifest)
+	if previousManifest, err := w.git(repoRoot, "show", "HEAD:"+manifestPath); err == nil {
+		if err := json.Unmarshal([]byte(previousManifest), &previous); err != nil {
+			log.Printf("Ignoring unreadable export manifest in previous commit: %v", err)
+		}
+	}
+
+	current, diagErr := buildExportManifest(w.resourceTypesMaps, w.resourceIds)
+	if diagErr != nil {
+		return diagErr
+	}
+	manifestBytes, err := json.MarshalIndent(current, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode export manifest: %v", err)
+	}
+	if diagErr := files.WriteToFile(manifestBytes, filepath.Join(exportDir, defaultExportManifestFile)); diagErr != nil {
+		return diagErr
+	}
+
+	summary := compareExportManifests(previous, current)
+	message, err := createCommitMessage(w.subject, summary)
+	if err != nil {
+		return diag.Errorf("Failed to create commit message: %v", err)
+	}
+
+	if _, err := w.git(repoRoot, "add", "--all", "--", relDir); err != nil {
+		return diag.Errorf("Failed to stage export in git repository %s: %v", repoRoot, err)
+	}
+	if _, err := w.git(repoRoot, "diff", "--cached", "--quiet", "--", relDir); err == nil {
+		log.Printf("Export in %s has not changed since the previous commit. Skipping commit.", relDir)
+		return nil
+	}
+
+	log.Printf("Committing export to git repository %s: %s", repoRoot, strings.SplitN(message, "\n", 2)[0])
+	if _, err := w.git(repoRoot, "commit", "--quiet", "--message", message, "--", relDir); err != nil {
+		return diag.Errorf("Failed to commit export to git repository %s: %v", repoRoot, err)
+	}
+	return nil
+}
+
+func (w *GitCommitWriter) git(dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(w.ctx, "git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %v %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// buildExportManifest hashes the config of every exported resource. Placeholders of jsonencoded attributes are replaced
+// with their values first, as the placeholder IDs are different on every export.
+func buildExportManifest(resourceTypesMaps map[string]resourceJSONMaps, resourceIds map[string]string) (exportManifest, diag.Diagnostics) {
+	manifest := make(exportManifest)
+	for resType, resources := range resourceTypesMaps {
+		for resName, config := range resources {
+			configBytes, err := json.Marshal(config)
+			if err != nil {
+				return nil, diag.Errorf("Failed to encode config of %s.%s: %v", resType, resName, err)
+			}
+			configStr := string(configBytes)
+			for placeholderId, val := range attributesDecoded {
+				configStr = strings.Replace(configStr, placeholderId, val, -1)
+			}
+
+			address := resType + "." + resName
+			hash := sha256.Sum256([]byte(configStr))
+			manifest[address] = exportManifestEntry{
+				Id:   resourceIds[address],
+				Hash: hex.EncodeToString(hash[:]),
+			}
+		}
+	}
+	return manifest, nil
+}
+
+func compareExportManifests(previous exportManifest, current exportManifest) ExportChangeSummary {
+	summary := ExportChangeSummary{
+		Added:   make([]string, 0),
+		Changed: make([]string, 0),
+		Removed: make([]string, 0),
+	}
+	for address, entry := range current {
+		previousEntry, ok := previous[address]
+		if !ok {
+			summary.Added = append(summary.Added, address)
+		} else if previousEntry.Hash != entry.Hash {
+			summary.Changed = append(summary.Changed, address)
+		}
+	}
+	for address := range previous {
+		if _, ok := current[address]; !ok {
+			summary.Removed = append(summary.Removed, address)
+		}
+	}
+	sort.Strings(summary.Added)
+	sort.Strings(summary.Changed)
+	sort.Strings(summary.Removed)
+	return summary
+}
+
+// createCommitMessage returns a one line summary followed by the JSON encoded change summary
+func createCommitMessage(subject string, summary ExportChangeSummary) (string, error) {
+	body, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s: %d added, %d changed, %d removed\n\n%s\n", subject, len(summary.Added), len(summary.Changed), len(summary.Removed), body), nil
+}
@@ -98,13 +98,17 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		tfVars := make(map[string]interface{})
 		keys := make(map[string]string)
 		for _, attr := range h.unresolvedAttrs {
+			if attr.Secret {
+				// Secret values are only written to the encrypted secrets file
+				continue
+			}
 			key := createUnresolvedAttrKey(attr)
 			if keys[key] != "" {
 				continue
 			}
 			keys[key] = key
 
-			tfVars[key] = determineVarValue(attr.Schema)
+			tfVars[key] = unresolvedAttrVarValue(attr)
 		}
 
 		tfVarsFilePath := filepath.Join(h.dirPath, defaultTfVarsFile)
@@ -0,0 +1,138 @@
+package tfexporter
+
+import (
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the code used to write Terraform 1.5+ import blocks for the exported resources. The import blocks are
+written next to the exported config so that an org can be adopted with the standard plan/apply workflow instead of a tfstate file.
+*/
+
+type importBlockInfo struct {
+	Module       string
+	ResourceType string
+	ResourceName string
+	Id           string
+}
+
+type ImportBlocksWriter struct {
+	imports           []importBlockInfo
+	moduleAssignments map[string]string
+	dirPath           string
+	exportAsHCL       bool
+}
+
+func NewImportBlocksWriter(imports []importBlockInfo, moduleAssignments map[string]string, dirPath string, exportAsHCL bool) *ImportBlocksWriter {
+	importWriter := &ImportBlocksWriter{
+		imports:           imports,
+		moduleAssignments: moduleAssignments,
+		dirPath:           dirPath,
+		exportAsHCL:       exportAsHCL,
+	}
+	return importWriter
+}
+
+func (i *ImportBlocksWriter) writeImportBlocks() diag.Diagnostics {
+	imports := sortImportBlocks(i.imports)
+
+	// Import blocks can only be declared in the root module, so resources exported into child modules are addressed through their module
+	for index, info := range imports {
+		if moduleName, ok := i.moduleAssignments[info.ResourceType+"."+info.ResourceName]; ok {
+			imports[index].Module = moduleName
+		}
+	}
+
+	if i.exportAsHCL {
+		importsFilePath := filepath.Join(i.dirPath, defaultTfHCLImportsFile)
+		log.Printf("Writing %d import blocks to %s", len(imports), importsFilePath)
+		return writeHCLToFile([][]byte{createHCLImportBlocks(imports)}, importsFilePath)
+	}
+
+	importsFilePath := filepath.Join(i.dirPath, defaultTfJSONImportsFile)
+	log.Printf("Writing %d import blocks to %s", len(imports), importsFilePath)
+	return writeConfig(map[string]interface{}{
+		"import": createImportsJsonList(imports),
+	}, importsFilePath)
+}
+
+// importBlocksFromResourceIds creates an import block for each exported resource, keyed by address
+func importBlocksFromResourceIds(resourceIds map[string]string) []importBlockInfo {
+	imports := make([]importBlockInfo, 0, len(resourceIds))
+	for address, id := range resourceIds {
+		resType, resName, _ := strings.Cut(address, ".")
+		imports = append(imports, importBlockInfo{
+			ResourceType: resType,
+			ResourceName: resName,
+			Id:           id,
+		})
+	}
+	return imports
+}
+
+// sortImportBlocks orders the import blocks by resource type and name so the output is stable between exports
+func sortImportBlocks(imports []importBlockInfo) []importBlockInfo {
+	sorted := make([]importBlockInfo, len(imports))
+	copy(sorted, imports)
+	sort.Slice(sorted, func(a, b int) bool {
+		if sorted[a].ResourceType != sorted[b].ResourceType {
+			return sorted[a].ResourceType < sorted[b].ResourceType
+		}
+		return sorted[a].ResourceName < sorted[b].ResourceName
+	})
+	return sorted
+}
+
+// Create an HCL import block for each exported resource
+func createHCLImportBlocks(imports []importBlockInfo) []byte {
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+	for index, info := range imports {
+		if index > 0 {
+			rootBody.AppendNewline()
+		}
+		importBody := rootBody.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", importAddressTraversal(info))
+		importBody.SetAttributeValue("id", zclconfCty.StringVal(info.Id))
+	}
+	return f.Bytes()
+}
+
+// Create the JSON representation of the import blocks
+func createImportsJsonList(imports []importBlockInfo) []util.JsonMap {
+	importsList := make([]util.JsonMap, 0, len(imports))
+	for _, info := range imports {
+		importsList = append(importsList, util.JsonMap{
+			"to": importAddress(info),
+			"id": info.Id,
+		})
+	}
+	return importsList
+}
+
+func importAddress(info importBlockInfo) string {
+	address := info.ResourceType + "." + info.ResourceName
+	if info.Module != "" {
+		address = "module." + info.Module + "." + address
+	}
+	return address
+}
+
+func importAddressTraversal(info importBlockInfo) hcl.Traversal {
+	traversal := hcl.Traversal{}
+	if info.Module != "" {
+		traversal = append(traversal, hcl.TraverseRoot{Name: "module"}, hcl.TraverseAttr{Name: info.Module}, hcl.TraverseAttr{Name: info.ResourceType})
+	} else {
+		traversal = append(traversal, hcl.TraverseRoot{Name: info.ResourceType})
+	}
+	return append(traversal, hcl.TraverseAttr{Name: info.ResourceName})
+}
@@ -0,0 +1,195 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the logic for incremental exports. A previous export's terraform.tfstate file is read back and the
+instance states of objects whose version has not changed since that export are reused instead of being read from the API.
+Objects that no longer exist in the org are not returned by the exporters' GetResourcesFunc, so they drop out of the output.
+*/
+
+// exportVersionMetaKey is the InstanceState.Meta key the exported object version is stored under in the tfstate file
+const exportVersionMetaKey = "genesyscloud_export_version"
+
+type previousExportResource struct {
+	Version string
+	State   *terraform.InstanceState
+}
+
+// previousExport holds the managed resources of a previous export keyed by resource type and then by ID
+type previousExport struct {
+	resources map[string]map[string]previousExportResource
+}
+
+// tfStateV3 is the subset of the legacy state format written by TFStateFileWriter
+type tfStateV3 struct {
+	Version int `json:"version"`
+	Modules []struct {
+		Resources map[string]struct {
+			Type    string `json:"type"`
+			Primary struct {
+				ID         string                 `json:"id"`
+				Attributes map[string]string      `json:"attributes"`
+				Meta       map[string]interface{} `json:"meta"`
+			} `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+}
+
+// tfStateV4 is the subset of the state format produced once the terraform CLI has upgraded the exported state file
+type tfStateV4 struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Instances []struct {
+			SchemaVersion  int               `json:"schema_version"`
+			Attributes     json.RawMessage   `json:"attributes,omitempty"`
+			AttributesFlat map[string]string `json:"attributes_flat,omitempty"`
+			Private        []byte            `json:"private,omitempty"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// readPreviousExport loads the tfstate file of a previous export. Both the v3 state written by TFStateFileWriter
+// and the v4 state produced by 'terraform state replace-provider' are supported.
+func readPreviousExport(path string, resources map[string]*schema.Resource) (*previousExport, diag.Diagnostics) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read previous export state file %s: %v", path, err)
+	}
+
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, diag.Errorf("Failed to parse previous export state file %s: %v", path, err)
+	}
+
+	p := &previousExport{resources: make(map[string]map[string]previousExportResource)}
+	switch header.Version {
+	case 3:
+		err = p.loadV3(data)
+	case 4:
+		err = p.loadV4(data, resources)
+	default:
+		err = fmt.Errorf("unsupported state version %d", header.Version)
+	}
+	if err != nil {
+		return nil, diag.Errorf("Failed to load previous export state file %s: %v", path, err)
+	}
+
+	log.Printf("Loaded %d resource types from previous export state file %s", len(p.resources), path)
+	return p, nil
+}
+
+func (p *previousExport) loadV3(data []byte) error {
+	var state tfStateV3
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	for _, module := range state.Modules {
+		for key, res := range module.Resources {
+			if strings.HasPrefix(key, "data.") {
+				continue
+			}
+			version, _ := res.Primary.Meta[exportVersionMetaKey].(string)
+			p.add(res.Type, version, &terraform.InstanceState{
+				ID:         res.Primary.ID,
+				Attributes: res.Primary.Attributes,
+				Meta:       res.Primary.Meta,
+			})
+		}
+	}
+	return nil
+}
+
+func (p *previousExport) loadV4(data []byte, resources map[string]*schema.Resource) error {
+	var state tfStateV4
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	for _, res := range state.Resources {
+		if res.Mode != "managed" {
+			continue
+		}
+		for _, instance := range res.Instances {
+			meta := make(map[string]interface{})
+			if len(instance.Private) > 0 {
+				if err := json.Unmarshal(instance.Private, &meta); err != nil {
+					log.Printf("Ignoring unreadable private data for %s instance: %v", res.Type, err)
+				}
+			}
+
+			instanceState, err := instanceStateFromV4(res.Type, instance.SchemaVersion, instance.Attributes, instance.AttributesFlat, resources)
+			if err != nil {
+				log.Printf("Skipping %s instance in previous export: %v", res.Type, err)
+				continue
+			}
+			for k, v := range meta {
+				instanceState.Meta[k] = v
+			}
+
+			version, _ := meta[exportVersionMetaKey].(string)
+			p.add(res.Type, version, instanceState)
+		}
+	}
+	return nil
+}
+
+func instanceStateFromV4(resType string, schemaVersion int, attributes json.RawMessage, attributesFlat map[string]string, resources map[string]*schema.Resource) (*terraform.InstanceState, error) {
+	if attributesFlat != nil {
+		return &terraform.InstanceState{
+			ID:         attributesFlat["id"],
+			Attributes: attributesFlat,
+			Meta:       map[string]interface{}{"schema_version": schemaVersion},
+		}, nil
+	}
+
+	res := resources[resType]
+	if res == nil {
+		return nil, fmt.Errorf("resource type %s not defined", resType)
+	}
+	val, err := ctyjson.Unmarshal(attributes, res.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return nil, err
+	}
+	return terraform.NewInstanceStateShimmedFromValue(val, schemaVersion), nil
+}
+
+func (p *previousExport) add(resType string, version string, state *terraform.InstanceState) {
+	if state == nil || state.ID == "" {
+		return
+	}
+	if p.resources[resType] == nil {
+		p.resources[resType] = make(map[string]previousExportResource)
+	}
+	p.resources[resType][state.ID] = previousExportResource{Version: version, State: state}
+}
+
+// unchangedState returns a copy of the previously exported state of an object if its version is known and has not changed.
+// Objects without a version are always re-read.
+func (p *previousExport) unchangedState(resType string, id string, resMeta *resourceExporter.ResourceMeta) *terraform.InstanceState {
+	if p == nil || resMeta == nil || resMeta.Version == "" {
+		return nil
+	}
+	previous, ok := p.resources[resType][resMeta.IdPrefix+id]
+	if !ok || previous.Version != resMeta.Version {
+		return nil
+	}
+	return previous.State.DeepCopy()
+}
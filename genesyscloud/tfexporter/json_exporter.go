@@ -132,9 +132,12 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 	if len(j.unresolvedAttrs) > 0 {
 		tfVars := make(map[string]interface{})
 		for _, attr := range j.unresolvedAttrs {
+			if attr.Secret {
+				continue
+			}
 			key := createUnresolvedAttrKey(attr)
 			tfVars[key] = make(util.JsonMap)
-			tfVars[key] = determineVarValue(attr.Schema)
+			tfVars[key] = unresolvedAttrVarValue(attr)
 		}
 
 		tfVarsFilePath := filepath.Join(j.dirPath, defaultTfVarsFile)
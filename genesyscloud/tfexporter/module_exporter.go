@@ -0,0 +1,415 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the functions used to export HCL as a root module with one child module per division or resource family.
+References between resources in different child modules are replaced with module variables, the referenced attributes are
+exposed as outputs of the owning module, and the root module wires the outputs to the variables.
+*/
+
+const (
+	moduleLayoutDivision       = "division"
+	moduleLayoutResourceFamily = "resource_family"
+
+	modulesSubDirectory     = "modules"
+	sharedModuleName        = "shared"
+	defaultTfHCLModuleFile  = "main.tf"
+	defaultTfHCLOutputFile  = "outputs.tf"
+	defaultTfHCLVersionFile = "versions.tf"
+	defaultTfHCLModulesFile = "modules.tf"
+)
+
+var (
+	// ${genesyscloud_routing_queue.my_queue.id} or ${data.genesyscloud_auth_division_home.home.id}
+	resourceReferenceRegex = regexp.MustCompile(`\$\{((?:data\.)?genesyscloud_[A-Za-z0-9_]+\.[A-Za-z0-9_-]+)\.([A-Za-z0-9_]+)\}`)
+	variableReferenceRegex = regexp.MustCompile(`\$\{var\.([A-Za-z0-9_-]+)`)
+	dependsOnRegex         = regexp.MustCompile(`(?m)^([ \t]*)depends_on[ \t]*=[ \t]*\[([^\]]*)\][ \t]*\n?`)
+	divisionReferenceRegex = regexp.MustCompile(`^\$\{(?:data\.)?genesyscloud_auth_division(?:_home)?\.([A-Za-z0-9_-]+)\.id\}$`)
+	unsafeModuleNameChars  = regexp.MustCompile(`[^0-9A-Za-z_-]`)
+)
+
+// moduleOutput is an attribute of a resource that another module references
+type moduleOutput struct {
+	Name    string
+	Address string
+	Attr    string
+	Module  string
+}
+
+type exportModule struct {
+	name         string
+	resources    map[string]resourceHCLBlock
+	variables    map[string]bool
+	crossModRefs map[string]moduleOutput
+	outputs      map[string]moduleOutput
+}
+
+type ModuleHCLExporter struct {
+	resourceTypesJSONMaps map[string]resourceJSONMaps
+	dataSourceTypesMaps   map[string]resourceJSONMaps
+	unresolvedAttrs       []unresolvableAttributeInfo
+	moduleAssignments     map[string]string
+	providerSource        string
+	version               string
+	dirPath               string
+	splitFilesByResource  bool
+}
+
+func NewModuleHCLExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, moduleAssignments map[string]string, providerSource string, version string, dirPath string, splitFilesByResource bool) *ModuleHCLExporter {
+	moduleExporter := &ModuleHCLExporter{
+		resourceTypesJSONMaps: resourceTypesJSONMaps,
+		dataSourceTypesMaps:   dataSourceTypesMaps,
+		unresolvedAttrs:       unresolvedAttrs,
+		moduleAssignments:     moduleAssignments,
+		providerSource:        providerSource,
+		version:               version,
+		dirPath:               dirPath,
+		splitFilesByResource:  splitFilesByResource,
+	}
+	return moduleExporter
+}
+
+// getModuleGroups returns the user defined mapping of resource types to child modules
+func getModuleGroups(d *schema.ResourceData) map[string]string {
+	groups := make(map[string]string)
+	for resType, group := range d.Get("module_groups").(map[string]interface{}) {
+		groups[resType] = group.(string)
+	}
+	return groups
+}
+
+// assignResourceModules returns the name of the child module each exported resource and data source belongs to, keyed by address
+func assignResourceModules(layout string, groups map[string]string, resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps) map[string]string {
+	assignments := make(map[string]string)
+	assign := func(address string, resType string, resName string, config util.JsonMap) {
+		if layout == moduleLayoutDivision {
+			assignments[address] = moduleForDivision(resType, resName, config)
+		} else {
+			assignments[address] = moduleForResourceFamily(resType, groups)
+		}
+	}
+
+	for resType, resources := range resourceTypesJSONMaps {
+		for resName, config := range resources {
+			assign(resType+"."+resName, resType, resName, config)
+		}
+	}
+	for resType, dataSources := range dataSourceTypesMaps {
+		for resName, config := range dataSources {
+			assign("data."+resType+"."+resName, resType, resName, config)
+		}
+	}
+	return assignments
+}
+
+// moduleForDivision groups divisions with the resources that reference them. Resources without a division go to the shared module.
+func moduleForDivision(resType string, resName string, config util.JsonMap) string {
+	if resType == "genesyscloud_auth_division" || resType == "genesyscloud_auth_division_home" {
+		return sanitizeModuleName(resName)
+	}
+	if divisionId, ok := config["division_id"].(string); ok {
+		if match := divisionReferenceRegex.FindStringSubmatch(divisionId); match != nil {
+			return sanitizeModuleName(match[1])
+		}
+	}
+	return sharedModuleName
+}
+
+// moduleForResourceFamily uses the user defined group of a resource type if there is one. Entries ending with '*' match a prefix of the type.
+// Otherwise, the family is the first word of the type, e.g. 'telephony' for genesyscloud_telephony_providers_edges_site.
+func moduleForResourceFamily(resType string, groups map[string]string) string {
+	if group, ok := groups[resType]; ok {
+		return sanitizeModuleName(group)
+	}
+
+	longestPrefix, prefixGroup, found := "", "", false
+	for key, group := range groups {
+		prefix, isPrefix := strings.CutSuffix(key, "*")
+		if isPrefix && strings.HasPrefix(resType, prefix) && (!found || len(prefix) > len(longestPrefix)) {
+			longestPrefix, prefixGroup, found = prefix, group, true
+		}
+	}
+	if found {
+		return sanitizeModuleName(prefixGroup)
+	}
+
+	family := strings.TrimPrefix(resType, "genesyscloud_")
+	family, _, _ = strings.Cut(family, "_")
+	return family
+}
+
+func sanitizeModuleName(name string) string {
+	name = unsafeModuleNameChars.ReplaceAllString(name, "_")
+	if name == "" {
+		return sharedModuleName
+	}
+	return name
+}
+
+func (m *ModuleHCLExporter) exportHCLModules() diag.Diagnostics {
+	modules := m.buildModules()
+
+	for _, module := range modules {
+		if diagErr := m.writeChildModule(module); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	providerBlock := createHCLProviderBlock(m.providerSource, m.version)
+	modulesBlock := createHCLModuleBlocks(modules)
+	variablesBlock := createHCLVariablesBlock(m.unresolvedAttrs)
+
+	if m.splitFilesByResource {
+		if diagErr := writeHCLToFile([][]byte{providerBlock}, filepath.Join(m.dirPath, defaultTfHCLProviderFile)); diagErr != nil {
+			return diagErr
+		}
+		if diagErr := writeHCLToFile([][]byte{variablesBlock}, filepath.Join(m.dirPath, defaultTfHCLVariablesFile)); diagErr != nil {
+			return diagErr
+		}
+		if diagErr := writeHCLToFile([][]byte{modulesBlock}, filepath.Join(m.dirPath, defaultTfHCLModulesFile)); diagErr != nil {
+			return diagErr
+		}
+	} else {
+		if diagErr := writeHCLToFile([][]byte{providerBlock, modulesBlock, variablesBlock}, filepath.Join(m.dirPath, defaultTfHCLFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// Optional tfvars file creation for unresolved attributes. The variables are declared in the root module and passed to the child modules.
+	if len(m.unresolvedAttrs) > 0 {
+		tfVars := make(map[string]interface{})
+		for _, attr := range m.unresolvedAttrs {
+			if attr.Secret {
+				continue
+			}
+			tfVars[createUnresolvedAttrKey(attr)] = unresolvedAttrVarValue(attr)
+		}
+		if diagErr := writeTfVars(tfVars, filepath.Join(m.dirPath, defaultTfVarsFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	return nil
+}
+
+// buildModules renders the HCL blocks of every child module and rewrites the references that cross module boundaries
+func (m *ModuleHCLExporter) buildModules() []*exportModule {
+	modulesByName := make(map[string]*exportModule)
+	getModule := func(name string) *exportModule {
+		if modulesByName[name] == nil {
+			modulesByName[name] = &exportModule{
+				name:         name,
+				resources:    make(map[string]resourceHCLBlock),
+				variables:    make(map[string]bool),
+				crossModRefs: make(map[string]moduleOutput),
+				outputs:      make(map[string]moduleOutput),
+			}
+		}
+		return modulesByName[name]
+	}
+
+	addBlock := func(address string, resType string, block []byte) {
+		module := getModule(m.moduleAssignments[address])
+		config := string(postProcessHclBytes(block))
+		for _, match := range variableReferenceRegex.FindAllStringSubmatch(config, -1) {
+			module.variables[match[1]] = true
+		}
+		config = m.rewriteReferences(config, module, getModule)
+		module.resources[resType] = append(module.resources[resType], []byte(config))
+	}
+
+	for _, resType := range sortedKeys(m.dataSourceTypesMaps) {
+		for _, resName := range sortedKeys(m.dataSourceTypesMaps[resType]) {
+			addBlock("data."+resType+"."+resName, resType, instanceStateToHCLBlock(resType, resName, m.dataSourceTypesMaps[resType][resName], true))
+		}
+	}
+	for _, resType := range sortedKeys(m.resourceTypesJSONMaps) {
+		for _, resName := range sortedKeys(m.resourceTypesJSONMaps[resType]) {
+			addBlock(resType+"."+resName, resType, instanceStateToHCLBlock(resType, resName, m.resourceTypesJSONMaps[resType][resName], false))
+		}
+	}
+
+	modules := make([]*exportModule, 0, len(modulesByName))
+	for _, name := range sortedKeys(modulesByName) {
+		modules = append(modules, modulesByName[name])
+	}
+	return modules
+}
+
+// rewriteReferences replaces references to resources in other modules with module variables and removes depends_on entries
+// that cannot be expressed within the module. Module to module ordering follows from the variables.
+func (m *ModuleHCLExporter) rewriteReferences(config string, module *exportModule, getModule func(string) *exportModule) string {
+	config = resourceReferenceRegex.ReplaceAllStringFunc(config, func(reference string) string {
+		match := resourceReferenceRegex.FindStringSubmatch(reference)
+		address, attr := match[1], match[2]
+		targetModule, ok := m.moduleAssignments[address]
+		if !ok || targetModule == module.name {
+			return reference
+		}
+
+		output := moduleOutput{
+			Name:    strings.ReplaceAll(address, ".", "_") + "_" + attr,
+			Address: address,
+			Attr:    attr,
+			Module:  targetModule,
+		}
+		module.crossModRefs[output.Name] = output
+		getModule(targetModule).outputs[output.Name] = output
+		return fmt.Sprintf("${var.%s}", output.Name)
+	})
+
+	return dependsOnRegex.ReplaceAllStringFunc(config, func(dependsOn string) string {
+		match := dependsOnRegex.FindStringSubmatch(dependsOn)
+		kept := make([]string, 0)
+		for _, dep := range strings.Split(match[2], ",") {
+			dep = strings.TrimSpace(dep)
+			if dep == "" {
+				continue
+			}
+			if targetModule, ok := m.moduleAssignments[dep]; ok && targetModule != module.name {
+				log.Printf("Dropping depends_on %s from module %s as it is exported to module %s", dep, module.name, targetModule)
+				continue
+			}
+			kept = append(kept, dep)
+		}
+		if len(kept) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%sdepends_on = [%s]\n", match[1], strings.Join(kept, ", "))
+	})
+}
+
+func (m *ModuleHCLExporter) writeChildModule(module *exportModule) diag.Diagnostics {
+	moduleDir := filepath.Join(m.dirPath, modulesSubDirectory, module.name)
+	if err := os.MkdirAll(moduleDir, os.ModePerm); err != nil {
+		return diag.Errorf("Failed to create module directory %s: %v", moduleDir, err)
+	}
+
+	log.Printf("Writing module %s to %s", module.name, moduleDir)
+	if diagErr := writeModuleFile([][]byte{createHCLProviderBlock(m.providerSource, m.version)}, filepath.Join(moduleDir, defaultTfHCLVersionFile)); diagErr != nil {
+		return diagErr
+	}
+
+	if m.splitFilesByResource {
+		for resType, blocks := range module.resources {
+			if diagErr := writeModuleFile(blocks, filepath.Join(moduleDir, fmt.Sprintf("%s.%s", resType, resourceHCLFileExt))); diagErr != nil {
+				return diagErr
+			}
+		}
+	} else {
+		allBlocks := make([][]byte, 0)
+		for _, resType := range sortedKeys(module.resources) {
+			allBlocks = append(allBlocks, module.resources[resType]...)
+		}
+		if diagErr := writeModuleFile(allBlocks, filepath.Join(moduleDir, defaultTfHCLModuleFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	moduleVariables := make([]unresolvableAttributeInfo, 0)
+	for _, attr := range m.unresolvedAttrs {
+		if module.variables[createUnresolvedAttrKey(attr)] {
+			moduleVariables = append(moduleVariables, attr)
+		}
+	}
+	variablesBlock := createHCLVariablesBlock(moduleVariables)
+	variablesBlock = append(variablesBlock, createHCLModuleReferenceVariables(module)...)
+	if diagErr := writeModuleFile([][]byte{variablesBlock}, filepath.Join(moduleDir, defaultTfHCLVariablesFile)); diagErr != nil {
+		return diagErr
+	}
+
+	return writeModuleFile([][]byte{createHCLModuleOutputs(module)}, filepath.Join(moduleDir, defaultTfHCLOutputFile))
+}
+
+// writeModuleFile writes blocks which have already been post processed
+func writeModuleFile(blocks [][]byte, path string) diag.Diagnostics {
+	content := make([]byte, 0)
+	for _, block := range blocks {
+		content = append(content, block...)
+		content = append(content, '\n')
+	}
+	return files.WriteToFile(content, path)
+}
+
+// Create the variables a module uses to receive attributes of resources in other modules
+func createHCLModuleReferenceVariables(module *exportModule) []byte {
+	f := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(module.crossModRefs) {
+		ref := module.crossModRefs[name]
+		variableBlock := f.Body().AppendNewBlock("variable", []string{name})
+		variableBlock.Body().SetAttributeValue("description", zclconfCty.StringVal(fmt.Sprintf("%s.%s exported by module %s", ref.Address, ref.Attr, ref.Module)))
+		variableBlock.Body().SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+	}
+	return f.Bytes()
+}
+
+// Create the outputs of a module that are referenced by other modules
+func createHCLModuleOutputs(module *exportModule) []byte {
+	f := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(module.outputs) {
+		output := module.outputs[name]
+		outputBlock := f.Body().AppendNewBlock("output", []string{name})
+		outputBlock.Body().SetAttributeTraversal("value", addressTraversal(output.Address, output.Attr))
+	}
+	return f.Bytes()
+}
+
+// Create the root module blocks which pass variables and module outputs to the child modules
+func createHCLModuleBlocks(modules []*exportModule) []byte {
+	f := hclwrite.NewEmptyFile()
+	for index, module := range modules {
+		if index > 0 {
+			f.Body().AppendNewline()
+		}
+		moduleBody := f.Body().AppendNewBlock("module", []string{module.name}).Body()
+		moduleBody.SetAttributeValue("source", zclconfCty.StringVal("./"+modulesSubDirectory+"/"+module.name))
+
+		for _, key := range sortedKeys(module.variables) {
+			moduleBody.SetAttributeTraversal(key, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: key}})
+		}
+		for _, name := range sortedKeys(module.crossModRefs) {
+			ref := module.crossModRefs[name]
+			moduleBody.SetAttributeTraversal(name, hcl.Traversal{
+				hcl.TraverseRoot{Name: "module"},
+				hcl.TraverseAttr{Name: ref.Module},
+				hcl.TraverseAttr{Name: name},
+			})
+		}
+	}
+	return f.Bytes()
+}
+
+func addressTraversal(address string, attr string) hcl.Traversal {
+	parts := strings.Split(address, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return append(traversal, hcl.TraverseAttr{Name: attr})
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
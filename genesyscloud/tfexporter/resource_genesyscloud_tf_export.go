@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type fileMeta struct {
@@ -24,6 +25,8 @@ type fileMeta struct {
 
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_tf_export", ResourceTfExport())
+	l.RegisterDataSource("genesyscloud_drift_report", DataSourceDriftReport())
+	l.RegisterDataSource("genesyscloud_tf_export_dependency_graph", DataSourceDependencyGraph())
 
 }
 
@@ -91,6 +94,33 @@ func ResourceTfExport() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"resource_types", "include_filter_resources"},
 			},
+			"closure_seed_resources": {
+				Description: "Export only the listed resources, in the form resource type::ID, and the resources connected to them. The resources they depend on and the resources that depend on them are followed up to closure_depth. Dependencies matching replace_with_datasource are exported as data sources and not followed further.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateClosureSeed,
+				},
+				ForceNew:      true,
+				ConflictsWith: []string{"resource_types", "include_filter_resources", "exclude_filter_resources"},
+			},
+			"closure_depth": {
+				Description:  "Number of levels of dependencies and dependents followed from closure_seed_resources.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"closure_direction": {
+				Description:  "Direction followed from closure_seed_resources. `dependencies` follows the resources they depend on, `dependents` follows the resources that depend on them, and `both` follows both.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      closureDirectionBoth,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{closureDirectionBoth, closureDirectionDependencies, closureDirectionDependents}, false),
+			},
 			"include_state_file": {
 				Description: "Export a 'terraform.tfstate' file along with the config file. This can be used for orgs to begin managing existing resources with terraform. When `false`, GUID fields will be omitted from the config file unless a resource reference can be supplied. In this case, the resource type will need to be included in the `resource_types` array.",
 				Type:        schema.TypeBool,
@@ -98,6 +128,13 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"include_import_blocks": {
+				Description: fmt.Sprintf("Write an '%s' file (or '%s' when exporting JSON) with a Terraform 1.5+ `import` block for every exported resource. Running `terraform plan` and `terraform apply` on the export adopts the existing objects without a generated state file. As with `include_state_file`, GUID fields are kept in the config when a resource reference cannot be supplied.", defaultTfHCLImportsFile, defaultTfJSONImportsFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"export_as_hcl": {
 				Description: "Export the config as HCL.",
 				Type:        schema.TypeBool,
@@ -112,6 +149,34 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"module_layout": {
+				Description:  fmt.Sprintf("Export the config as a root module with one child module per group of resources, written to '%s/<group>'. `%s` groups resources by the division they belong to, with resources that have no division in a '%s' module. `%s` groups resources by family, e.g. routing, telephony or architect, which can be customized with `module_groups`. References between modules are passed through module outputs and variables. Requires `export_as_hcl`.", modulesSubDirectory, moduleLayoutDivision, sharedModuleName, moduleLayoutResourceFamily),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{moduleLayoutDivision, moduleLayoutResourceFamily}, false),
+			},
+			"module_groups": {
+				Description: fmt.Sprintf("Map of resource types to the child module they are exported to when `module_layout` is `%s`, e.g. { \"genesyscloud_flow\" = \"architect\" }. A key ending with '*' matches all resource types with that prefix. Resource types that are not mapped are grouped by the first word of their type.", moduleLayoutResourceFamily),
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"git_commit": {
+				Description: fmt.Sprintf("Commit the export to the git repository that `directory` is located in. An '%s' file listing the exported resources is written along with the config, and the commit message summarizes the resources added, changed and removed since the previous commit as JSON. No commit is created if nothing has changed. Requires the git CLI and a configured git user.", defaultExportManifestFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"git_commit_subject": {
+				Description: "Subject of the commit created when `git_commit` is true. The number of resources added, changed and removed is appended to it.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultGitCommitSubject,
+				ForceNew:    true,
+			},
 			"log_permission_errors": {
 				Description: "Log permission/product issues rather than fail.",
 				Type:        schema.TypeBool,
@@ -147,6 +212,45 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"incremental_state_file": {
+				Description: "Path to the 'terraform.tfstate' file written by a previous export. When set, objects whose version or modified date has not changed since that export are taken from the state file instead of being read again, and objects that no longer exist in the org are dropped from the output. Only resource types that report a version are skipped; all others are read as usual. A state file is always written so it can seed the next incremental export. The file must be located outside of `directory`, as the directory contents are removed when the export is recreated.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"secrets_pgp_public_key": {
+				Description: "Armored PGP public key used to encrypt the values of sensitive attributes. When set, every attribute marked sensitive, e.g. the `fields` of `genesyscloud_integration_credential` or the `password` of `genesyscloud_user`, is exported as a sensitive variable. The variable values are written to 'secrets.tfvars.asc', encrypted with the key, rather than to 'terraform.tfvars'. Decrypt the file to a '.auto.tfvars' file in the export directory before applying the config. Values that cannot be read from the API are left empty. Sensitive values are still written to the state file when `include_state_file` is true.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"promotion_mapping_file": {
+				Description: "Path to a JSON file that maps values in the exported org to their values in other environments, keyed by resource type, attribute and source value, e.g. { \"genesyscloud_user\": { \"email\": { \"jane@dev.example.com\": { \"prod\": \"jane@example.com\" } } } }. Mapped attributes are exported as variables. Their source values are written to 'terraform.tfvars' and the values of each environment to '<environment>.tfvars', to be applied with -var-file. Nested attributes are separated with '.', e.g. 'addresses.phone_numbers.number'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"export_rows_as_csv": {
+				Description: "Export the rows of datatables and the contacts of contact lists as CSV files managed by `genesyscloud_architect_datatable_rows` and `genesyscloud_outbound_contact_list_contacts`, rather than as one `genesyscloud_architect_datatable_row` or `genesyscloud_outbound_contact_list_contact` block per row. The CSV files are written to the 'datatables' and 'contacts' sub-directories of `directory`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"export_flows_as_yaml": {
+				Description: "Export the latest configuration of each published `genesyscloud_flow` as a YAML file in the 'flows' sub-directory of `directory`, rather than leaving `filepath` as a variable. Names and IDs of other exported resources in the flow configuration are replaced with `substitutions` that reference those resources.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"export_dependency_graph": {
+				Description: fmt.Sprintf("Write the dependency graph of the exported resources to '%s' in the Graphviz DOT language and to '%s' as a JSON adjacency list. Resources are colored by type and cycles are highlighted in red. Dependencies are only resolved when `enable_dependency_resolution` is true.", dependencyGraphDotFile, dependencyGraphJsonFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"export_computed": {
 				Description: "Export attributes that are marked as being Computed. Defaults to true to match existing functionality. This attribute's default value will likely switch to false in a future release.",
 				Default:     true,
@@ -162,8 +266,25 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	tfexporter_state.ActivateExporterState()
 
 	if _, ok := d.GetOk("include_filter_resources"); ok {
-		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
-		diagErr := gre.Export()
+		gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
+		if diagErr != nil {
+			return diagErr
+		}
+		diagErr = gre.Export()
+		if diagErr != nil {
+			return diagErr
+		}
+
+		d.SetId(gre.exportDirPath)
+		return nil
+	}
+
+	if _, ok := d.GetOk("closure_seed_resources"); ok {
+		gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, ClosureResources)
+		if diagErr != nil {
+			return diagErr
+		}
+		diagErr = gre.Export()
 		if diagErr != nil {
 			return diagErr
 		}
@@ -173,8 +294,11 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	if _, ok := d.GetOk("exclude_filter_resources"); ok {
-		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, ExcludeResources)
-		diagErr := gre.Export()
+		gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, ExcludeResources)
+		if diagErr != nil {
+			return diagErr
+		}
+		diagErr = gre.Export()
 		if diagErr != nil {
 			return diagErr
 		}
@@ -184,8 +308,11 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	//Dealing with the traditional resource
-	gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, LegacyInclude)
-	diagErr := gre.Export()
+	gre, diagErr := NewGenesysCloudResourceExporter(ctx, d, meta, LegacyInclude)
+	if diagErr != nil {
+		return diagErr
+	}
+	diagErr = gre.Export()
 
 	if diagErr != nil {
 		return diagErr
@@ -212,15 +339,20 @@ func readTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag
 }
 
 // Delete everything (files and subdirectories) inside the export directory
-// not including the directory itself
+// not including the directory itself. When committing to git, the repository
+// metadata is kept so the export directory can be the root of the repository.
 func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	exportPath := d.Id()
+	keepGitDir := d.Get("git_commit").(bool)
 	dir, err := os.ReadDir(exportPath)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	for _, d := range dir {
-		os.RemoveAll(filepath.Join(exportPath, d.Name()))
+	for _, entry := range dir {
+		if keepGitDir && entry.Name() == ".git" {
+			continue
+		}
+		os.RemoveAll(filepath.Join(exportPath, entry.Name()))
 	}
 
 	return nil
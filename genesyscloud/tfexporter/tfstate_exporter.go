@@ -22,18 +22,20 @@ This files contains all of the code used to create an export's Terraform state f
 The other functions in this file deal with how to generate the TFVars we create during the export.
 */
 type TFStateFileWriter struct {
-	ctx            context.Context
-	resources      []resourceExporter.ResourceInfo
-	d              *schema.ResourceData
-	providerSource string
+	ctx               context.Context
+	resources         []resourceExporter.ResourceInfo
+	d                 *schema.ResourceData
+	providerSource    string
+	moduleAssignments map[string]string
 }
 
-func NewTFStateWriter(ctx context.Context, resources []resourceExporter.ResourceInfo, d *schema.ResourceData, providerSource string) *TFStateFileWriter {
+func NewTFStateWriter(ctx context.Context, resources []resourceExporter.ResourceInfo, d *schema.ResourceData, providerSource string, moduleAssignments map[string]string) *TFStateFileWriter {
 	tfwriter := &TFStateFileWriter{
-		ctx:            ctx,
-		resources:      resources,
-		d:              d,
-		providerSource: providerSource,
+		ctx:               ctx,
+		resources:         resources,
+		d:                 d,
+		providerSource:    providerSource,
+		moduleAssignments: moduleAssignments,
 	}
 
 	return tfwriter
@@ -46,13 +48,29 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 	}
 
 	tfstate := terraform.NewState()
+	childModules := make(map[string]*terraform.ModuleState)
 	for _, resource := range t.resources {
 		resourceState := &terraform.ResourceState{
 			Type:     resource.Type,
 			Primary:  resource.State,
 			Provider: "provider.genesyscloud",
 		}
-		tfstate.RootModule().Resources[resource.ResourceType+resource.Type+"."+resource.Name] = resourceState
+		address := resource.ResourceType + resource.Type + "." + resource.Name
+
+		// Resources exported into child modules are stored in the state of their module
+		moduleName, ok := t.moduleAssignments[address]
+		if !ok {
+			tfstate.RootModule().Resources[address] = resourceState
+			continue
+		}
+		if childModules[moduleName] == nil {
+			childModules[moduleName] = &terraform.ModuleState{
+				Path:      []string{"root", moduleName},
+				Resources: make(map[string]*terraform.ResourceState),
+			}
+			tfstate.AddModuleState(childModules[moduleName])
+		}
+		childModules[moduleName].Resources[address] = resourceState
 	}
 
 	data, err := json.MarshalIndent(tfstate, "", "  ")
@@ -1,6 +1,7 @@
 package tfexporter_state
 
 import (
+	"context"
 	"log"
 	"sync"
 )
@@ -8,10 +9,15 @@ import (
 /*
 Export state is used to indicate whether an export is being done.  If the export state is set to true, then this should be
 a signal that any resources being exported should be reading their data from each resource's internal cache rather then the API.
+
+Data sources that read the org the same way as an export without being an export themselves (e.g. the drift report) mark
+the context of their reads with WithExporterState instead, so the rest of the provider process is not affected.
 */
 var exportState bool
 var once sync.Once
 
+type exporterStateContextKey struct{}
+
 // ActivateExporterState will be used to indicate that caching should be used to process requests.
 // We are setting this as an environment variable so we can experiment with it, without creating an attribute
 // on the resource
@@ -25,3 +31,17 @@ func ActivateExporterState() {
 func IsExporterActive() bool {
 	return exportState
 }
+
+// WithExporterState marks the reads made with the context as export reads without activating the process wide exporter state
+func WithExporterState(ctx context.Context) context.Context {
+	return context.WithValue(ctx, exporterStateContextKey{}, true)
+}
+
+// IsExporterActiveContext returns true if the process wide exporter state is active or the context is marked as an export read
+func IsExporterActiveContext(ctx context.Context) bool {
+	if exportState {
+		return true
+	}
+	active, _ := ctx.Value(exporterStateContextKey{}).(bool)
+	return active
+}
@@ -67,6 +67,8 @@ func newUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
 	userApi := platformclientv2.NewUsersApiWithConfig(clientConfig)      // NewUsersApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	routingApi := platformclientv2.NewRoutingApiWithConfig(clientConfig) // NewRoutingApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	userCache := rc.NewResourceCache[platformclientv2.User]()            // Create Cache for User resource
+	// Users are read with their skills, languages and locations
+	rc.InvalidateOnChange(userCache, "genesyscloud_routing_skill", "genesyscloud_routing_language", "genesyscloud_location")
 	return &userProxy{
 		clientConfig:           clientConfig,
 		userApi:                userApi,
@@ -115,7 +117,11 @@ func (p *userProxy) getUserIdByName(ctx context.Context, name string) (id string
 
 // getUserById returns a single Genesys Cloud User by Id
 func (p *userProxy) getUserById(ctx context.Context, id string, expand []string, state string) (user *platformclientv2.User, response *platformclientv2.APIResponse, err error) {
-	if user := rc.GetCacheItem(p.userCache, id); user != nil { // Get the user from the cache, if not there in the cache then call p.getUserByIdAttr()
+	hydrate := func(ctx context.Context) error {
+		_, _, err := p.getAllUser(ctx)
+		return err
+	}
+	if user := rc.GetCacheItemOrHydrate(ctx, p.userCache, id, hydrate); user != nil { // Get the user from the cache, if not there in the cache then call p.getUserByIdAttr()
 		return user, nil, nil
 	}
 	return p.getUserByIdAttr(ctx, p, id, expand, state)
@@ -172,7 +178,7 @@ func deleteUserFn(ctx context.Context, p *userProxy, id string) (*interface{}, *
 	if err != nil {
 		return nil, resp, err
 	}
-	rc.DeleteCacheItem(p.userCache, id)
+	rc.DeleteCacheItem(ctx, p.userCache, id)
 	return data, nil, nil
 }
 
@@ -246,7 +252,7 @@ func getAllUserFn(ctx context.Context, p *userProxy) (*[]platformclientv2.User,
 
 	// Cache the architect schedules resource into the p.userCache for later use
 	for _, user := range allUsers {
-		rc.SetCache(p.userCache, *user.Id, user)
+		rc.SetCache(ctx, p.userCache, *user.Id, user)
 	}
 
 	return &allUsers, apiResponse, nil
@@ -41,6 +41,8 @@ func GetAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration)
 
 	// Add resources to metamap
 	for _, user := range *users {
+		// No version is set as the user version does not change when skills, roles or utilization change, so
+		// incremental exports always re-read users
 		resources[*user.Id] = &resourceExporter.ResourceMeta{Name: *user.Email}
 	}
 
@@ -123,7 +125,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
-	diagErr := executeAllUpdates(d, proxy, sdkConfig, false)
+	diagErr := executeAllUpdates(ctx, d, proxy, sdkConfig, false)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -231,7 +233,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
-	diagErr = executeAllUpdates(d, proxy, sdkConfig, true)
+	diagErr = executeAllUpdates(ctx, d, proxy, sdkConfig, true)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -247,7 +249,7 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	email := d.Get("email").(string)
 
 	log.Printf("Deleting user %s", email)
-	err := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+	err := util.RetryWhen(ctx, util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
 		_, proxyDelResponse, err := proxy.deleteUser(ctx, d.Id())
 		if err != nil {
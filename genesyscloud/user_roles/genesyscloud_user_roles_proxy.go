@@ -69,7 +69,7 @@ func getUserRolesByIdFn(_ context.Context, p *userRolesProxy, roleId string) (*[
 	return &grants, resp, nil
 }
 
-func updateUserRolesFn(_ context.Context, p *userRolesProxy, roleId string, rolesConfig *schema.Set, subjectType string) (*platformclientv2.APIResponse, error) {
+func updateUserRolesFn(ctx context.Context, p *userRolesProxy, roleId string, rolesConfig *schema.Set, subjectType string) (*platformclientv2.APIResponse, error) {
 	// Get existing roles/divisions
 	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(roleId, true)
 
@@ -111,7 +111,7 @@ func updateUserRolesFn(_ context.Context, p *userRolesProxy, roleId string, role
 	}
 	if len(grantsToAdd) > 0 {
 		// In some cases new roles or divisions have not yet been added to the auth service cache causing 404s that should be retried.
-		diagErr := util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		diagErr := util.RetryWhen(ctx, util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 			resp, err := p.authorizationApi.PostAuthorizationSubjectBulkadd(roleId, roleDivPairsToGrants(grantsToAdd), subjectType)
 			if err != nil {
 				return resp, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("failed to add role grants for subject %s error: %s", roleId, err), resp)
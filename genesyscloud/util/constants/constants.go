@@ -8,3 +8,6 @@ const (
 
 // DefaultConsistencyChecks The default number of times we will try to check a resources state before stopping
 const DefaultConsistencyChecks = 5
+
+// BasePathEnvVar overrides the region base path used by the provider when set, e.g. to point it at the mock API server
+const BasePathEnvVar = "GENESYSCLOUD_API_BASE_PATH"
@@ -0,0 +1,93 @@
+package files
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"strings"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The util_csv_files.go file contains the helpers shared by the resources that keep the rows of an object in sync with a
+CSV file (e.g. genesyscloud_architect_datatable_rows and genesyscloud_outbound_contact_list_contacts).
+*/
+
+// NewCsvReader returns a reader for the records of a CSV file and the column names in its first row. The header is nil
+// if the file is empty.
+func NewCsvReader(reader io.Reader) (*csv.Reader, []string, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return csvReader, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV header: %v", err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	return csvReader, header, nil
+}
+
+// ClearFileHashOnDrift clears the file_content_hash of a resource if the rows of its file no longer match the live rows,
+// so that the next plan uploads the file again. diff reads the file and describes how the rows differ, or returns ""
+// if they match.
+func ClearFileHashOnDrift(d *schema.ResourceData, diff func(filePath string) (string, error)) {
+	filePath, _ := d.Get("filepath").(string)
+	if filePath == "" {
+		return
+	}
+
+	difference, err := diff(filePath)
+	if err != nil {
+		log.Printf("Unable to compare %s with the live rows to check for drift: %s", filePath, err)
+		return
+	}
+	if difference != "" {
+		log.Printf("Rows of %s differ from the live rows: %s", filePath, difference)
+		_ = d.Set("file_content_hash", "")
+	}
+}
+
+// CsvExportResolver returns a RetrieveAndWriteFilesFunc that writes the CSV content returned by getCsv to
+// <fileNamePrefix>-<id>.csv in the export directory and points the filepath and file_content_hash of the exported
+// resource at it
+func CsvExportResolver(fileNamePrefix string, getCsv func(ctx context.Context, meta interface{}, id string) ([]byte, error)) func(string, string, string, map[string]interface{}, interface{}, resourceExporter.ResourceInfo) error {
+	return func(id, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}, resource resourceExporter.ResourceInfo) error {
+		csvContent, err := getCsv(context.Background(), meta, id)
+		if err != nil {
+			return err
+		}
+
+		exportFileName := fmt.Sprintf("%s-%s.csv", fileNamePrefix, id)
+		fullPath := path.Join(exportDirectory, subDirectory)
+		if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path.Join(fullPath, exportFileName), csvContent, 0644); err != nil {
+			return err
+		}
+
+		// Update filepath field in configMap to point to exported CSV file
+		fileNameVal := path.Join(subDirectory, exportFileName)
+		configMap["filepath"] = fileNameVal
+		configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, fileNameVal)
+
+		resource.State.Attributes["filepath"] = fileNameVal
+
+		hash, err := HashFileContent(path.Join(fullPath, exportFileName))
+		if err != nil {
+			log.Printf("Error Calculating Hash '%s' ", err)
+		} else {
+			resource.State.Attributes["file_content_hash"] = hash
+		}
+		return nil
+	}
+}
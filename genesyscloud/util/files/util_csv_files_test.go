@@ -0,0 +1,56 @@
+package files
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNewCsvReader(t *testing.T) {
+	csvReader, header, err := NewCsvReader(strings.NewReader("\ufeffkey,name\n1,Alice\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key", "name"}, header)
+
+	record, err := csvReader.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "Alice"}, record)
+
+	_, header, err = NewCsvReader(strings.NewReader(""))
+	assert.Nil(t, err)
+	assert.Nil(t, header)
+}
+
+func TestUnitClearFileHashOnDrift(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"filepath":          {Type: schema.TypeString, Optional: true},
+		"file_content_hash": {Type: schema.TypeString, Optional: true},
+	}
+	newResourceData := func() *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+			"filepath":          "rows.csv",
+			"file_content_hash": "hash",
+		})
+	}
+
+	d := newResourceData()
+	ClearFileHashOnDrift(d, func(filePath string) (string, error) {
+		assert.Equal(t, "rows.csv", filePath)
+		return "", nil
+	})
+	assert.Equal(t, "hash", d.Get("file_content_hash"))
+
+	d = newResourceData()
+	ClearFileHashOnDrift(d, func(string) (string, error) {
+		return "", errors.New("file not found")
+	})
+	assert.Equal(t, "hash", d.Get("file_content_hash"), "the hash should be kept if the file can't be read")
+
+	d = newResourceData()
+	ClearFileHashOnDrift(d, func(string) (string, error) {
+		return "1 row to update", nil
+	})
+	assert.Equal(t, "", d.Get("file_content_hash"))
+}
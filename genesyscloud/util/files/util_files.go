@@ -22,12 +22,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
+// fileFormKey is the form field that holds the uploaded file in form uploads
+const fileFormKey = "file"
+
 type S3Uploader struct {
 	reader        io.Reader
 	formData      map[string]io.Reader
 	bodyBuf       *bytes.Buffer
 	Writer        *multipart.Writer
 	substitutions map[string]interface{}
+	template      *FileTemplate
 	headers       map[string]string
 	httpMethod    string
 	presignedUrl  string
@@ -71,6 +75,12 @@ func (s *S3Uploader) substituteValues() {
 	}
 }
 
+// SetTemplate renders the file content as a template before it is uploaded. For form uploads only the form field
+// holding the file is rendered.
+func (s *S3Uploader) SetTemplate(template *FileTemplate) {
+	s.template = template
+}
+
 func (s *S3Uploader) Upload() ([]byte, error) {
 	return s.UploadFunc(s)
 }
@@ -86,7 +96,14 @@ func UploadFn(s *S3Uploader) ([]byte, error) {
 		}
 		s.headers["Content-Type"] = s.Writer.FormDataContentType()
 	} else {
-		_, err := io.Copy(s.bodyBuf, s.reader)
+		reader := s.reader
+		if s.template != nil {
+			var err error
+			if reader, err = s.template.renderReader("file", reader); err != nil {
+				return nil, fmt.Errorf("failed to render file template. Error: %s", err)
+			}
+		}
+		_, err := io.Copy(s.bodyBuf, reader)
 		if err != nil {
 			return nil, fmt.Errorf("failed to copy file content to the handler. Error: %s ", err)
 		}
@@ -168,6 +185,11 @@ func (s *S3Uploader) createFormData() error {
 		if err != nil {
 			return err
 		}
+		if s.template != nil && key == fileFormKey {
+			if r, err = s.template.renderReader(key, r); err != nil {
+				return fmt.Errorf("failed to render file template. Error: %s", err)
+			}
+		}
 		if _, err := io.Copy(fw, r); err != nil {
 			return err
 		}
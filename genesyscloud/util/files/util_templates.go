@@ -0,0 +1,211 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+File templates are an alternative to the flat substitutions map of the flow and script resources. The file is rendered
+as a Go text/template with the values decoded from the JSON in template_values, so values can be nested maps and lists,
+and any placeholder without a value is an error instead of being left in the file. Files that contain literal {{ or }}
+set template_delimiters to use other delimiters. Only the content of the file is rendered, never the rest of the upload.
+*/
+
+var missingTemplateKeyRegex = regexp.MustCompile(`^template: (\S+?): executing .*map has no entry for key "([^"]+)"$`)
+
+// templateFuncs are the helpers available to file templates. The reference helpers accept either a name or an object
+// with name and id attributes, such as jsonencode({ name = genesyscloud_routing_queue.q.name, id = genesyscloud_routing_queue.q.id })
+var templateFuncs = template.FuncMap{
+	"queueRef":         architectRef,
+	"scheduleGroupRef": architectRef,
+	"promptRef":        promptRef,
+	"toJson":           toJson,
+	"quote":            toJson,
+}
+
+// FileTemplate holds the values and delimiters used to render a file as a template
+type FileTemplate struct {
+	values     map[string]interface{}
+	leftDelim  string
+	rightDelim string
+}
+
+// resourceGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// GetFileTemplate returns the template configured by the template_values and template_delimiters attributes. It
+// returns nil if template_values is not set, which means templating is off.
+func GetFileTemplate(d resourceGetter) (*FileTemplate, error) {
+	templateValues, _ := d.Get("template_values").(string)
+	delimiters, _ := d.Get("template_delimiters").([]interface{})
+	return NewFileTemplate(templateValues, delimiters)
+}
+
+// NewFileTemplate decodes the JSON object of a template_values attribute. An empty string means templating is off.
+// The delimiters are the left and right delimiters of the template actions, {{ and }} if they are not set.
+func NewFileTemplate(templateValues string, delimiters []interface{}) (*FileTemplate, error) {
+	if templateValues == "" {
+		return nil, nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(templateValues), &values); err != nil {
+		return nil, fmt.Errorf("template_values must be a JSON object: %v", err)
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+
+	t := &FileTemplate{values: values}
+	if len(delimiters) > 0 {
+		if len(delimiters) != 2 {
+			return nil, fmt.Errorf("template_delimiters must hold a left and a right delimiter")
+		}
+		t.leftDelim, _ = delimiters[0].(string)
+		t.rightDelim, _ = delimiters[1].(string)
+	}
+	return t, nil
+}
+
+// Render renders file content as a template. Referencing a value that is not set fails with an error naming
+// the key and the line it was referenced on.
+func (t *FileTemplate) Render(name, content string) (string, error) {
+	tmpl, err := template.New(name).Delims(t.leftDelim, t.rightDelim).Option("missingkey=error").Funcs(templateFuncs).Parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, t.values); err != nil {
+		if match := missingTemplateKeyRegex.FindStringSubmatch(err.Error()); match != nil {
+			return "", fmt.Errorf("%s: template value '%s' is not set", match[1], match[2])
+		}
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// renderReader reads the content of a file and returns a reader of the rendered content
+func (t *FileTemplate) renderReader(name string, reader io.Reader) (io.Reader, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", name, err)
+	}
+	rendered, err := t.Render(name, string(content))
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(rendered), nil
+}
+
+// templateFileContents caches the content of the files downloaded from a URL to check their template during plan,
+// so that a URL is only downloaded once per provider run
+var templateFileContents sync.Map
+
+// CustomizeTemplateValuesDiff renders the file at filepath with template_values during plan so that missing values
+// are reported before anything is uploaded. The file is only read if the file or the template attributes changed.
+func CustomizeTemplateValuesDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("template_values") || !diff.NewValueKnown("filepath") {
+		return nil
+	}
+	if !diff.HasChanges("template_values", "template_delimiters", "filepath", "file_content_hash") {
+		return nil
+	}
+	fileTemplate, err := GetFileTemplate(diff)
+	if err != nil || fileTemplate == nil {
+		return err
+	}
+
+	filePath := diff.Get("filepath").(string)
+	content, err := readTemplateFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	_, err = fileTemplate.Render(filePath, content)
+	return err
+}
+
+func readTemplateFile(filePath string) (string, error) {
+	if content, ok := templateFileContents.Load(filePath); ok {
+		return content.(string), nil
+	}
+
+	reader, file, err := DownloadOrOpenFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %v", filePath, err)
+	}
+
+	// Only downloads are cached, local files are cheap to read
+	if file == nil {
+		templateFileContents.Store(filePath, string(content))
+	}
+	return string(content), nil
+}
+
+// architectRef renders the literal name/ID pair Architect uses to reference a queue or schedule group
+func architectRef(ref interface{}) (string, error) {
+	name, id, err := refNameAndId(ref)
+	if err != nil {
+		return "", err
+	}
+	lit := map[string]string{"name": name}
+	if id != "" {
+		lit["id"] = id
+	}
+	return toJson(map[string]interface{}{"lit": lit})
+}
+
+// promptRef renders the expression Architect uses to reference a user prompt
+func promptRef(ref interface{}) (string, error) {
+	name, _, err := refNameAndId(ref)
+	if err != nil {
+		return "", err
+	}
+	return "Prompt." + name, nil
+}
+
+func refNameAndId(ref interface{}) (string, string, error) {
+	switch r := ref.(type) {
+	case string:
+		if r != "" {
+			return r, "", nil
+		}
+	case map[string]interface{}:
+		name, _ := r["name"].(string)
+		id, _ := r["id"].(string)
+		if name != "" {
+			return name, id, nil
+		}
+	}
+	return "", "", fmt.Errorf("reference %v must be a name or an object with a name", ref)
+}
+
+// toJson renders a value as JSON, which is also valid YAML, so nested maps and lists can be spliced into flow files
+func toJson(value interface{}) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,113 @@
+package files
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRenderTemplate(t *testing.T) {
+	fileTemplate, err := NewFileTemplate(`{
+		"flow_name": "Main IVR",
+		"sales": {"name": "Sales", "id": "1b7e8f6e-6c8d-4d3e-9d0e-3f1c9b0e2a11"},
+		"hours": "Business Hours",
+		"languages": ["en-us", "es"],
+		"menus": [{"name": "Sales", "dtmf": 1}, {"name": "Support", "dtmf": 2}]
+	}`, nil)
+	assert.Nil(t, err)
+
+	content := `inboundCall:
+  name: {{ .flow_name }}
+  supportedLanguages: {{ toJson .languages }}
+  initialGreeting:
+    exp: ToAudio({{ promptRef "Welcome" }})
+  scheduleGroup: {{ scheduleGroupRef .hours }}
+  menus:
+{{- range .menus }}
+    - name: {{ quote .name }}
+      dtmf: digit_{{ .dtmf }}
+      targetQueue: {{ queueRef $.sales }}
+{{- end }}
+`
+	rendered, err := fileTemplate.Render("flow.yaml", content)
+	assert.Nil(t, err)
+	assert.Equal(t, `inboundCall:
+  name: Main IVR
+  supportedLanguages: ["en-us","es"]
+  initialGreeting:
+    exp: ToAudio(Prompt.Welcome)
+  scheduleGroup: {"lit":{"name":"Business Hours"}}
+  menus:
+    - name: "Sales"
+      dtmf: digit_1
+      targetQueue: {"lit":{"id":"1b7e8f6e-6c8d-4d3e-9d0e-3f1c9b0e2a11","name":"Sales"}}
+    - name: "Support"
+      dtmf: digit_2
+      targetQueue: {"lit":{"id":"1b7e8f6e-6c8d-4d3e-9d0e-3f1c9b0e2a11","name":"Sales"}}
+`, rendered)
+
+	_, err = fileTemplate.Render("flow.yaml", "inboundCall:\n  name: {{ .flow_nme }}\n")
+	assert.EqualError(t, err, "flow.yaml:2:11: template value 'flow_nme' is not set")
+
+	_, err = fileTemplate.Render("flow.yaml", "targetQueue: {{ queueRef .languages }}\n")
+	assert.ErrorContains(t, err, "must be a name or an object with a name")
+
+	_, err = NewFileTemplate(`["not", "an", "object"]`, nil)
+	assert.ErrorContains(t, err, "must be a JSON object")
+
+	fileTemplate, err = NewFileTemplate("", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, fileTemplate)
+}
+
+func TestUnitRenderTemplateDelimiters(t *testing.T) {
+	content := `inboundCall:
+  name: [[ .flow_name ]]
+  description: Says {{ hello }} and }} literally
+`
+	fileTemplate, err := NewFileTemplate(`{"flow_name": "Main IVR"}`, []interface{}{"[[", "]]"})
+	assert.Nil(t, err)
+	rendered, err := fileTemplate.Render("flow.yaml", content)
+	assert.Nil(t, err)
+	assert.Equal(t, `inboundCall:
+  name: Main IVR
+  description: Says {{ hello }} and }} literally
+`, rendered)
+
+	// The default delimiters fail on the literal braces
+	fileTemplate, err = NewFileTemplate(`{"flow_name": "Main IVR"}`, nil)
+	assert.Nil(t, err)
+	_, err = fileTemplate.Render("flow.yaml", content)
+	assert.NotNil(t, err)
+
+	_, err = NewFileTemplate(`{}`, []interface{}{"[["})
+	assert.ErrorContains(t, err, "must hold a left and a right delimiter")
+}
+
+func TestUnitUploadRendersOnlyTheFile(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := io.ReadAll(r.Body)
+		body = string(content)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	fileTemplate, err := NewFileTemplate(`{"queue": "Sales"}`, nil)
+	assert.Nil(t, err)
+	formData := map[string]io.Reader{
+		"file":       strings.NewReader(`{"queue": "{{ .queue }}"}`),
+		"scriptName": strings.NewReader("Script {{ literal }}"),
+	}
+	uploader := NewS3Uploader(nil, formData, nil, map[string]string{}, "POST", server.URL)
+	uploader.SetTemplate(fileTemplate)
+
+	_, err = uploader.Upload()
+	assert.Nil(t, err)
+	assert.Contains(t, body, `{"queue": "Sales"}`)
+	assert.Contains(t, body, "Script {{ literal }}")
+}
@@ -0,0 +1,299 @@
+package holidays
+
+import "time"
+
+/*
+The dataset holds the national and regional public holidays of each supported country. Regional days such as the
+anniversary days of New Zealand provinces, and bank holidays that are only given in parts of a region, are left out
+and can be added as company-specific days where they apply.
+*/
+
+var dataset = map[string]country{
+	"AU": {
+		name: "Australia",
+		regions: map[string]string{
+			"ACT": "Australian Capital Territory",
+			"NSW": "New South Wales",
+			"NT":  "Northern Territory",
+			"QLD": "Queensland",
+			"SA":  "South Australia",
+			"TAS": "Tasmania",
+			"VIC": "Victoria",
+			"WA":  "Western Australia",
+		},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observance: observeNextWeekday},
+			{name: "Australia Day", date: fixed(time.January, 26), observance: observeNextWeekday},
+			{name: "Canberra Day", date: nthWeekday(time.March, time.Monday, 2), regions: []string{"ACT"}},
+			{name: "Adelaide Cup Day", date: nthWeekday(time.March, time.Monday, 2), regions: []string{"SA"}},
+			{name: "Eight Hours Day", date: nthWeekday(time.March, time.Monday, 2), regions: []string{"TAS"}},
+			{name: "Labour Day", date: nthWeekday(time.March, time.Monday, 2), regions: []string{"VIC"}},
+			{name: "Labour Day", date: nthWeekday(time.March, time.Monday, 1), regions: []string{"WA"}},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Saturday", date: easter(-1), notRegions: []string{"TAS", "WA"}},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "Anzac Day", date: fixed(time.April, 25)},
+			{name: "Labour Day", date: nthWeekday(time.May, time.Monday, 1), regions: []string{"QLD"}},
+			{name: "May Day", date: nthWeekday(time.May, time.Monday, 1), regions: []string{"NT"}},
+			{name: "Reconciliation Day", date: weekdayOnOrAfter(time.May, 27, time.Monday), regions: []string{"ACT"}, fromYear: 2018},
+			{name: "Western Australia Day", date: nthWeekday(time.June, time.Monday, 1), regions: []string{"WA"}},
+			{name: "Queen's Birthday", date: nthWeekday(time.June, time.Monday, 2), notRegions: []string{"QLD", "WA"}, toYear: 2022},
+			{name: "King's Birthday", date: nthWeekday(time.June, time.Monday, 2), notRegions: []string{"QLD", "WA"}, fromYear: 2023},
+			{name: "Picnic Day", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"NT"}},
+			{name: "Labour Day", date: nthWeekday(time.October, time.Monday, 1), regions: []string{"ACT", "NSW", "SA"}},
+			{name: "Queen's Birthday", date: nthWeekday(time.October, time.Monday, 1), regions: []string{"QLD"}, fromYear: 2016, toYear: 2022},
+			{name: "King's Birthday", date: nthWeekday(time.October, time.Monday, 1), regions: []string{"QLD"}, fromYear: 2023},
+			{name: "Melbourne Cup Day", date: nthWeekday(time.November, time.Tuesday, 1), regions: []string{"VIC"}},
+			{name: "Christmas Day", date: fixed(time.December, 25), observance: observeNextWeekday},
+			{name: "Boxing Day", date: fixed(time.December, 26), observance: observeNextWeekday},
+		},
+	},
+	"CA": {
+		name: "Canada",
+		regions: map[string]string{
+			"AB": "Alberta",
+			"BC": "British Columbia",
+			"MB": "Manitoba",
+			"NB": "New Brunswick",
+			"NS": "Nova Scotia",
+			"ON": "Ontario",
+			"PE": "Prince Edward Island",
+			"QC": "Quebec",
+			"SK": "Saskatchewan",
+		},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observance: observeNextWeekday},
+			{name: "Family Day", date: nthWeekday(time.February, time.Monday, 3), regions: []string{"AB", "BC", "NB", "ON", "SK"}},
+			{name: "Louis Riel Day", date: nthWeekday(time.February, time.Monday, 3), regions: []string{"MB"}},
+			{name: "Heritage Day", date: nthWeekday(time.February, time.Monday, 3), regions: []string{"NS"}},
+			{name: "Islander Day", date: nthWeekday(time.February, time.Monday, 3), regions: []string{"PE"}},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1), regions: []string{"QC"}},
+			{name: "Victoria Day", date: weekdayOnOrBefore(time.May, 24, time.Monday), notRegions: []string{"QC"}},
+			{name: "National Patriots' Day", date: weekdayOnOrBefore(time.May, 24, time.Monday), regions: []string{"QC"}},
+			{name: "Saint-Jean-Baptiste Day", date: fixed(time.June, 24), regions: []string{"QC"}},
+			{name: "Canada Day", date: fixed(time.July, 1), observance: observeNextWeekday},
+			{name: "Civic Holiday", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"ON"}},
+			{name: "Heritage Day", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"AB"}},
+			{name: "British Columbia Day", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"BC"}},
+			{name: "New Brunswick Day", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"NB"}},
+			{name: "Natal Day", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"NS"}},
+			{name: "Saskatchewan Day", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"SK"}},
+			{name: "Labour Day", date: nthWeekday(time.September, time.Monday, 1)},
+			{name: "National Day for Truth and Reconciliation", date: fixed(time.September, 30), fromYear: 2021},
+			{name: "Thanksgiving", date: nthWeekday(time.October, time.Monday, 2)},
+			{name: "Remembrance Day", date: fixed(time.November, 11), notRegions: []string{"ON", "QC"}},
+			{name: "Christmas Day", date: fixed(time.December, 25), observance: observeNextWeekday},
+			{name: "Boxing Day", date: fixed(time.December, 26), observance: observeNextWeekday},
+		},
+	},
+	"DE": {
+		name: "Germany",
+		regions: map[string]string{
+			"BB": "Brandenburg",
+			"BE": "Berlin",
+			"BW": "Baden-Württemberg",
+			"BY": "Bavaria",
+			"HB": "Bremen",
+			"HE": "Hesse",
+			"HH": "Hamburg",
+			"MV": "Mecklenburg-Vorpommern",
+			"NI": "Lower Saxony",
+			"NW": "North Rhine-Westphalia",
+			"RP": "Rhineland-Palatinate",
+			"SH": "Schleswig-Holstein",
+			"SL": "Saarland",
+			"SN": "Saxony",
+			"ST": "Saxony-Anhalt",
+			"TH": "Thuringia",
+		},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1)},
+			{name: "Epiphany", date: fixed(time.January, 6), regions: []string{"BW", "BY", "ST"}},
+			{name: "International Women's Day", date: fixed(time.March, 8), regions: []string{"BE"}, fromYear: 2019},
+			{name: "International Women's Day", date: fixed(time.March, 8), regions: []string{"MV"}, fromYear: 2023},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "Labour Day", date: fixed(time.May, 1)},
+			{name: "Ascension Day", date: easter(39)},
+			{name: "Whit Monday", date: easter(50)},
+			{name: "Corpus Christi", date: easter(60), regions: []string{"BW", "BY", "HE", "NW", "RP", "SL"}},
+			{name: "Assumption Day", date: fixed(time.August, 15), regions: []string{"SL"}},
+			{name: "World Children's Day", date: fixed(time.September, 20), regions: []string{"TH"}, fromYear: 2019},
+			{name: "German Unity Day", date: fixed(time.October, 3)},
+			{name: "Reformation Day", date: fixed(time.October, 31), regions: []string{"BB", "MV", "SN", "ST", "TH"}},
+			{name: "Reformation Day", date: fixed(time.October, 31), regions: []string{"HB", "HH", "NI", "SH"}, fromYear: 2018},
+			{name: "All Saints' Day", date: fixed(time.November, 1), regions: []string{"BW", "BY", "NW", "RP", "SL"}},
+			{name: "Repentance and Prayer Day", date: weekdayOnOrBefore(time.November, 22, time.Wednesday), regions: []string{"SN"}},
+			{name: "Christmas Day", date: fixed(time.December, 25)},
+			{name: "St. Stephen's Day", date: fixed(time.December, 26)},
+		},
+	},
+	"FR": {
+		name: "France",
+		regions: map[string]string{
+			"57": "Moselle",
+			"67": "Bas-Rhin",
+			"68": "Haut-Rhin",
+		},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1)},
+			{name: "Good Friday", date: easter(-2), regions: []string{"57", "67", "68"}},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "Labour Day", date: fixed(time.May, 1)},
+			{name: "Victory in Europe Day", date: fixed(time.May, 8)},
+			{name: "Ascension Day", date: easter(39)},
+			{name: "Whit Monday", date: easter(50)},
+			{name: "Bastille Day", date: fixed(time.July, 14)},
+			{name: "Assumption Day", date: fixed(time.August, 15)},
+			{name: "All Saints' Day", date: fixed(time.November, 1)},
+			{name: "Armistice Day", date: fixed(time.November, 11)},
+			{name: "Christmas Day", date: fixed(time.December, 25)},
+			{name: "St. Stephen's Day", date: fixed(time.December, 26), regions: []string{"57", "67", "68"}},
+		},
+	},
+	"GB": {
+		name: "United Kingdom",
+		regions: map[string]string{
+			"ENG": "England",
+			"NIR": "Northern Ireland",
+			"SCT": "Scotland",
+			"WLS": "Wales",
+		},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observance: observeNextWeekday},
+			{name: "2nd January", date: fixed(time.January, 2), regions: []string{"SCT"}, observance: observeNextWeekday},
+			{name: "St Patrick's Day", date: fixed(time.March, 17), regions: []string{"NIR"}, observance: observeNextWeekday},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1), notRegions: []string{"SCT"}},
+			{name: "Early May bank holiday", date: moved(nthWeekday(time.May, time.Monday, 1), map[int]time.Time{
+				2020: date(2020, time.May, 8),
+			})},
+			{name: "Spring bank holiday", date: moved(nthWeekday(time.May, time.Monday, -1), map[int]time.Time{
+				2002: date(2002, time.June, 4),
+				2012: date(2012, time.June, 4),
+				2022: date(2022, time.June, 2),
+			})},
+			{name: "Battle of the Boyne", date: fixed(time.July, 12), regions: []string{"NIR"}, observance: observeNextWeekday},
+			{name: "Summer bank holiday", date: nthWeekday(time.August, time.Monday, 1), regions: []string{"SCT"}},
+			{name: "Summer bank holiday", date: nthWeekday(time.August, time.Monday, -1), notRegions: []string{"SCT"}},
+			{name: "St Andrew's Day", date: fixed(time.November, 30), regions: []string{"SCT"}, observance: observeNextWeekday},
+			{name: "Christmas Day", date: fixed(time.December, 25), observance: observeNextWeekday},
+			{name: "Boxing Day", date: fixed(time.December, 26), observance: observeNextWeekday},
+			{name: "Golden Jubilee bank holiday", date: fixed(time.June, 3), fromYear: 2002, toYear: 2002},
+			{name: "Royal wedding bank holiday", date: fixed(time.April, 29), fromYear: 2011, toYear: 2011},
+			{name: "Diamond Jubilee bank holiday", date: fixed(time.June, 5), fromYear: 2012, toYear: 2012},
+			{name: "Platinum Jubilee bank holiday", date: fixed(time.June, 3), fromYear: 2022, toYear: 2022},
+			{name: "State Funeral of Queen Elizabeth II", date: fixed(time.September, 19), fromYear: 2022, toYear: 2022},
+			{name: "Coronation of King Charles III", date: fixed(time.May, 8), fromYear: 2023, toYear: 2023},
+		},
+	},
+	"IE": {
+		name:    "Ireland",
+		regions: map[string]string{},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observance: observeNextWeekday},
+			{name: "St Brigid's Day", date: stBrigidsDay, fromYear: 2023},
+			{name: "St Patrick's Day", date: fixed(time.March, 17), observance: observeNextWeekday},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "May bank holiday", date: nthWeekday(time.May, time.Monday, 1)},
+			{name: "June bank holiday", date: nthWeekday(time.June, time.Monday, 1)},
+			{name: "August bank holiday", date: nthWeekday(time.August, time.Monday, 1)},
+			{name: "October bank holiday", date: nthWeekday(time.October, time.Monday, -1)},
+			{name: "Christmas Day", date: fixed(time.December, 25), observance: observeNextWeekday},
+			{name: "St Stephen's Day", date: fixed(time.December, 26), observance: observeNextWeekday},
+		},
+	},
+	"NL": {
+		name:    "Netherlands",
+		regions: map[string]string{},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1)},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "King's Day", date: kingsDay, fromYear: 2014},
+			{name: "Liberation Day", date: fixed(time.May, 5)},
+			{name: "Ascension Day", date: easter(39)},
+			{name: "Whit Monday", date: easter(50)},
+			{name: "Christmas Day", date: fixed(time.December, 25)},
+			{name: "Second Day of Christmas", date: fixed(time.December, 26)},
+		},
+	},
+	"NZ": {
+		name:    "New Zealand",
+		regions: map[string]string{},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observance: observeNextWeekday},
+			{name: "Day after New Year's Day", date: fixed(time.January, 2), observance: observeNextWeekday},
+			{name: "Waitangi Day", date: fixed(time.February, 6), observance: observeNextWeekday},
+			{name: "Good Friday", date: easter(-2)},
+			{name: "Easter Monday", date: easter(1)},
+			{name: "Anzac Day", date: fixed(time.April, 25), observance: observeNextWeekday},
+			{name: "Queen's Birthday", date: nthWeekday(time.June, time.Monday, 1), toYear: 2022},
+			{name: "King's Birthday", date: nthWeekday(time.June, time.Monday, 1), fromYear: 2023},
+			{name: "Matariki", date: dates(map[int]time.Time{
+				2022: date(2022, time.June, 24),
+				2023: date(2023, time.July, 14),
+				2024: date(2024, time.June, 28),
+				2025: date(2025, time.June, 20),
+				2026: date(2026, time.July, 10),
+				2027: date(2027, time.June, 25),
+				2028: date(2028, time.July, 14),
+				2029: date(2029, time.July, 6),
+				2030: date(2030, time.June, 21),
+			})},
+			{name: "Labour Day", date: nthWeekday(time.October, time.Monday, 4)},
+			{name: "Christmas Day", date: fixed(time.December, 25), observance: observeNextWeekday},
+			{name: "Boxing Day", date: fixed(time.December, 26), observance: observeNextWeekday},
+		},
+	},
+	"US": {
+		name: "United States",
+		regions: map[string]string{
+			"AK": "Alaska",
+			"CA": "California",
+			"HI": "Hawaii",
+			"MA": "Massachusetts",
+			"ME": "Maine",
+			"TX": "Texas",
+		},
+		rules: []rule{
+			{name: "New Year's Day", date: fixed(time.January, 1), observance: observeNearestWeekday},
+			{name: "Martin Luther King Jr. Day", date: nthWeekday(time.January, time.Monday, 3)},
+			{name: "Washington's Birthday", date: nthWeekday(time.February, time.Monday, 3)},
+			{name: "Texas Independence Day", date: fixed(time.March, 2), regions: []string{"TX"}},
+			{name: "Prince Jonah Kuhio Kalanianaole Day", date: fixed(time.March, 26), regions: []string{"HI"}, observance: observeNearestWeekday},
+			{name: "Seward's Day", date: nthWeekday(time.March, time.Monday, -1), regions: []string{"AK"}},
+			{name: "Cesar Chavez Day", date: fixed(time.March, 31), regions: []string{"CA"}},
+			{name: "Patriots' Day", date: nthWeekday(time.April, time.Monday, 3), regions: []string{"MA", "ME"}},
+			{name: "San Jacinto Day", date: fixed(time.April, 21), regions: []string{"TX"}},
+			{name: "Memorial Day", date: nthWeekday(time.May, time.Monday, -1)},
+			{name: "King Kamehameha I Day", date: fixed(time.June, 11), regions: []string{"HI"}, observance: observeNearestWeekday},
+			{name: "Juneteenth National Independence Day", date: fixed(time.June, 19), observance: observeNearestWeekday, fromYear: 2021},
+			{name: "Independence Day", date: fixed(time.July, 4), observance: observeNearestWeekday},
+			{name: "Labor Day", date: nthWeekday(time.September, time.Monday, 1)},
+			{name: "Columbus Day", date: nthWeekday(time.October, time.Monday, 2)},
+			{name: "Alaska Day", date: fixed(time.October, 18), regions: []string{"AK"}, observance: observeNearestWeekday},
+			{name: "Veterans Day", date: fixed(time.November, 11), observance: observeNearestWeekday},
+			{name: "Thanksgiving Day", date: nthWeekday(time.November, time.Thursday, 4)},
+			{name: "Day after Thanksgiving", date: offset(nthWeekday(time.November, time.Thursday, 4), 1), regions: []string{"CA"}},
+			{name: "Christmas Day", date: fixed(time.December, 25), observance: observeNearestWeekday},
+		},
+	},
+}
+
+// stBrigidsDay is the first Monday in February, or 1 February when it falls on a Friday
+func stBrigidsDay(year int) (time.Time, bool) {
+	if first := date(year, time.February, 1); first.Weekday() == time.Friday {
+		return first, true
+	}
+	return nthWeekday(time.February, time.Monday, 1)(year)
+}
+
+// kingsDay is 27 April, or 26 April when the 27th falls on a Sunday
+func kingsDay(year int) (time.Time, bool) {
+	if day := date(year, time.April, 27); day.Weekday() != time.Sunday {
+		return day, true
+	}
+	return date(year, time.April, 26), true
+}
@@ -0,0 +1,295 @@
+// Package holidays computes public holidays from a bundled, offline dataset of holiday rules keyed by ISO 3166-1
+// alpha-2 country code and ISO 3166-2 subdivision code.
+package holidays
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Holiday is a single public holiday. The date is a civil date at midnight UTC.
+type Holiday struct {
+	Name string
+	Date time.Time
+	// Observed is set on the substitute day given for a holiday that falls on a weekend
+	Observed bool
+}
+
+// observance is how a holiday falling on a weekend is observed
+type observance int
+
+const (
+	// observeNone gives no substitute day
+	observeNone observance = iota
+	// observeNearestWeekday observes a Saturday holiday on the Friday before and a Sunday holiday on the Monday after
+	observeNearestWeekday
+	// observeNextWeekday observes a weekend holiday on the next weekday that is not already a holiday
+	observeNextWeekday
+)
+
+// rule describes a holiday of a country
+type rule struct {
+	name string
+	date func(year int) (time.Time, bool)
+	// regions limits the holiday to the listed subdivisions, and notRegions excludes it from them
+	regions    []string
+	notRegions []string
+	observance observance
+	// fromYear and toYear limit the years the holiday is held, when set
+	fromYear int
+	toYear   int
+}
+
+type country struct {
+	name    string
+	regions map[string]string
+	rules   []rule
+}
+
+// Countries returns the codes of the countries in the dataset
+func Countries() []string {
+	codes := make([]string, 0, len(dataset))
+	for code := range dataset {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Regions returns the subdivision codes with their own holidays in a country
+func Regions(countryCode string) []string {
+	c, ok := dataset[strings.ToUpper(countryCode)]
+	if !ok {
+		return nil
+	}
+	codes := make([]string, 0, len(c.regions))
+	for code := range c.regions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Names returns the names of the holidays in a country, including those of every region
+func Names(countryCode string) []string {
+	c, ok := dataset[strings.ToUpper(countryCode)]
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(c.rules))
+	for _, r := range c.rules {
+		if !containsString(names, r.name) {
+			names = append(names, r.name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NormalizeRegion converts a subdivision code such as GB-SCT or sct to the code used in the dataset
+func NormalizeRegion(countryCode, regionCode string) string {
+	regionCode = strings.ToUpper(strings.TrimSpace(regionCode))
+	return strings.TrimPrefix(regionCode, strings.ToUpper(countryCode)+"-")
+}
+
+// ForYear returns the holidays of a country, and of a region in it when set, that fall in a year, ordered by date.
+// Substitute days for holidays falling on a weekend are included when includeObserved is set.
+func ForYear(countryCode, regionCode string, year int, includeObserved bool) ([]Holiday, error) {
+	countryCode = strings.ToUpper(countryCode)
+	c, ok := dataset[countryCode]
+	if !ok {
+		return nil, fmt.Errorf("no holidays for country %s. Supported countries are %s", countryCode, strings.Join(Countries(), ", "))
+	}
+	region := NormalizeRegion(countryCode, regionCode)
+	if region != "" {
+		if _, ok := c.regions[region]; !ok {
+			return nil, fmt.Errorf("no holidays for region %s of %s. Supported regions are %s", region, c.name, strings.Join(Regions(countryCode), ", "))
+		}
+	}
+
+	// Substitute days can fall in the year before or after the holiday, e.g. New Year's Day on a Saturday
+	holidays := make([]Holiday, 0)
+	for y := year - 1; y <= year+1; y++ {
+		holidays = append(holidays, c.holidays(region, y, includeObserved)...)
+	}
+
+	inYear := make([]Holiday, 0, len(holidays))
+	for _, holiday := range holidays {
+		if holiday.Date.Year() == year {
+			inYear = append(inYear, holiday)
+		}
+	}
+	return inYear, nil
+}
+
+// holidays returns the holidays of a year with their substitute days
+func (c country) holidays(region string, year int, includeObserved bool) []Holiday {
+	type occurrence struct {
+		rule rule
+		date time.Time
+	}
+	occurrences := make([]occurrence, 0, len(c.rules))
+	for _, r := range c.rules {
+		if !r.appliesTo(region, year) {
+			continue
+		}
+		if date, ok := r.date(year); ok {
+			occurrences = append(occurrences, occurrence{rule: r, date: date})
+		}
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool { return occurrences[i].date.Before(occurrences[j].date) })
+
+	taken := make(map[time.Time]bool)
+	holidays := make([]Holiday, 0, len(occurrences))
+	for _, o := range occurrences {
+		taken[o.date] = true
+		holidays = append(holidays, Holiday{Name: o.rule.name, Date: o.date})
+	}
+	if !includeObserved {
+		return holidays
+	}
+
+	// Substitute days are given in date order so that consecutive weekend holidays get consecutive substitute days
+	for _, o := range occurrences {
+		if !isWeekend(o.date) {
+			continue
+		}
+		var observed time.Time
+		switch o.rule.observance {
+		case observeNearestWeekday:
+			observed = o.date.AddDate(0, 0, 1)
+			if o.date.Weekday() == time.Saturday {
+				observed = o.date.AddDate(0, 0, -1)
+			}
+		case observeNextWeekday:
+			observed = o.date.AddDate(0, 0, 1)
+			for isWeekend(observed) || taken[observed] {
+				observed = observed.AddDate(0, 0, 1)
+			}
+		default:
+			continue
+		}
+		taken[observed] = true
+		holidays = append(holidays, Holiday{Name: o.rule.name, Date: observed, Observed: true})
+	}
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+func (r rule) appliesTo(region string, year int) bool {
+	if (r.fromYear != 0 && year < r.fromYear) || (r.toYear != 0 && year > r.toYear) {
+		return false
+	}
+	if len(r.regions) > 0 && !containsString(r.regions, region) {
+		return false
+	}
+	return !containsString(r.notRegions, region)
+}
+
+// fixed is a holiday on the same day every year
+func fixed(month time.Month, day int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		return date(year, month, day), true
+	}
+}
+
+// nthWeekday is the nth weekday of a month, counting from the end of the month when n is negative
+func nthWeekday(month time.Month, weekday time.Weekday, n int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		if n < 0 {
+			last := date(year, month+1, 0)
+			back := (int(last.Weekday()) - int(weekday) + 7) % 7
+			return last.AddDate(0, 0, -back+(n+1)*7), true
+		}
+		first := date(year, month, 1)
+		forward := (int(weekday) - int(first.Weekday()) + 7) % 7
+		return first.AddDate(0, 0, forward+(n-1)*7), true
+	}
+}
+
+// weekdayOnOrAfter is the first weekday on or after a day
+func weekdayOnOrAfter(month time.Month, day int, weekday time.Weekday) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		start := date(year, month, day)
+		return start.AddDate(0, 0, (int(weekday)-int(start.Weekday())+7)%7), true
+	}
+}
+
+// weekdayOnOrBefore is the last weekday on or before a day
+func weekdayOnOrBefore(month time.Month, day int, weekday time.Weekday) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		end := date(year, month, day)
+		return end.AddDate(0, 0, -((int(end.Weekday()) - int(weekday) + 7) % 7)), true
+	}
+}
+
+// easter is a number of days from Easter Sunday
+func easter(offset int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		return easterSunday(year).AddDate(0, 0, offset), true
+	}
+}
+
+// offset is a number of days from another holiday
+func offset(base func(int) (time.Time, bool), days int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		d, ok := base(year)
+		return d.AddDate(0, 0, days), ok
+	}
+}
+
+// moved is a holiday that is moved to another day in some years
+func moved(base func(int) (time.Time, bool), exceptions map[int]time.Time) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		if exception, ok := exceptions[year]; ok {
+			return exception, true
+		}
+		return base(year)
+	}
+}
+
+// dates is a holiday whose date is set for each year
+func dates(byYear map[int]time.Time) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		d, ok := byYear[year]
+		return d, ok
+	}
+}
+
+// easterSunday computes the date of Easter Sunday in the Gregorian calendar
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
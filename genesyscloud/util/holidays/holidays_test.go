@@ -0,0 +1,181 @@
+package holidays
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUnitHolidaysForYear(t *testing.T) {
+	type testCase struct {
+		country  string
+		region   string
+		year     int
+		observed bool
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			country:  "US",
+			year:     2021,
+			observed: true,
+			expected: []string{
+				"2021-01-01 New Year's Day",
+				"2021-01-18 Martin Luther King Jr. Day",
+				"2021-02-15 Washington's Birthday",
+				"2021-05-31 Memorial Day",
+				"2021-06-18 Juneteenth National Independence Day (observed)",
+				"2021-06-19 Juneteenth National Independence Day",
+				"2021-07-04 Independence Day",
+				"2021-07-05 Independence Day (observed)",
+				"2021-09-06 Labor Day",
+				"2021-10-11 Columbus Day",
+				"2021-11-11 Veterans Day",
+				"2021-11-25 Thanksgiving Day",
+				"2021-12-24 Christmas Day (observed)",
+				"2021-12-25 Christmas Day",
+				// New Year's Day 2022 falls on a Saturday
+				"2021-12-31 New Year's Day (observed)",
+			},
+		},
+		{
+			country:  "GB",
+			region:   "GB-ENG",
+			year:     2022,
+			observed: true,
+			expected: []string{
+				"2022-01-01 New Year's Day",
+				"2022-01-03 New Year's Day (observed)",
+				"2022-04-15 Good Friday",
+				"2022-04-18 Easter Monday",
+				"2022-05-02 Early May bank holiday",
+				"2022-06-02 Spring bank holiday",
+				"2022-06-03 Platinum Jubilee bank holiday",
+				"2022-08-29 Summer bank holiday",
+				"2022-09-19 State Funeral of Queen Elizabeth II",
+				"2022-12-25 Christmas Day",
+				"2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (observed)",
+			},
+		},
+		{
+			country:  "gb",
+			region:   "sct",
+			year:     2021,
+			observed: true,
+			expected: []string{
+				"2021-01-01 New Year's Day",
+				"2021-01-02 2nd January",
+				"2021-01-04 2nd January (observed)",
+				"2021-04-02 Good Friday",
+				"2021-05-03 Early May bank holiday",
+				"2021-05-31 Spring bank holiday",
+				"2021-08-02 Summer bank holiday",
+				"2021-11-30 St Andrew's Day",
+				"2021-12-25 Christmas Day",
+				"2021-12-26 Boxing Day",
+				"2021-12-27 Christmas Day (observed)",
+				"2021-12-28 Boxing Day (observed)",
+			},
+		},
+		{
+			country: "DE",
+			region:  "BY",
+			year:    2024,
+			expected: []string{
+				"2024-01-01 New Year's Day",
+				"2024-01-06 Epiphany",
+				"2024-03-29 Good Friday",
+				"2024-04-01 Easter Monday",
+				"2024-05-01 Labour Day",
+				"2024-05-09 Ascension Day",
+				"2024-05-20 Whit Monday",
+				"2024-05-30 Corpus Christi",
+				"2024-10-03 German Unity Day",
+				"2024-11-01 All Saints' Day",
+				"2024-12-25 Christmas Day",
+				"2024-12-26 St. Stephen's Day",
+			},
+		},
+		{
+			country:  "CA",
+			region:   "QC",
+			year:     2024,
+			observed: true,
+			expected: []string{
+				"2024-01-01 New Year's Day",
+				"2024-03-29 Good Friday",
+				"2024-04-01 Easter Monday",
+				"2024-05-20 National Patriots' Day",
+				"2024-06-24 Saint-Jean-Baptiste Day",
+				"2024-07-01 Canada Day",
+				"2024-09-02 Labour Day",
+				"2024-09-30 National Day for Truth and Reconciliation",
+				"2024-10-14 Thanksgiving",
+				"2024-12-25 Christmas Day",
+				"2024-12-26 Boxing Day",
+			},
+		},
+		{
+			country: "IE",
+			year:    2025,
+			expected: []string{
+				"2025-01-01 New Year's Day",
+				"2025-02-03 St Brigid's Day",
+				"2025-03-17 St Patrick's Day",
+				"2025-04-21 Easter Monday",
+				"2025-05-05 May bank holiday",
+				"2025-06-02 June bank holiday",
+				"2025-08-04 August bank holiday",
+				"2025-10-27 October bank holiday",
+				"2025-12-25 Christmas Day",
+				"2025-12-26 St Stephen's Day",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %s %d", tc.country, tc.region, tc.year), func(t *testing.T) {
+			holidays, err := ForYear(tc.country, tc.region, tc.year, tc.observed)
+			if err != nil {
+				t.Fatalf("Failed to get holidays: %v", err)
+			}
+			actual := make([]string, 0, len(holidays))
+			for _, holiday := range holidays {
+				entry := holiday.Date.Format("2006-01-02") + " " + holiday.Name
+				if holiday.Observed {
+					entry += " (observed)"
+				}
+				actual = append(actual, entry)
+			}
+			if strings.Join(actual, "\n") != strings.Join(tc.expected, "\n") {
+				t.Errorf("Expected holidays:\n%s\nGot:\n%s", strings.Join(tc.expected, "\n"), strings.Join(actual, "\n"))
+			}
+		})
+	}
+}
+
+func TestUnitHolidaysEaster(t *testing.T) {
+	expected := map[int]string{
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	}
+	for year, date := range expected {
+		if actual := easterSunday(year).Format("2006-01-02"); actual != date {
+			t.Errorf("Expected Easter %d to be %s, got %s", year, date, actual)
+		}
+	}
+}
+
+func TestUnitHolidaysInvalidCodes(t *testing.T) {
+	if _, err := ForYear("XX", "", 2025, false); err == nil || !strings.Contains(err.Error(), "Supported countries") {
+		t.Errorf("Expected an error for an unknown country, got %v", err)
+	}
+	if _, err := ForYear("GB", "GB-XYZ", 2025, false); err == nil || !strings.Contains(err.Error(), "ENG, NIR, SCT, WLS") {
+		t.Errorf("Expected an error for an unknown region, got %v", err)
+	}
+}
@@ -0,0 +1,449 @@
+package mockapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+/*
+This package contains an in-process fake of the Genesys Cloud Platform API. Entities are stored in memory per collection
+path (e.g. /api/v2/routing/skills) and are served with the same paging envelope, error bodies and status codes as the real API.
+Point an SDK configuration at the server with Configure, or set the GENESYSCLOUD_API_BASE_PATH environment variable to
+Server.URL to point the provider at it.
+*/
+
+// BasePathEnvVar overrides the region base path used by the provider when set
+const BasePathEnvVar = constants.BasePathEnvVar
+
+const (
+	defaultPageSize = 25
+	maxPageSize     = 500
+	mockAccessToken = "mock-access-token"
+)
+
+// DefaultCollections are the collection paths registered on every new server
+var DefaultCollections = []string{
+	"/api/v2/users",
+	"/api/v2/groups",
+	"/api/v2/routing/queues",
+	"/api/v2/routing/skills",
+	"/api/v2/routing/languages",
+	"/api/v2/routing/wrapupcodes",
+	"/api/v2/authorization/divisions",
+	"/api/v2/authorization/roles",
+	"/api/v2/flows",
+	"/api/v2/flows/datatables",
+	"/api/v2/architect/ivrs",
+	"/api/v2/architect/schedules",
+	"/api/v2/architect/schedulegroups",
+	"/api/v2/architect/prompts",
+	"/api/v2/telephony/providers/edges/sites",
+	"/api/v2/telephony/providers/edges/phones",
+	"/api/v2/telephony/providers/edges/didpools",
+	"/api/v2/telephony/providers/edges/dids",
+	"/api/v2/outbound/contactlists",
+}
+
+type collection struct {
+	entities map[string]map[string]interface{}
+	order    []string
+}
+
+// Server is an in-memory fake of the Genesys Cloud Platform API
+type Server struct {
+	*httptest.Server
+
+	mutex         sync.Mutex
+	collections   map[string]*collection
+	organization  map[string]interface{}
+	homeDivision  string
+	throttleCount int
+	retryAfter    int
+	requestCounts map[string]int
+}
+
+// NewServer starts a new mock API server with the DefaultCollections and a home division registered.
+// Close must be called when the server is no longer needed.
+func NewServer() *Server {
+	s := &Server{
+		collections:   make(map[string]*collection),
+		requestCounts: make(map[string]int),
+		retryAfter:    1,
+	}
+	for _, path := range DefaultCollections {
+		s.RegisterCollection(path)
+	}
+
+	s.homeDivision = s.Seed("/api/v2/authorization/divisions", map[string]interface{}{
+		"name":         "Home",
+		"homeDivision": true,
+	})
+	s.organization = map[string]interface{}{
+		"id":                 uuid.NewString(),
+		"name":               "Mock Organization",
+		"defaultCountryCode": "US",
+		"domain":             "mock",
+		"state":              "active",
+		"version":            1,
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	log.Printf("Mock Genesys Cloud API started at %s", s.URL)
+	return s
+}
+
+// Configure points an SDK configuration at the mock server
+func (s *Server) Configure(config *platformclientv2.Configuration) {
+	config.BasePath = s.URL
+	config.AccessToken = mockAccessToken
+}
+
+// NewConfiguration returns a new SDK configuration pointing at the mock server
+func (s *Server) NewConfiguration() *platformclientv2.Configuration {
+	config := platformclientv2.NewConfiguration()
+	s.Configure(config)
+	return config
+}
+
+// RegisterCollection adds an empty collection of entities served under path
+func (s *Server) RegisterCollection(path string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	path = strings.TrimSuffix(path, "/")
+	if _, ok := s.collections[path]; !ok {
+		s.collections[path] = &collection{entities: make(map[string]map[string]interface{})}
+	}
+}
+
+// Seed stores an entity in the collection at path and returns its ID. An ID is generated if the entity does not have one.
+func (s *Server) Seed(path string, entity map[string]interface{}) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	path = strings.TrimSuffix(path, "/")
+	c := s.collection(path)
+	if c == nil {
+		c = &collection{entities: make(map[string]map[string]interface{})}
+		s.collections[path] = c
+	}
+	return s.store(path, c, copyEntity(entity))
+}
+
+// Get returns a copy of a stored entity
+func (s *Server) Get(path string, id string) (map[string]interface{}, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	c := s.collection(strings.TrimSuffix(path, "/"))
+	if c == nil {
+		return nil, false
+	}
+	entity, ok := c.entities[id]
+	if !ok {
+		return nil, false
+	}
+	return copyEntity(entity), true
+}
+
+// Count returns the number of entities stored in the collection at path
+func (s *Server) Count(path string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c := s.collection(strings.TrimSuffix(path, "/")); c != nil {
+		return len(c.entities)
+	}
+	return 0
+}
+
+// HomeDivisionId returns the ID of the seeded home division
+func (s *Server) HomeDivisionId() string {
+	return s.homeDivision
+}
+
+// ThrottleNext makes the next count requests fail with a 429 and the given Retry-After value in seconds
+func (s *Server) ThrottleNext(count int, retryAfterSeconds int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.throttleCount = count
+	s.retryAfter = retryAfterSeconds
+}
+
+// RequestCount returns the number of requests received for a method and path, e.g. "GET /api/v2/routing/skills"
+func (s *Server) RequestCount(method string, path string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requestCounts[method+" "+path]
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	s.requestCounts[r.Method+" "+path]++
+
+	// Client credentials grants always succeed so the provider can authorize against the server
+	if path == "/oauth/token" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": mockAccessToken,
+			"token_type":   "bearer",
+			"expires_in":   86400,
+		})
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "authentication.required", "No authentication bearer token specified in authorization header.")
+		return
+	}
+
+	if s.throttleCount > 0 {
+		s.throttleCount--
+		w.Header().Set("Retry-After", strconv.Itoa(s.retryAfter))
+		w.Header().Set("inin-ratelimit-count", "300")
+		w.Header().Set("inin-ratelimit-allowed", "300")
+		w.Header().Set("inin-ratelimit-reset", strconv.Itoa(s.retryAfter))
+		writeError(w, http.StatusTooManyRequests, "too.many.requests", "Rate limit exceeded the maximum.")
+		return
+	}
+
+	switch path {
+	case "/api/v2/organizations/me":
+		writeJSON(w, http.StatusOK, s.organization)
+		return
+	case "/api/v2/authorization/divisions/home":
+		path = "/api/v2/authorization/divisions/" + s.homeDivision
+	}
+
+	if c := s.collection(path); c != nil {
+		s.handleCollection(w, r, path, c)
+		return
+	}
+
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		if c := s.collection(path[:i]); c != nil {
+			s.handleEntity(w, r, path[:i], path[i+1:], c)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "not.found", fmt.Sprintf("Resource %s not found.", path))
+}
+
+func (s *Server) handleCollection(w http.ResponseWriter, r *http.Request, path string, c *collection) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.page(r, path, c))
+	case http.MethodPost:
+		entity, err := readEntity(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad.request", err.Error())
+			return
+		}
+		delete(entity, "id")
+		id := s.store(path, c, entity)
+		writeJSON(w, http.StatusOK, c.entities[id])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method.not.allowed", fmt.Sprintf("Method %s not allowed on %s.", r.Method, path))
+	}
+}
+
+func (s *Server) handleEntity(w http.ResponseWriter, r *http.Request, path string, id string, c *collection) {
+	existing, ok := c.entities[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not.found", fmt.Sprintf("Unable to find %s with id %s.", path, id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPut, http.MethodPatch:
+		entity, err := readEntity(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "bad.request", err.Error())
+			return
+		}
+		if r.Method == http.MethodPatch {
+			for k, v := range entity {
+				existing[k] = v
+			}
+			entity = existing
+		}
+		entity["id"] = id
+		entity["version"] = version(existing) + 1
+		s.store(path, c, entity)
+		writeJSON(w, http.StatusOK, c.entities[id])
+	case http.MethodDelete:
+		delete(c.entities, id)
+		for i, orderedId := range c.order {
+			if orderedId == id {
+				c.order = append(c.order[:i], c.order[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method.not.allowed", fmt.Sprintf("Method %s not allowed on %s.", r.Method, path))
+	}
+}
+
+// page builds a paged entity listing. The name and id query parameters filter the listing, with a trailing '*' on
+// name matching by prefix as the real API does.
+func (s *Server) page(r *http.Request, path string, c *collection) map[string]interface{} {
+	query := r.URL.Query()
+	pageSize := queryInt(query.Get("pageSize"), defaultPageSize)
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	pageNumber := queryInt(query.Get("pageNumber"), 1)
+
+	name := query.Get("name")
+	ids := query["id"]
+
+	matches := make([]interface{}, 0)
+	for _, id := range c.order {
+		entity := c.entities[id]
+		if len(ids) > 0 && !containsString(ids, id) {
+			continue
+		}
+		if name != "" && !matchesName(entity, name) {
+			continue
+		}
+		matches = append(matches, entity)
+	}
+
+	total := len(matches)
+	pageCount := int(math.Ceil(float64(total) / float64(pageSize)))
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+
+	result := map[string]interface{}{
+		"entities":   matches[start:end],
+		"pageSize":   pageSize,
+		"pageNumber": pageNumber,
+		"total":      total,
+		"pageCount":  pageCount,
+		"selfUri":    fmt.Sprintf("%s?pageSize=%d&pageNumber=%d", path, pageSize, pageNumber),
+		"firstUri":   fmt.Sprintf("%s?pageSize=%d&pageNumber=1", path, pageSize),
+		"lastUri":    fmt.Sprintf("%s?pageSize=%d&pageNumber=%d", path, pageSize, pageCount),
+	}
+	if pageNumber < pageCount {
+		result["nextUri"] = fmt.Sprintf("%s?pageSize=%d&pageNumber=%d", path, pageSize, pageNumber+1)
+	}
+	return result
+}
+
+func (s *Server) collection(path string) *collection {
+	return s.collections[path]
+}
+
+func (s *Server) store(path string, c *collection, entity map[string]interface{}) string {
+	id, _ := entity["id"].(string)
+	if id == "" {
+		id = uuid.NewString()
+		entity["id"] = id
+	}
+	if _, ok := entity["version"]; !ok {
+		entity["version"] = 1
+	}
+	if _, ok := c.entities[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	entity["selfUri"] = strings.TrimSuffix(path, "/") + "/" + id
+	entity["dateModified"] = time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+	c.entities[id] = entity
+	return id
+}
+
+func readEntity(r *http.Request) (map[string]interface{}, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	entity := make(map[string]interface{})
+	if len(body) == 0 {
+		return entity, nil
+	}
+	if err := json.Unmarshal(body, &entity); err != nil {
+		return nil, fmt.Errorf("invalid request body: %v", err)
+	}
+	return entity, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("inin-correlation-id", uuid.NewString())
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("Mock Genesys Cloud API failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"message": message,
+		"code":    code,
+		"status":  status,
+	})
+}
+
+func matchesName(entity map[string]interface{}, name string) bool {
+	entityName, _ := entity["name"].(string)
+	if strings.HasSuffix(name, "*") {
+		return strings.HasPrefix(strings.ToLower(entityName), strings.ToLower(strings.TrimSuffix(name, "*")))
+	}
+	return entityName == name
+}
+
+func version(entity map[string]interface{}) int {
+	switch v := entity["version"].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return 0
+}
+
+func queryInt(value string, defaultValue int) int {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 1 {
+		return defaultValue
+	}
+	return i
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func copyEntity(entity map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(entity))
+	for k, v := range entity {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitMockApiPaging(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	for i := 0; i < 55; i++ {
+		server.Seed("/api/v2/routing/skills", map[string]interface{}{"name": fmt.Sprintf("skill %d", i)})
+	}
+
+	api := platformclientv2.NewRoutingApiWithConfig(server.NewConfiguration())
+	skills, resp, err := api.GetRoutingSkills(25, 3, "", nil)
+	if err != nil {
+		t.Fatalf("failed to get skills: %v", err)
+	}
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, *skills.PageCount)
+	assert.Equal(t, 55, int(*skills.Total))
+	assert.Len(t, *skills.Entities, 5)
+	assert.Equal(t, "skill 50", *(*skills.Entities)[0].Name)
+
+	filtered, _, err := api.GetRoutingSkills(25, 1, "skill 1*", nil)
+	if err != nil {
+		t.Fatalf("failed to get skills by name: %v", err)
+	}
+	assert.Len(t, *filtered.Entities, 11)
+}
+
+func TestUnitMockApiCrud(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	api := platformclientv2.NewRoutingApiWithConfig(server.NewConfiguration())
+	name := "Mock Skill"
+	skill, _, err := api.PostRoutingSkills(platformclientv2.Routingskill{Name: &name})
+	if err != nil {
+		t.Fatalf("failed to create skill: %v", err)
+	}
+	assert.NotEmpty(t, *skill.Id)
+	assert.Equal(t, 1, server.Count("/api/v2/routing/skills"))
+
+	read, _, err := api.GetRoutingSkill(*skill.Id)
+	if err != nil {
+		t.Fatalf("failed to read skill: %v", err)
+	}
+	assert.Equal(t, name, *read.Name)
+
+	if _, err := api.DeleteRoutingSkill(*skill.Id); err != nil {
+		t.Fatalf("failed to delete skill: %v", err)
+	}
+
+	_, resp, err := api.GetRoutingSkill(*skill.Id)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestUnitMockApiThrottle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.ThrottleNext(1, 1)
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v2/routing/skills", nil)
+	req.Header.Set("Authorization", "Bearer mock-access-token")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to call mock server: %v", err)
+	}
+	resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("Retry-After"))
+
+	// The SDK honours Retry-After when retries are configured
+	server.ThrottleNext(1, 1)
+	config := server.NewConfiguration()
+	config.RetryConfiguration = &platformclientv2.RetryConfiguration{
+		RetryWaitMin: 10 * time.Millisecond,
+		RetryWaitMax: 2 * time.Second,
+		RetryMax:     2,
+	}
+	_, apiResp, err := platformclientv2.NewRoutingApiWithConfig(config).GetRoutingSkills(25, 1, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, apiResp.StatusCode)
+	assert.Equal(t, 3, server.RequestCount(http.MethodGet, "/api/v2/routing/skills"))
+}
+
+func TestUnitMockApiOrganizationAndHomeDivision(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	config := server.NewConfiguration()
+	org, _, err := platformclientv2.NewOrganizationApiWithConfig(config).GetOrganizationsMe()
+	if err != nil {
+		t.Fatalf("failed to get organization: %v", err)
+	}
+	assert.Equal(t, "US", *org.DefaultCountryCode)
+
+	home, _, err := platformclientv2.NewObjectsApiWithConfig(config).GetAuthorizationDivisionsHome()
+	if err != nil {
+		t.Fatalf("failed to get home division: %v", err)
+	}
+	assert.Equal(t, server.HomeDivisionId(), *home.Id)
+}
+
+func TestUnitMockApiUnauthorized(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	config := server.NewConfiguration()
+	config.AccessToken = ""
+	_, resp, err := platformclientv2.NewRoutingApiWithConfig(config).GetRoutingSkills(25, 1, "", nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestUnitMockApiClientCredentials(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	config := platformclientv2.GetDefaultConfiguration()
+	config.BasePath = server.URL
+	if err := config.AuthorizeClientCredentials("client-id", "client-secret"); err != nil {
+		t.Fatalf("failed to authorize client credentials: %v", err)
+	}
+	assert.Equal(t, mockAccessToken, config.AccessToken)
+}
@@ -0,0 +1,72 @@
+package rrule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Occurrence is a single occurrence of a schedule
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Occurrences returns the occurrences of a schedule that overlap the window [windowStart, windowEnd), clipped to the
+// window. start and end are the wall clock times of the first occurrence, which are placed in loc, and rule is the
+// optional recurrence rule of the schedule. An error is returned when there are more than limit occurrences.
+func Occurrences(start, end time.Time, rule string, loc *time.Location, windowStart, windowEnd time.Time, limit int) ([]Occurrence, error) {
+	duration := end.Sub(start)
+	if duration <= 0 {
+		return nil, fmt.Errorf("schedule ends before it starts")
+	}
+	first := inLocation(start, loc)
+
+	starts := []time.Time{first}
+	if strings.TrimSpace(rule) != "" {
+		parsed, err := Parse(rule)
+		if err != nil {
+			return nil, err
+		}
+		// Occurrences that start before the window can still overlap it. The extra hour covers daylight saving time changes.
+		starts, err = parsed.Between(first, windowStart.Add(-duration-time.Hour), windowEnd, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	occurrences := make([]Occurrence, 0, len(starts))
+	for _, occurrenceStart := range starts {
+		// The duration is added to the wall clock, so an occurrence spanning a daylight saving time change keeps its end time
+		occurrenceEnd := inLocation(inLocation(occurrenceStart, time.UTC).Add(duration), loc)
+		if !occurrenceEnd.After(windowStart) || !occurrenceStart.Before(windowEnd) {
+			continue
+		}
+		occurrences = append(occurrences, Occurrence{
+			Start: Latest(occurrenceStart, windowStart),
+			End:   Earliest(occurrenceEnd, windowEnd),
+		})
+	}
+	return occurrences, nil
+}
+
+// inLocation returns the time with the same wall clock in the location
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// Earliest returns the earlier of two times
+func Earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// Latest returns the later of two times
+func Latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
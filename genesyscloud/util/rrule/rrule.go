@@ -0,0 +1,625 @@
+package rrule
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Package rrule parses and evaluates iCalendar recurrence rules as defined in RFC 5545 section 3.3.10, e.g.
+FREQ=YEARLY;BYMONTH=11;BYDAY=4TH for the fourth Thursday of November.
+
+Rules are evaluated on wall clock times, as the start and end of an Architect schedule are local date-times that are
+interpreted in the time zone of the schedule group. The date-times of each occurrence are computed without a time zone
+and only then placed in the location of the first occurrence.
+*/
+
+type Frequency int
+
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"SECONDLY": Secondly,
+	"MINUTELY": Minutely,
+	"HOURLY":   Hourly,
+	"DAILY":    Daily,
+	"WEEKLY":   Weekly,
+	"MONTHLY":  Monthly,
+	"YEARLY":   Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var (
+	weekdayNumRegex  = regexp.MustCompile(`^([+-]?)(\d{1,2})?(SU|MO|TU|WE|TH|FR|SA)$`)
+	leadingZeroRegex = regexp.MustCompile(`^([+-]?)0+(\d)`)
+)
+
+// The formats UNTIL may be given in. A date-time ending with Z is in UTC, otherwise it is a local date-time.
+const (
+	untilDateFormat     = "20060102"
+	untilDateTimeFormat = "20060102T150405"
+)
+
+// WeekdayNum is a BYDAY value. An Ordinal of 0 matches every such weekday, otherwise it selects the nth weekday of the
+// month or year, counting from the end when negative.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	UntilUTC   bool
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// Parse parses a recurrence rule and checks the combinations of rule parts that RFC 5545 does not allow
+func Parse(rule string) (*Rule, error) {
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, fmt.Errorf("rrule is empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(rule, ";") {
+		name, value, found := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if !found || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid rule part '%s': should be in the form NAME=VALUE", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s must not occur more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			freq, ok := frequencies[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid FREQ %s: should be one of SECONDLY, MINUTELY, HOURLY, DAILY, WEEKLY, MONTHLY or YEARLY", value)
+			}
+			r.Freq = freq
+		case "INTERVAL":
+			r.Interval, err = parseInt(name, value)
+		case "COUNT":
+			r.Count, err = parseInt(name, value)
+		case "UNTIL":
+			err = r.parseUntil(value)
+		case "BYSECOND":
+			r.BySecond, err = parseIntList(name, value, 0, 60, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseIntList(name, value, 0, 59, false)
+		case "BYHOUR":
+			r.ByHour, err = parseIntList(name, value, 0, 23, false)
+		case "BYDAY":
+			r.ByDay, err = parseWeekdayNumList(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseIntList(name, value, 1, 31, true)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseIntList(name, value, 1, 366, true)
+		case "BYWEEKNO":
+			r.ByWeekNo, err = parseIntList(name, value, 1, 53, true)
+		case "BYMONTH":
+			r.ByMonth, err = parseIntList(name, value, 1, 12, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseIntList(name, value, 1, 366, true)
+		case "WKST":
+			weekday, ok := weekdays[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %s: should be one of SU, MO, TU, WE, TH, FR or SA", value)
+			}
+			r.WeekStart = weekday
+		default:
+			return nil, fmt.Errorf("unknown rule part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !seen["FREQ"] {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if err := r.validate(seen); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// validate checks the restrictions RFC 5545 places on combining rule parts
+func (r *Rule) validate(seen map[string]bool) error {
+	if seen["UNTIL"] && seen["COUNT"] {
+		return fmt.Errorf("UNTIL and COUNT must not both be set")
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq == Weekly {
+		return fmt.Errorf("BYMONTHDAY must not be used when FREQ is WEEKLY")
+	}
+	if len(r.ByYearDay) > 0 && (r.Freq == Daily || r.Freq == Weekly || r.Freq == Monthly) {
+		return fmt.Errorf("BYYEARDAY must not be used when FREQ is DAILY, WEEKLY or MONTHLY")
+	}
+	if len(r.ByWeekNo) > 0 && r.Freq != Yearly {
+		return fmt.Errorf("BYWEEKNO may only be used when FREQ is YEARLY")
+	}
+	for _, day := range r.ByDay {
+		if day.Ordinal == 0 {
+			continue
+		}
+		if r.Freq != Monthly && r.Freq != Yearly {
+			return fmt.Errorf("BYDAY must not have a numeric value when FREQ is not MONTHLY or YEARLY")
+		}
+		if r.Freq == Yearly && len(r.ByWeekNo) > 0 {
+			return fmt.Errorf("BYDAY must not have a numeric value when FREQ is YEARLY and BYWEEKNO is set")
+		}
+	}
+	if len(r.BySetPos) > 0 && len(r.BySecond)+len(r.ByMinute)+len(r.ByHour)+len(r.ByDay)+len(r.ByMonthDay)+
+		len(r.ByYearDay)+len(r.ByWeekNo)+len(r.ByMonth) == 0 {
+		return fmt.Errorf("BYSETPOS must only be used with another BYxxx rule part")
+	}
+	return nil
+}
+
+func (r *Rule) parseUntil(value string) error {
+	if until, err := time.Parse(untilDateFormat, value); err == nil {
+		// A date includes every occurrence that starts on that day
+		until = until.Add(24*time.Hour - time.Second)
+		r.Until = &until
+		return nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		until, err := time.Parse(untilDateTimeFormat, strings.TrimSuffix(value, "Z"))
+		if err == nil {
+			r.Until = &until
+			r.UntilUTC = true
+			return nil
+		}
+	} else if until, err := time.Parse(untilDateTimeFormat, value); err == nil {
+		r.Until = &until
+		return nil
+	}
+	return fmt.Errorf("invalid UNTIL %s: should be a date (YYYYMMDD) or date-time (YYYYMMDDTHHMMSS or YYYYMMDDTHHMMSSZ)", value)
+}
+
+// parseInt parses a positive integer
+func parseInt(name, value string) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || strings.HasPrefix(value, "+") {
+		return 0, fmt.Errorf("invalid %s value %s: should be an integer", name, value)
+	}
+	if i < 1 {
+		return 0, fmt.Errorf("invalid %s value %s: should be at least 1", name, value)
+	}
+	return i, nil
+}
+
+// parseIntList parses a comma separated list of integers. Values may be negative, counting from the end, when signed.
+func parseIntList(name, value string, minimum, maximum int, signed bool) ([]int, error) {
+	values := make([]int, 0)
+	for _, item := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %s: should be an integer", name, item)
+		}
+		abs := i
+		if signed && i < 0 {
+			abs = -i
+		}
+		if abs < minimum || abs > maximum {
+			if signed {
+				return nil, fmt.Errorf("invalid %s value %s: should be between %d and %d or between -%d and -%d", name, item, minimum, maximum, maximum, minimum)
+			}
+			return nil, fmt.Errorf("invalid %s value %s: should be between %d and %d", name, item, minimum, maximum)
+		}
+		values = append(values, i)
+	}
+	return values, nil
+}
+
+func parseWeekdayNumList(value string) ([]WeekdayNum, error) {
+	days := make([]WeekdayNum, 0)
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		match := weekdayNumRegex.FindStringSubmatch(item)
+		if match == nil {
+			return nil, fmt.Errorf("invalid BYDAY value %s: should be a weekday (SU, MO, TU, WE, TH, FR or SA) with an optional ordinal, e.g. 1MO or -1FR", item)
+		}
+		day := WeekdayNum{Weekday: weekdays[match[3]]}
+		if match[2] != "" {
+			ordinal, _ := strconv.Atoi(match[2])
+			if ordinal < 1 || ordinal > 53 {
+				return nil, fmt.Errorf("invalid BYDAY value %s: the ordinal should be between 1 and 53 or between -53 and -1", item)
+			}
+			if match[1] == "-" {
+				ordinal = -ordinal
+			}
+			day.Ordinal = ordinal
+		} else if match[1] != "" {
+			return nil, fmt.Errorf("invalid BYDAY value %s: a sign must be followed by an ordinal", item)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// Normalize removes the leading zeros from the numeric values of a rule, e.g. INTERVAL=01 becomes INTERVAL=1, and
+// otherwise keeps the rule as it is
+func Normalize(rule string) string {
+	parts := strings.Split(rule, ";")
+	for i, part := range parts {
+		name, value, found := strings.Cut(part, "=")
+		if !found || strings.EqualFold(name, "UNTIL") {
+			continue
+		}
+		items := strings.Split(value, ",")
+		for j, item := range items {
+			items[j] = leadingZeroRegex.ReplaceAllString(item, "$1$2")
+		}
+		parts[i] = name + "=" + strings.Join(items, ",")
+	}
+	return strings.Join(parts, ";")
+}
+
+// Between returns the start of each occurrence of the rule that starts in the window [from, to). The first occurrence is
+// dtstart, whose location the occurrences are placed in. An error is returned when there are more than limit
+// occurrences in the window.
+func (r *Rule) Between(dtstart, from, to time.Time, limit int) ([]time.Time, error) {
+	loc := dtstart.Location()
+	start := wallClock(dtstart)
+	rule := r.withDefaults(start)
+
+	var until time.Time
+	if r.Until != nil {
+		until = *r.Until
+		if !r.UntilUTC {
+			until = fromWallClock(until, loc)
+		}
+	}
+
+	occurrences := make([]time.Time, 0)
+	count := 0
+	for period := rule.firstPeriod(start); ; period = rule.nextPeriod(period) {
+		periodStart := fromWallClock(rule.periodDays(period)[0], loc)
+		if rule.Freq < Daily {
+			periodStart = fromWallClock(period, loc)
+		}
+		if !periodStart.Before(to) || (r.Until != nil && periodStart.After(until)) {
+			return occurrences, nil
+		}
+		if rule.Freq < Daily {
+			// Skip to the next day or hour with a match, rather than stepping through every period that cannot match
+			if skipped, ok := rule.skipSubDaily(period); ok {
+				period = skipped
+				if !fromWallClock(period, loc).Before(to) {
+					return occurrences, nil
+				}
+			}
+		}
+
+		for _, candidate := range rule.expand(period, start) {
+			if candidate.Before(start) {
+				continue
+			}
+			occurrence := fromWallClock(candidate, loc)
+			if r.Until != nil && occurrence.After(until) {
+				return occurrences, nil
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return occurrences, nil
+			}
+			if !occurrence.Before(from) && occurrence.Before(to) {
+				if limit > 0 && len(occurrences) >= limit {
+					return nil, fmt.Errorf("rule has more than %d occurrences in the window", limit)
+				}
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+	}
+}
+
+// withDefaults returns a copy of the rule with the rule parts that are taken from the first occurrence when not set
+func (r *Rule) withDefaults(start time.Time) *Rule {
+	rule := *r
+	if len(rule.ByWeekNo) == 0 && len(rule.ByYearDay) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+		switch rule.Freq {
+		case Yearly:
+			if len(rule.ByMonth) == 0 {
+				rule.ByMonth = []int{int(start.Month())}
+			}
+			rule.ByMonthDay = []int{start.Day()}
+		case Monthly:
+			rule.ByMonthDay = []int{start.Day()}
+		case Weekly:
+			rule.ByDay = []WeekdayNum{{Weekday: start.Weekday()}}
+		}
+	}
+	if len(rule.ByHour) == 0 && rule.Freq > Hourly {
+		rule.ByHour = []int{start.Hour()}
+	}
+	if len(rule.ByMinute) == 0 && rule.Freq > Minutely {
+		rule.ByMinute = []int{start.Minute()}
+	}
+	if len(rule.BySecond) == 0 && rule.Freq > Secondly {
+		rule.BySecond = []int{start.Second()}
+	}
+	return &rule
+}
+
+// firstPeriod returns the start of the period that contains the first occurrence
+func (r *Rule) firstPeriod(start time.Time) time.Time {
+	switch r.Freq {
+	case Yearly:
+		return time.Date(start.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	case Monthly:
+		return time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case Weekly:
+		day := startOfDay(start)
+		return day.AddDate(0, 0, -((int(day.Weekday()) - int(r.WeekStart) + 7) % 7))
+	case Daily:
+		return startOfDay(start)
+	case Hourly:
+		return start.Truncate(time.Hour)
+	case Minutely:
+		return start.Truncate(time.Minute)
+	default:
+		return start.Truncate(time.Second)
+	}
+}
+
+func (r *Rule) nextPeriod(period time.Time) time.Time {
+	switch r.Freq {
+	case Yearly:
+		return period.AddDate(r.Interval, 0, 0)
+	case Monthly:
+		return period.AddDate(0, r.Interval, 0)
+	case Weekly:
+		return period.AddDate(0, 0, 7*r.Interval)
+	case Daily:
+		return period.AddDate(0, 0, r.Interval)
+	default:
+		return period.Add(time.Duration(r.Interval) * r.step())
+	}
+}
+
+func (r *Rule) step() time.Duration {
+	switch r.Freq {
+	case Hourly:
+		return time.Hour
+	case Minutely:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// skipSubDaily moves an hourly, minutely or secondly period forward to the first period of the next day or hour that
+// can match, when the day or hour of the period cannot
+func (r *Rule) skipSubDaily(period time.Time) (time.Time, bool) {
+	var boundary time.Time
+	if !r.matchesDay(startOfDay(period), period) {
+		boundary = startOfDay(period).AddDate(0, 0, 1)
+	} else if r.Freq < Hourly && len(r.ByHour) > 0 && !containsInt(r.ByHour, period.Hour()) {
+		boundary = period.Truncate(time.Hour).Add(time.Hour)
+	} else {
+		return period, false
+	}
+
+	step := time.Duration(r.Interval) * r.step()
+	steps := (boundary.Sub(period) + step - 1) / step
+	return period.Add(steps * step), true
+}
+
+// expand returns the date-times in a period that match the rule, in order
+func (r *Rule) expand(period, start time.Time) []time.Time {
+	candidates := make([]time.Time, 0)
+	for _, day := range r.periodDays(period) {
+		if !r.matchesDay(day, period) {
+			continue
+		}
+		for _, hour := range r.values(r.ByHour, Hourly, period.Hour()) {
+			for _, minute := range r.values(r.ByMinute, Minutely, period.Minute()) {
+				for _, second := range r.values(r.BySecond, Secondly, period.Second()) {
+					candidates = append(candidates, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, time.UTC))
+				}
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	if len(r.BySetPos) == 0 {
+		return candidates
+	}
+
+	selected := make([]time.Time, 0, len(r.BySetPos))
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(candidates) + pos
+		}
+		if i >= 0 && i < len(candidates) && !containsTime(selected, candidates[i]) {
+			selected = append(selected, candidates[i])
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Before(selected[j]) })
+	return selected
+}
+
+// values returns the values of an hour, minute or second rule part. For a frequency of that unit or smaller, the rule
+// part limits the value of the period, otherwise it expands the period to each value.
+func (r *Rule) values(byValues []int, unit Frequency, periodValue int) []int {
+	if r.Freq > unit {
+		return byValues
+	}
+	if len(byValues) > 0 && !containsInt(byValues, periodValue) {
+		return nil
+	}
+	return []int{periodValue}
+}
+
+// periodDays returns the days of a period
+func (r *Rule) periodDays(period time.Time) []time.Time {
+	var first, end time.Time
+	switch r.Freq {
+	case Yearly:
+		if len(r.ByWeekNo) > 0 {
+			// The weeks of a year can start in the previous year and end in the next
+			first, end = weekOneStart(period.Year(), r.WeekStart), weekOneStart(period.Year()+1, r.WeekStart)
+		} else {
+			first, end = period, period.AddDate(1, 0, 0)
+		}
+	case Monthly:
+		first, end = period, period.AddDate(0, 1, 0)
+	case Weekly:
+		first, end = period, period.AddDate(0, 0, 7)
+	default:
+		first = startOfDay(period)
+		end = first.AddDate(0, 0, 1)
+	}
+
+	days := make([]time.Time, 0)
+	for day := first; day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// matchesDay checks a day against the day rule parts
+func (r *Rule) matchesDay(day, period time.Time) bool {
+	if len(r.ByMonth) > 0 && !containsInt(r.ByMonth, int(day.Month())) {
+		return false
+	}
+	if len(r.ByWeekNo) > 0 {
+		weekNo := int(day.Sub(weekOneStart(period.Year(), r.WeekStart)).Hours()/24)/7 + 1
+		weeks := weeksInYear(period.Year(), r.WeekStart)
+		if !containsInt(r.ByWeekNo, weekNo) && !containsInt(r.ByWeekNo, weekNo-weeks-1) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 {
+		yearDay, days := day.YearDay(), daysInYear(day.Year())
+		if !containsInt(r.ByYearDay, yearDay) && !containsInt(r.ByYearDay, yearDay-days-1) {
+			return false
+		}
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := daysInMonth(day.Year(), day.Month())
+		if !containsInt(r.ByMonthDay, day.Day()) && !containsInt(r.ByMonthDay, day.Day()-days-1) {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 && !r.matchesWeekday(day) {
+		return false
+	}
+	return true
+}
+
+// matchesWeekday checks a day against BYDAY. Ordinals count the weekdays of the month when FREQ is MONTHLY or FREQ is
+// YEARLY with BYMONTH, and the weekdays of the year otherwise.
+func (r *Rule) matchesWeekday(day time.Time) bool {
+	for _, weekday := range r.ByDay {
+		if weekday.Weekday != day.Weekday() {
+			continue
+		}
+		if weekday.Ordinal == 0 {
+			return true
+		}
+
+		var index, total int
+		if r.Freq == Monthly || len(r.ByMonth) > 0 {
+			index, total = day.Day(), daysInMonth(day.Year(), day.Month())
+		} else {
+			index, total = day.YearDay(), daysInYear(day.Year())
+		}
+		fromStart := (index-1)/7 + 1
+		fromEnd := -((total-index)/7 + 1)
+		if weekday.Ordinal == fromStart || weekday.Ordinal == fromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// weekOneStart returns the first day of week 1 of a year, which is the first week with at least four days in the year
+func weekOneStart(year int, weekStart time.Weekday) time.Time {
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan1.Weekday()) - int(weekStart) + 7) % 7
+	if offset <= 3 {
+		return jan1.AddDate(0, 0, -offset)
+	}
+	return jan1.AddDate(0, 0, 7-offset)
+}
+
+func weeksInYear(year int, weekStart time.Weekday) int {
+	return int(weekOneStart(year+1, weekStart).Sub(weekOneStart(year, weekStart)).Hours()/24) / 7
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// wallClock returns the date and time of day of a time, without its location
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+func fromWallClock(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTime(values []time.Time, value time.Time) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+package rrule
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestUnitRruleBetween(t *testing.T) {
+	type testCase struct {
+		rule     string
+		dtstart  string
+		from     string
+		to       string
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			// Thanksgiving
+			rule:     "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			dtstart:  "2024-11-28T00:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2027-01-01T00:00:00",
+			expected: []string{"2024-11-28T00:00:00", "2025-11-27T00:00:00", "2026-11-26T00:00:00"},
+		},
+		{
+			rule:     "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25",
+			dtstart:  "2024-12-25T00:00:00",
+			from:     "2025-01-01T00:00:00",
+			to:       "2027-01-01T00:00:00",
+			expected: []string{"2025-12-25T00:00:00", "2026-12-25T00:00:00"},
+		},
+		{
+			// The month and day are taken from the first occurrence
+			rule:     "FREQ=YEARLY;INTERVAL=01",
+			dtstart:  "2024-07-04T08:30:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2026-12-31T00:00:00",
+			expected: []string{"2024-07-04T08:30:00", "2025-07-04T08:30:00", "2026-07-04T08:30:00"},
+		},
+		{
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+			dtstart:  "2024-01-01T00:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-05-01T00:00:00",
+			expected: []string{"2024-01-26T00:00:00", "2024-02-23T00:00:00", "2024-03-29T00:00:00", "2024-04-26T00:00:00"},
+		},
+		{
+			// Last weekday of the month
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			dtstart:  "2024-01-01T17:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-04-01T00:00:00",
+			expected: []string{"2024-01-31T17:00:00", "2024-02-29T17:00:00", "2024-03-29T17:00:00"},
+		},
+		{
+			// Months without a 31st are skipped
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=31",
+			dtstart:  "2024-01-31T00:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-06-01T00:00:00",
+			expected: []string{"2024-01-31T00:00:00", "2024-03-31T00:00:00", "2024-05-31T00:00:00"},
+		},
+		{
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart:  "2024-01-31T00:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-04-01T00:00:00",
+			expected: []string{"2024-01-31T00:00:00", "2024-02-29T00:00:00", "2024-03-31T00:00:00"},
+		},
+		{
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR;COUNT=6",
+			dtstart:  "2024-01-01T09:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2025-01-01T00:00:00",
+			expected: []string{"2024-01-01T09:00:00", "2024-01-03T09:00:00", "2024-01-05T09:00:00", "2024-01-15T09:00:00", "2024-01-17T09:00:00", "2024-01-19T09:00:00"},
+		},
+		{
+			// COUNT is counted from the first occurrence, not the start of the window
+			rule:     "FREQ=DAILY;COUNT=5",
+			dtstart:  "2024-01-01T09:00:00",
+			from:     "2024-01-04T00:00:00",
+			to:       "2024-02-01T00:00:00",
+			expected: []string{"2024-01-04T09:00:00", "2024-01-05T09:00:00"},
+		},
+		{
+			rule:     "FREQ=DAILY;UNTIL=20240103",
+			dtstart:  "2024-01-01T09:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-02-01T00:00:00",
+			expected: []string{"2024-01-01T09:00:00", "2024-01-02T09:00:00", "2024-01-03T09:00:00"},
+		},
+		{
+			// Monday of week 20
+			rule:     "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+			dtstart:  "1997-05-12T09:00:00",
+			from:     "1997-01-01T00:00:00",
+			to:       "2000-01-01T00:00:00",
+			expected: []string{"1997-05-12T09:00:00", "1998-05-11T09:00:00", "1999-05-17T09:00:00"},
+		},
+		{
+			rule:     "FREQ=YEARLY;BYYEARDAY=1,100,200",
+			dtstart:  "1997-01-01T09:00:00",
+			from:     "1997-01-01T00:00:00",
+			to:       "1998-01-01T00:00:00",
+			expected: []string{"1997-01-01T09:00:00", "1997-04-10T09:00:00", "1997-07-19T09:00:00"},
+		},
+		{
+			rule:     "FREQ=MINUTELY;INTERVAL=15;COUNT=4",
+			dtstart:  "2024-01-01T09:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-01-02T00:00:00",
+			expected: []string{"2024-01-01T09:00:00", "2024-01-01T09:15:00", "2024-01-01T09:30:00", "2024-01-01T09:45:00"},
+		},
+		{
+			// The days and hours that cannot match are skipped
+			rule:     "FREQ=HOURLY;BYDAY=SA;BYHOUR=10,11",
+			dtstart:  "2024-01-05T09:00:00",
+			from:     "2024-01-01T00:00:00",
+			to:       "2024-01-08T00:00:00",
+			expected: []string{"2024-01-06T10:00:00", "2024-01-06T11:00:00"},
+		},
+		{
+			// The time of day is kept across daylight saving time changes
+			rule:     "FREQ=DAILY",
+			dtstart:  "2024-03-09T09:00:00",
+			from:     "2024-03-09T00:00:00",
+			to:       "2024-03-11T00:00:00",
+			expected: []string{"2024-03-09T09:00:00", "2024-03-10T09:00:00"},
+		},
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(value string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02T15:04:05", value, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.rule, func(t *testing.T) {
+			rule, err := Parse(tc.rule)
+			if err != nil {
+				t.Fatalf("Failed to parse %s: %v", tc.rule, err)
+			}
+			occurrences, err := rule.Between(parse(tc.dtstart), parse(tc.from), parse(tc.to), 100)
+			if err != nil {
+				t.Fatalf("Failed to evaluate %s: %v", tc.rule, err)
+			}
+
+			actual := make([]string, 0, len(occurrences))
+			for _, occurrence := range occurrences {
+				actual = append(actual, occurrence.Format("2006-01-02T15:04:05"))
+			}
+			if strings.Join(actual, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("Expected occurrences of %s to be %v, got %v", tc.rule, tc.expected, actual)
+			}
+		})
+	}
+
+	rule, _ := Parse("FREQ=DAILY")
+	if _, err := rule.Between(parse("2024-01-01T09:00:00"), parse("2024-01-01T00:00:00"), parse("2025-01-01T00:00:00"), 100); err == nil {
+		t.Errorf("Expected an error for more than 100 occurrences")
+	}
+}
+
+func TestUnitRruleParseInvalid(t *testing.T) {
+	invalidRules := map[string]string{
+		"BYMONTH=12":                               "FREQ is required",
+		"FREQ=FORTNIGHTLY":                         "invalid FREQ",
+		"FREQ=DAILY;FREQ=WEEKLY":                   "more than once",
+		"FREQ=DAILY;BYEASTER=1":                    "unknown rule part",
+		"FREQ=DAILY;INTERVAL=0":                    "at least 1",
+		"FREQ=DAILY;COUNT=5;UNTIL=20240101":        "UNTIL and COUNT",
+		"FREQ=DAILY;UNTIL=2024-01-01":              "invalid UNTIL",
+		"FREQ=YEARLY;BYMONTH=13":                   "between 1 and 12",
+		"FREQ=MONTHLY;BYMONTHDAY=0":                "invalid BYMONTHDAY",
+		"FREQ=WEEKLY;BYMONTHDAY=1":                 "BYMONTHDAY must not be used",
+		"FREQ=MONTHLY;BYYEARDAY=100":               "BYYEARDAY must not be used",
+		"FREQ=MONTHLY;BYWEEKNO=1":                  "BYWEEKNO may only be used",
+		"FREQ=WEEKLY;BYDAY=1MO":                    "must not have a numeric value",
+		"FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO":         "must not have a numeric value",
+		"FREQ=MONTHLY;BYDAY=MONDAY":                "invalid BYDAY",
+		"FREQ=MONTHLY;BYSETPOS=1":                  "BYSETPOS must only be used",
+		"FREQ=DAILY;BYHOUR=24":                     "invalid BYHOUR",
+		"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;WKST=XX": "invalid WKST",
+	}
+	for rule, expected := range invalidRules {
+		if _, err := Parse(rule); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error containing '%s' for %s, got %v", expected, rule, err)
+		}
+	}
+}
+
+func TestUnitRruleNormalize(t *testing.T) {
+	testCases := map[string]string{
+		"FREQ=YEARLY;INTERVAL=01;BYMONTH=12;BYMONTHDAY=06": "FREQ=YEARLY;INTERVAL=1;BYMONTH=12;BYMONTHDAY=6",
+		"FREQ=MONTHLY;BYDAY=+01MO,-01FR;BYSETPOS=-01":      "FREQ=MONTHLY;BYDAY=+1MO,-1FR;BYSETPOS=-1",
+		"FREQ=DAILY;BYHOUR=0;UNTIL=20240101T000000Z":       "FREQ=DAILY;BYHOUR=0;UNTIL=20240101T000000Z",
+	}
+	for input, expected := range testCases {
+		if actual := Normalize(input); actual != expected {
+			t.Errorf("Expected %s to be normalized to %s, got %s", input, expected, actual)
+		}
+	}
+}
+
+func TestUnitRruleOccurrences(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC)
+	windowStart := time.Date(2024, 3, 9, 12, 0, 0, 0, loc)
+	windowEnd := time.Date(2024, 3, 11, 0, 0, 0, 0, loc)
+
+	occurrences, err := Occurrences(start, end, "FREQ=DAILY", loc, windowStart, windowEnd, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Occurrence{
+		// Daylight saving time starts during the night, which still ends at 08:00 on the wall clock
+		{Start: time.Date(2024, 3, 9, 20, 0, 0, 0, loc), End: time.Date(2024, 3, 10, 8, 0, 0, 0, loc)},
+		// Occurrences are clipped to the window
+		{Start: time.Date(2024, 3, 10, 20, 0, 0, 0, loc), End: windowEnd},
+	}
+	if len(occurrences) != len(expected) {
+		t.Fatalf("expected %d occurrences, got %v", len(expected), occurrences)
+	}
+	for i := range expected {
+		if !occurrences[i].Start.Equal(expected[i].Start) || !occurrences[i].End.Equal(expected[i].End) {
+			t.Errorf("occurrence %d: expected %v, got %v", i, expected[i], occurrences[i])
+		}
+	}
+
+	if _, err := Occurrences(end, start, "", loc, windowStart, windowEnd, 100); err == nil {
+		t.Error("expected an error for a schedule that ends before it starts")
+	}
+}
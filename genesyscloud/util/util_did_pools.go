@@ -0,0 +1,39 @@
+package util
+
+import (
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+)
+
+// IsNumberInDidRange checks if an E.164 number is in the DID range from start to end. Numbers of a different length
+// than the range are not in it.
+func IsNumberInDidRange(number, start, end string) bool {
+	return len(number) == len(start) && len(number) == len(end) && number >= start && number <= end
+}
+
+// GetAllDidPools retrieves all Genesys Cloud DID pools
+func GetAllDidPools(telephonyApi *platformclientv2.TelephonyProvidersEdgeApi) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	var allDidPools []platformclientv2.Didpool
+	const pageSize = 100
+
+	didPools, resp, err := telephonyApi.GetTelephonyProvidersEdgesDidpools(pageSize, 1, "", nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("error requesting page of did pools: %v", err)
+	}
+	if didPools.Entities != nil {
+		allDidPools = append(allDidPools, *didPools.Entities...)
+	}
+
+	for pageNum := 2; pageNum <= *didPools.PageCount; pageNum++ {
+		page, resp, err := telephonyApi.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of did pools: %v", err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		allDidPools = append(allDidPools, *page.Entities...)
+	}
+	return &allDidPools, resp, nil
+}
@@ -0,0 +1,19 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitIsNumberInDidRange(t *testing.T) {
+	start := "+13175550000"
+	end := "+13175550099"
+
+	assert.True(t, IsNumberInDidRange("+13175550000", start, end))
+	assert.True(t, IsNumberInDidRange("+13175550042", start, end))
+	assert.True(t, IsNumberInDidRange("+13175550099", start, end))
+	assert.False(t, IsNumberInDidRange("+13175550100", start, end))
+	// Numbers of a different length sort inside the range, but are not in it
+	assert.False(t, IsNumberInDidRange("+131755500421", start, end))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -57,15 +58,27 @@ type callSdkFunc func() (*platformclientv2.APIResponse, diag.Diagnostics)
 
 // Retries up to 10 times while the shouldRetry condition returns true
 // Useful for adding custom retry logic to normally non-retryable error codes
-func RetryWhen(shouldRetry checkResponseFunc, callSdk callSdkFunc, additionalCodes ...int) diag.Diagnostics {
+// Rate limited requests are also retried, backing off through the rate limit scheduler shared by the SDK clients
+func RetryWhen(ctx context.Context, shouldRetry checkResponseFunc, callSdk callSdkFunc, additionalCodes ...int) diag.Diagnostics {
 	var lastErr diag.Diagnostics
 	for i := 0; i < 10; i++ {
 		resp, sdkErr := callSdk()
 		if sdkErr != nil {
-			if resp != nil && shouldRetry(resp, additionalCodes...) {
+			if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+				// Back off and try again
+				lastErr = sdkErr
+				if err := provider.WaitForRetry(ctx, resp, i); err != nil {
+					return diag.FromErr(err)
+				}
+				continue
+			} else if resp != nil && shouldRetry(resp, additionalCodes...) {
 				// Wait a second and try again
 				lastErr = sdkErr
-				time.Sleep(time.Second)
+				select {
+				case <-ctx.Done():
+					return diag.FromErr(ctx.Err())
+				case <-time.After(time.Second):
+				}
 				continue
 			} else {
 				return sdkErr
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v146/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRetryWhen(t *testing.T) {
+	calls := 0
+	diagErr := RetryWhen(context.Background(), IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		calls++
+		if calls == 1 {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusConflict}, diag.Errorf("conflict")
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 2, calls)
+
+	// Responses that do not match the condition are not retried
+	calls = 0
+	diagErr = RetryWhen(context.Background(), IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		calls++
+		return &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, diag.Errorf("bad request")
+	})
+	assert.True(t, diagErr.HasError())
+	assert.Equal(t, 1, calls)
+}
+
+func TestUnitRetryWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Both the fixed wait and the rate limit backoff stop when the context of the caller is done
+	for _, statusCode := range []int{http.StatusConflict, http.StatusTooManyRequests} {
+		calls := 0
+		diagErr := RetryWhen(ctx, IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			calls++
+			return &platformclientv2.APIResponse{StatusCode: statusCode}, diag.Errorf("status %d", statusCode)
+		})
+		assert.True(t, diagErr.HasError())
+		assert.Equal(t, context.Canceled.Error(), diagErr[0].Summary)
+		assert.Equal(t, 1, calls)
+	}
+}
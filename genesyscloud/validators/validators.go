@@ -3,8 +3,8 @@ package validators
 import (
 	"fmt"
 	"regexp"
-	"strconv"
 	"time"
+	_ "time/tzdata"
 
 	"strings"
 
@@ -13,6 +13,7 @@ import (
 
 	files "terraform-provider-genesyscloud/genesyscloud/util/files"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -35,63 +36,25 @@ func ValidatePhoneNumber(number interface{}, _ cty.Path) diag.Diagnostics {
 	return diag.Errorf("Phone number %v is not a string", number)
 }
 
-// ValidateRrule validates rrule attribute
-func ValidateRrule(rrule interface{}, _ cty.Path) diag.Diagnostics {
-	if input, ok := rrule.(string); ok {
-		// FREQ Attribute validation
-		freqRegex := regexp.MustCompile(`FREQ=([A-Z]+)`)
-		if match := freqRegex.FindStringSubmatch(input); strings.Contains(input, "FREQ=") && match == nil {
-			return diag.Errorf("Invalid FREQ attribute. Should consist of uppercase letters.")
+// ValidateRrule validates rrule attribute is a valid RFC 5545 recurrence rule
+func ValidateRrule(rule interface{}, _ cty.Path) diag.Diagnostics {
+	if input, ok := rule.(string); ok {
+		if input == "" {
+			return nil
 		}
+
 		// INTERVAL Attribute validation
 		intervalRegex := regexp.MustCompile(`INTERVAL=([1-9][0-9]*)`)
 		if match := intervalRegex.FindStringSubmatch(input); strings.Contains(input, "INTERVAL=") && match == nil {
 			return diag.Errorf("Invalid INTERVAL attribute. Should be a positive integer greater than 0 without leading zeros.")
 		}
 
-		// rrule is split and stored in array using ';' as delimiter
-		// array is iterated over and variables are assigned if they exist
-		// This allows for the values for BYMONTH and BYMONTHDAY to be split, parsed and checked that they are within the valid range
-		rRuleAttributes := strings.Split(input, ";")
-		for _, value := range rRuleAttributes {
-			// BYMONTH Attribute validation
-			if strings.Contains(value, "BYMONTH=") {
-				byMonth := value
-				byMonthString := strings.Split(byMonth, "=")[1]
-				byMonthValues := strings.Split(byMonthString, ",")
-
-				for _, month := range byMonthValues {
-					byMonthValue, err := strconv.Atoi(month)
-					if err != nil {
-						return diag.Errorf("Failed to validate BYMONTH. [Error: %v]", err)
-					}
-					if byMonthValue <= 0 || byMonthValue > 12 {
-						return diag.Errorf("Invalid BYMONTH attribute. Should be a valid month (1-12) without leading zeros for single-digit months.")
-					}
-				}
-			}
-
-			// BYMONTHDAY Attribute validation
-			if strings.Contains(value, "BYMONTHDAY=") {
-				byMonthDay := value
-				byMonthDayString := strings.Split(byMonthDay, "=")[1]
-				byMonthDayValues := strings.Split(byMonthDayString, ",")
-
-				for _, day := range byMonthDayValues {
-					byMonthDayValue, err := strconv.Atoi(day)
-					if err != nil {
-						return diag.Errorf("Failed to validate BYMONTHDAY. [Error: %v]", err)
-					}
-
-					if byMonthDayValue <= 0 || byMonthDayValue > 31 {
-						return diag.Errorf("Invalid BYMONTHDAY attribute. Should be a valid day of the month (1-31) without leading zeros for single-digit days.")
-					}
-				}
-			}
+		if _, err := rrule.Parse(input); err != nil {
+			return diag.Errorf("Invalid rrule %s: %v", input, err)
 		}
 		return nil
 	}
-	return diag.Errorf("Provided rrule %v is not in string format", rrule)
+	return diag.Errorf("Provided rrule %v is not in string format", rule)
 }
 
 // ValidateExtensionPool validates a phone extension pool
@@ -121,6 +84,20 @@ func ValidateDate(date interface{}, _ cty.Path) diag.Diagnostics {
 	return diag.Errorf("Date %v is not a string", date)
 }
 
+// ValidateTimeZone validates a string is an IANA time zone name such as America/New_York
+func ValidateTimeZone(timeZone interface{}, _ cty.Path) diag.Diagnostics {
+	if timeZoneStr, ok := timeZone.(string); ok {
+		if timeZoneStr == "" {
+			return diag.Errorf("Time zone must not be empty")
+		}
+		if _, err := time.LoadLocation(timeZoneStr); err != nil {
+			return diag.Errorf("Invalid time zone %s: %s", timeZoneStr, err)
+		}
+		return nil
+	}
+	return diag.Errorf("Time zone %v is not a string", timeZone)
+}
+
 // ValidateDateTime validates a date string is in the format 2006-01-02T15:04Z
 func ValidateDateTime(date interface{}, _ cty.Path) diag.Diagnostics {
 	if dateStr, ok := date.(string); ok {
@@ -3,6 +3,7 @@ module terraform-provider-genesyscloud
 go 1.23
 
 require (
+	github.com/ProtonMail/go-crypto v1.1.0
 	github.com/google/go-cmp v0.6.0
 	github.com/google/uuid v1.6.0
 	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
@@ -17,12 +18,12 @@ require (
 	github.com/rjNemo/underscore v0.7.0
 	github.com/zclconf/go-cty v1.15.0
 	gonum.org/v1/gonum v0.15.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/BurntSushi/toml v1.2.1 // indirect
 	github.com/Kunde21/markdownfmt/v3 v3.1.0 // indirect
-	github.com/ProtonMail/go-crypto v1.1.0 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/bmatcuk/doublestar/v4 v4.6.1 // indirect
 	github.com/cloudflare/circl v1.5.0 // indirect
@@ -44,7 +45,6 @@ require (
 	golang.org/x/sync v0.9.0 // indirect
 	golang.org/x/tools v0.26.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241104194629-dd2ea8efbc28 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 require (
@@ -6,6 +6,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
 	dtr "terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	dtrs "terraform-provider-genesyscloud/genesyscloud/architect_datatable_rows"
 	emergencyGroup "terraform-provider-genesyscloud/genesyscloud/architect_emergencygroup"
 	flow "terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	grammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
@@ -55,6 +56,7 @@ import (
 	obCampaignRule "terraform-provider-genesyscloud/genesyscloud/outbound_campaignrule"
 	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
 	outboundContactListContact "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list_contact"
+	outboundContactListContacts "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list_contacts"
 	obContactListTemplate "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list_template"
 	obContactListFilter "terraform-provider-genesyscloud/genesyscloud/outbound_contactlistfilter"
 	obDigitalRuleSet "terraform-provider-genesyscloud/genesyscloud/outbound_digitalruleset"
@@ -96,6 +98,7 @@ import (
 	worktype "terraform-provider-genesyscloud/genesyscloud/task_management_worktype"
 	worktypeStatus "terraform-provider-genesyscloud/genesyscloud/task_management_worktype_status"
 	"terraform-provider-genesyscloud/genesyscloud/team"
+	numberInventory "terraform-provider-genesyscloud/genesyscloud/telephony_number_inventory"
 	"terraform-provider-genesyscloud/genesyscloud/telephony_provider_edges_trunkbasesettings"
 	did "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did"
 	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
@@ -161,6 +164,7 @@ func main() {
 		opts.ProviderAddr = "genesys.com/mypurecloud/genesyscloud"
 	}
 	plugin.Serve(opts)
+	provider.CloseMetrics()
 }
 
 type RegisterInstance struct {
@@ -176,6 +180,7 @@ func registerResources() {
 	oauth.SetRegistrar(regInstance)                                        //Registering oauth_client
 	dt.SetRegistrar(regInstance)                                           //Registering architect data table
 	dtr.SetRegistrar(regInstance)                                          //Registering architect data table row
+	dtrs.SetRegistrar(regInstance)                                         //Registering architect data table rows
 	emergencyGroup.SetRegistrar(regInstance)                               //Registering architect emergency group
 	architectSchedulegroups.SetRegistrar(regInstance)                      //Registering architect schedule groups
 	architectSchedules.SetRegistrar(regInstance)                           //Registering architect schedules
@@ -234,6 +239,7 @@ func registerResources() {
 	routingEmailRoute.SetRegistrar(regInstance)                            //Registering routing email route
 	did.SetRegistrar(regInstance)                                          //Registering telephony did
 	didPool.SetRegistrar(regInstance)                                      //Registering telephony did pools
+	numberInventory.SetRegistrar(regInstance)                              //Registering telephony number inventory
 	archIvr.SetRegistrar(regInstance)                                      //Registering architect ivr
 	workbin.SetRegistrar(regInstance)                                      //Registering task management workbin
 	workitemSchema.SetRegistrar(regInstance)                               //Registering task management workitem schema
@@ -261,6 +267,7 @@ func registerResources() {
 	routingQueueConditionalGroupRouting.SetRegistrar(regInstance)          //Registering routing queue conditional group routing
 	routingQueueOutboundEmailAddress.SetRegistrar(regInstance)             //Registering routing queue outbound email address
 	outboundContactListContact.SetRegistrar(regInstance)                   //Registering outbound contact list contact
+	outboundContactListContacts.SetRegistrar(regInstance)                  //Registering outbound contact list contacts
 	routingSettings.SetRegistrar(regInstance)                              //Registering routing Settings
 	routingUtilization.SetRegistrar(regInstance)                           //Registering routing utilization
 	routingUtilizationLabel.SetRegistrar(regInstance)                      //Registering routing utilization label
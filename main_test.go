@@ -0,0 +1,21 @@
+package main
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestProvider validates the schemas of every registered resource and data source
+func TestProvider(t *testing.T) {
+	providerResources = make(map[string]*schema.Resource)
+	providerDataSources = make(map[string]*schema.Resource)
+	resourceExporters = make(map[string]*resourceExporter.ResourceExporter)
+	registerResources()
+
+	if err := provider.New("0.1.0", providerResources, providerDataSources)().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}